@@ -6,12 +6,17 @@ require (
 	github.com/go-chi/chi/v5 v5.2.2
 	github.com/minio/madmin-go/v4 v4.1.1
 	github.com/minio/minio-go/v7 v7.0.94
+	github.com/minio/pkg/v3 v3.1.3
 	github.com/olivere/vite v0.1.0
+	github.com/prometheus/client_golang v1.23.0
+	github.com/prometheus/procfs v0.16.1
 	github.com/rs/zerolog v1.34.0
 	github.com/spf13/viper v1.20.1
+	go.etcd.io/bbolt v1.4.3
 )
 
 require (
+	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/dustin/go-humanize v1.0.1 // indirect
 	github.com/ebitengine/purego v0.8.4 // indirect
@@ -24,7 +29,7 @@ require (
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.10 // indirect
 	github.com/lufia/plan9stats v0.0.0-20250317134145-8bc96cf8fc35 // indirect
-	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-colorable v0.1.14 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/matttproud/golang_protobuf_extensions v1.0.4 // indirect
 	github.com/minio/md5-simd v1.1.2 // indirect
@@ -34,7 +39,6 @@ require (
 	github.com/power-devops/perfstat v0.0.0-20240221224432-82ca36839d55 // indirect
 	github.com/prometheus/client_model v0.6.2 // indirect
 	github.com/prometheus/common v0.65.0 // indirect
-	github.com/prometheus/procfs v0.16.1 // indirect
 	github.com/prometheus/prom2json v1.4.2 // indirect
 	github.com/prometheus/prometheus v0.304.1 // indirect
 	github.com/rs/xid v1.6.0 // indirect
@@ -2,6 +2,8 @@ package config
 
 import (
 	"flag"
+	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
@@ -13,6 +15,16 @@ type Config struct {
 	Vite   Vite   `mapstructure:"vite"`
 	Logger Logger `mapstructure:"logger"`
 	MinIO  MinIO  `mapstructure:"minio"`
+	// Clusters are additional MinIO clusters managed by the same instance
+	Clusters []MinIO  `mapstructure:"clusters"`
+	Metrics  Metrics  `mapstructure:"metrics"`
+	Store    Store    `mapstructure:"store"`
+	History  History  `mapstructure:"history"`
+	Expiry   Expiry   `mapstructure:"expiry"`
+	Rotation Rotation `mapstructure:"rotation"`
+	// Credentials are the rules of keys generated for new service accounts
+	Credentials Credentials `mapstructure:"credentials"`
+	Reveal      Reveal      `mapstructure:"reveal"`
 }
 
 // Server configuration
@@ -33,13 +45,93 @@ type Logger struct {
 	Pretty bool   `mapstructure:"pretty"`
 }
 
-// MinIO configuration
+// MinIO configuration of a cluster, the name selects it in the API
 type MinIO struct {
+	Name     string `mapstructure:"name"`
 	URL      string `mapstructure:"url"`
 	RootUser string `mapstructure:"root_user"`
 	Password string `mapstructure:"password"`
 }
 
+// Metrics configuration
+type Metrics struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Path    string `mapstructure:"path"`
+}
+
+// Store configuration for the embedded database keeping local state
+type Store struct {
+	Path string `mapstructure:"path"`
+}
+
+// History configuration for the background usage snapshot collector
+type History struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	Interval  time.Duration `mapstructure:"interval"`
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// Expiry configuration for the background access key expiry sweeper, only the default cluster is swept
+type Expiry struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	Interval   time.Duration `mapstructure:"interval"`
+	WarnDays   int           `mapstructure:"warn_days"`
+	Action     string        `mapstructure:"action"`
+	Retention  time.Duration `mapstructure:"retention"`
+	WebhookURL string        `mapstructure:"webhook_url"`
+	SMTP       SMTP          `mapstructure:"smtp"`
+}
+
+// SMTP configuration for mail notifications, disabled when the address is empty
+type SMTP struct {
+	Addr     string `mapstructure:"addr"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+	To       string `mapstructure:"to"` // Comma separated recipients
+}
+
+// Rotation configuration for access key rotations with a handoff window
+type Rotation struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"` // Time between checks of pending rotations
+	Overlap  time.Duration `mapstructure:"overlap"`  // Default time both access keys stay valid
+	Grace    time.Duration `mapstructure:"grace"`    // Default time the old access key stays disabled before it is deleted
+}
+
+// Credentials configuration for generated keys and the strength of supplied secret keys
+type Credentials struct {
+	AccessKeyPrefix  string  `mapstructure:"access_key_prefix"`
+	AccessKeyLength  int     `mapstructure:"access_key_length"` // Including the prefix
+	AccessKeyCharset string  `mapstructure:"access_key_charset"`
+	SecretKeyLength  int     `mapstructure:"secret_key_length"`
+	SecretKeyCharset string  `mapstructure:"secret_key_charset"`
+	MinSecretEntropy float64 `mapstructure:"min_secret_entropy"` // Estimated bits, zero disables the check
+}
+
+// Reveal configuration for one-time links to view new secret keys
+type Reveal struct {
+	Enabled bool          `mapstructure:"enabled"`
+	TTL     time.Duration `mapstructure:"ttl"`      // Time a link can be opened
+	BaseURL string        `mapstructure:"base_url"` // Public URL of the admin, links are relative when empty
+}
+
+// Recipients returns the mail recipients without blanks
+func (s SMTP) Recipients() []string {
+	var recipients []string
+	for _, to := range strings.Split(s.To, ",") {
+		if to = strings.TrimSpace(to); to != "" {
+			recipients = append(recipients, to)
+		}
+	}
+	return recipients
+}
+
+// MinIOClusters returns the default cluster followed by the additional clusters
+func (c *Config) MinIOClusters() []MinIO {
+	return append([]MinIO{c.MinIO}, c.Clusters...)
+}
+
 // Load loads configuration from flags, environment variables, and config files
 func Load() *Config {
 	// Set up Viper
@@ -55,9 +147,40 @@ func Load() *Config {
 	viper.SetDefault("vite.entry", "/src/main.ts")
 	viper.SetDefault("logger.level", "info")
 	viper.SetDefault("logger.pretty", true)
+	viper.SetDefault("minio.name", "default")
 	viper.SetDefault("minio.url", "http://localhost:9000")
 	viper.SetDefault("minio.root_user", "")
 	viper.SetDefault("minio.password", "")
+	viper.SetDefault("metrics.enabled", false)
+	viper.SetDefault("metrics.path", "/metrics")
+	viper.SetDefault("store.path", "data/minio-lite-admin.db")
+	viper.SetDefault("history.enabled", false)
+	viper.SetDefault("history.interval", "1h")
+	viper.SetDefault("history.retention", "2160h")
+	viper.SetDefault("expiry.enabled", false)
+	viper.SetDefault("expiry.interval", "24h")
+	viper.SetDefault("expiry.warn_days", 14)
+	viper.SetDefault("expiry.action", "none")
+	viper.SetDefault("expiry.retention", "2160h")
+	viper.SetDefault("expiry.webhook_url", "")
+	viper.SetDefault("expiry.smtp.addr", "")
+	viper.SetDefault("expiry.smtp.username", "")
+	viper.SetDefault("expiry.smtp.password", "")
+	viper.SetDefault("expiry.smtp.from", "")
+	viper.SetDefault("expiry.smtp.to", "")
+	viper.SetDefault("rotation.enabled", false)
+	viper.SetDefault("rotation.interval", "5m")
+	viper.SetDefault("rotation.overlap", "168h")
+	viper.SetDefault("rotation.grace", "24h")
+	viper.SetDefault("credentials.access_key_prefix", "AKIA")
+	viper.SetDefault("credentials.access_key_length", 20)
+	viper.SetDefault("credentials.access_key_charset", "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+	viper.SetDefault("credentials.secret_key_length", 40)
+	viper.SetDefault("credentials.secret_key_charset", "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/")
+	viper.SetDefault("credentials.min_secret_entropy", 64)
+	viper.SetDefault("reveal.enabled", false)
+	viper.SetDefault("reveal.ttl", "24h")
+	viper.SetDefault("reveal.base_url", "")
 
 	// Environment variable bindings
 	viper.SetEnvPrefix("MINIO_ADMIN")
@@ -82,6 +205,9 @@ func Load() *Config {
 	if err := viper.BindEnv("logger.pretty", "LOG_PRETTY"); err != nil {
 		log.Fatal().Err(err).Msg("Failed to bind logger.pretty environment variable")
 	}
+	if err := viper.BindEnv("minio.name", "MINIO_CLUSTER_NAME"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind minio.name environment variable")
+	}
 	if err := viper.BindEnv("minio.url", "MINIO_URL"); err != nil {
 		log.Fatal().Err(err).Msg("Failed to bind minio.url environment variable")
 	}
@@ -91,6 +217,96 @@ func Load() *Config {
 	if err := viper.BindEnv("minio.password", "MINIO_ROOT_PASSWORD"); err != nil {
 		log.Fatal().Err(err).Msg("Failed to bind minio.password environment variable")
 	}
+	if err := viper.BindEnv("metrics.enabled", "METRICS_ENABLED"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind metrics.enabled environment variable")
+	}
+	if err := viper.BindEnv("metrics.path", "METRICS_PATH"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind metrics.path environment variable")
+	}
+	if err := viper.BindEnv("store.path", "STORE_PATH"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind store.path environment variable")
+	}
+	if err := viper.BindEnv("history.enabled", "HISTORY_ENABLED"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind history.enabled environment variable")
+	}
+	if err := viper.BindEnv("history.interval", "HISTORY_INTERVAL"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind history.interval environment variable")
+	}
+	if err := viper.BindEnv("history.retention", "HISTORY_RETENTION"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind history.retention environment variable")
+	}
+	if err := viper.BindEnv("expiry.enabled", "EXPIRY_ENABLED"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.enabled environment variable")
+	}
+	if err := viper.BindEnv("expiry.interval", "EXPIRY_INTERVAL"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.interval environment variable")
+	}
+	if err := viper.BindEnv("expiry.warn_days", "EXPIRY_WARN_DAYS"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.warn_days environment variable")
+	}
+	if err := viper.BindEnv("expiry.action", "EXPIRY_ACTION"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.action environment variable")
+	}
+	if err := viper.BindEnv("expiry.retention", "EXPIRY_RETENTION"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.retention environment variable")
+	}
+	if err := viper.BindEnv("expiry.webhook_url", "EXPIRY_WEBHOOK_URL"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.webhook_url environment variable")
+	}
+	if err := viper.BindEnv("expiry.smtp.addr", "EXPIRY_SMTP_ADDR"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.smtp.addr environment variable")
+	}
+	if err := viper.BindEnv("expiry.smtp.username", "EXPIRY_SMTP_USERNAME"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.smtp.username environment variable")
+	}
+	if err := viper.BindEnv("expiry.smtp.password", "EXPIRY_SMTP_PASSWORD"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.smtp.password environment variable")
+	}
+	if err := viper.BindEnv("expiry.smtp.from", "EXPIRY_SMTP_FROM"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.smtp.from environment variable")
+	}
+	if err := viper.BindEnv("expiry.smtp.to", "EXPIRY_SMTP_TO"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind expiry.smtp.to environment variable")
+	}
+	if err := viper.BindEnv("rotation.enabled", "ROTATION_ENABLED"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind rotation.enabled environment variable")
+	}
+	if err := viper.BindEnv("rotation.interval", "ROTATION_INTERVAL"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind rotation.interval environment variable")
+	}
+	if err := viper.BindEnv("rotation.overlap", "ROTATION_OVERLAP"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind rotation.overlap environment variable")
+	}
+	if err := viper.BindEnv("rotation.grace", "ROTATION_GRACE"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind rotation.grace environment variable")
+	}
+	if err := viper.BindEnv("credentials.access_key_prefix", "CREDENTIALS_ACCESS_KEY_PREFIX"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind credentials.access_key_prefix environment variable")
+	}
+	if err := viper.BindEnv("credentials.access_key_length", "CREDENTIALS_ACCESS_KEY_LENGTH"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind credentials.access_key_length environment variable")
+	}
+	if err := viper.BindEnv("credentials.access_key_charset", "CREDENTIALS_ACCESS_KEY_CHARSET"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind credentials.access_key_charset environment variable")
+	}
+	if err := viper.BindEnv("credentials.secret_key_length", "CREDENTIALS_SECRET_KEY_LENGTH"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind credentials.secret_key_length environment variable")
+	}
+	if err := viper.BindEnv("credentials.secret_key_charset", "CREDENTIALS_SECRET_KEY_CHARSET"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind credentials.secret_key_charset environment variable")
+	}
+	if err := viper.BindEnv("credentials.min_secret_entropy", "CREDENTIALS_MIN_SECRET_ENTROPY"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind credentials.min_secret_entropy environment variable")
+	}
+	if err := viper.BindEnv("reveal.enabled", "REVEAL_ENABLED"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind reveal.enabled environment variable")
+	}
+	if err := viper.BindEnv("reveal.ttl", "REVEAL_TTL"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind reveal.ttl environment variable")
+	}
+	if err := viper.BindEnv("reveal.base_url", "REVEAL_BASE_URL"); err != nil {
+		log.Fatal().Err(err).Msg("Failed to bind reveal.base_url environment variable")
+	}
 
 	// Parse command line flags
 	addr := flag.String("addr", viper.GetString("server.addr"), "HTTP server address")
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// ClusterHeader selects the target cluster of API requests without the cluster path prefix
+const ClusterHeader = "X-MinIO-Cluster"
+
+// clusterRouters creates the API router of each cluster on first use
+type clusterRouters struct {
+	defaultCluster string
+	known          map[string]struct{}
+	build          func(cluster string) (http.Handler, error)
+
+	mu      sync.Mutex
+	routers map[string]http.Handler
+}
+
+func newClusterRouters(clusters []string, build func(cluster string) (http.Handler, error)) *clusterRouters {
+	known := make(map[string]struct{}, len(clusters))
+	for _, cluster := range clusters {
+		known[cluster] = struct{}{}
+	}
+
+	return &clusterRouters{
+		defaultCluster: clusters[0],
+		known:          known,
+		build:          build,
+		routers:        make(map[string]http.Handler, len(clusters)),
+	}
+}
+
+func (c *clusterRouters) router(cluster string) (http.Handler, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if router, ok := c.routers[cluster]; ok {
+		return router, nil
+	}
+
+	router, err := c.build(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	c.routers[cluster] = router
+	return router, nil
+}
+
+// Handler dispatches the request to the router of the selected cluster, an empty selection uses the default cluster
+func (c *clusterRouters) Handler(selectCluster func(r *http.Request) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cluster := selectCluster(r)
+		if cluster == "" {
+			cluster = c.defaultCluster
+		}
+
+		logger := zerolog.Ctx(r.Context()).With().Str("cluster", cluster).Logger()
+
+		if _, ok := c.known[cluster]; !ok {
+			logger.Warn().Msg("Unknown cluster requested")
+			http.Error(w, "Unknown cluster", http.StatusNotFound)
+			return
+		}
+
+		router, err := c.router(cluster)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to create cluster services")
+			http.Error(w, "Failed to connect cluster", http.StatusInternalServerError)
+			return
+		}
+
+		router.ServeHTTP(w, r.WithContext(logger.WithContext(r.Context())))
+	})
+}
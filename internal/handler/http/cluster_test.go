@@ -0,0 +1,167 @@
+package http
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// testClusterClients serves the clients of mock servers by name in the given order
+type testClusterClients struct {
+	names   []string
+	clients map[string]*madmin.AdminClient
+}
+
+func (c *testClusterClients) Names() []string {
+	return c.names
+}
+
+func (c *testClusterClients) Client(name string) (*madmin.AdminClient, error) {
+	client, ok := c.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown cluster %q", name)
+	}
+	return client, nil
+}
+
+// createTestRouterForClusters creates the router of a "primary" and a "secondary" cluster backed by mock servers
+func createTestRouterForClusters(t *testing.T, primary, secondary minio.ServerInfoResponse) http.Handler {
+	t.Helper()
+
+	clients := &testClusterClients{
+		names:   []string{"primary", "secondary"},
+		clients: make(map[string]*madmin.AdminClient),
+	}
+	for name, response := range map[string]minio.ServerInfoResponse{"primary": primary, "secondary": secondary} {
+		mockServer := minio.NewMockMinIOServer()
+		t.Cleanup(mockServer.Close)
+		mockServer.SetServerInfoResponse(response)
+
+		client, err := mockServer.CreateMinIOClient()
+		if err != nil {
+			t.Fatalf("Failed to create MinIO client: %v", err)
+		}
+		clients.clients[name] = client
+	}
+
+	newServices := func(cluster string) (Services, error) {
+		client, err := clients.Client(cluster)
+		if err != nil {
+			return Services{}, err
+		}
+
+		return Services{
+			GetServerInfoService: service.NewGetServerInfoService(client),
+		}, nil
+	}
+
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	var distFS embed.FS
+	router, err := NewService(cfg, zerolog.New(zerolog.NewTestWriter(t)), clients.Names(), newServices, service.NewGetClustersSummaryService(clients), nil, distFS)
+	if err != nil {
+		t.Fatalf("Failed to create service: %v", err)
+	}
+
+	return router
+}
+
+func TestClusterRouting(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+	router := createTestRouterForClusters(t, scenarios.SuccessfulServerInfo(), scenarios.DistributedServerInfo())
+
+	tests := []struct {
+		name           string
+		path           string
+		header         string
+		expectedStatus int
+		expectedMode   string
+	}{
+		{
+			name:           "default cluster without selection",
+			path:           "/api/server-info",
+			expectedStatus: http.StatusOK,
+			expectedMode:   "standalone",
+		},
+		{
+			name:           "cluster selected by path prefix",
+			path:           "/api/clusters/secondary/server-info",
+			expectedStatus: http.StatusOK,
+			expectedMode:   "distributed",
+		},
+		{
+			name:           "cluster selected by header",
+			path:           "/api/server-info",
+			header:         "secondary",
+			expectedStatus: http.StatusOK,
+			expectedMode:   "distributed",
+		},
+		{
+			name:           "path prefix takes precedence over header",
+			path:           "/api/clusters/primary/server-info",
+			header:         "secondary",
+			expectedStatus: http.StatusOK,
+			expectedMode:   "standalone",
+		},
+		{
+			name:           "unknown cluster by path prefix",
+			path:           "/api/clusters/unknown/server-info",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "unknown cluster by header",
+			path:           "/api/server-info",
+			header:         "unknown",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(ClusterHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status code %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var response ServerInfoResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if response.Mode != tt.expectedMode {
+				t.Errorf("Expected Mode %q, got %q", tt.expectedMode, response.Mode)
+			}
+		})
+	}
+}
+
+func TestNewService_RequiresCluster(t *testing.T) {
+	var distFS embed.FS
+	_, err := NewService(&config.Config{}, zerolog.Nop(), nil, nil, nil, nil, distFS)
+	if err == nil {
+		t.Error("Expected error without clusters")
+	}
+}
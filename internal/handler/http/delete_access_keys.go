@@ -38,7 +38,7 @@ func (s *Service) DeleteAccessKeysHandler(w http.ResponseWriter, r *http.Request
 	}
 
 	// Execute the service
-	response, err := s.deleteServiceAccountService.Execute(ctx, req)
+	response, err := s.DeleteServiceAccountService.Execute(ctx, req)
 	if err != nil {
 		logger.Error().Err(err).Str("accessKey", accessKey).Msg("Failed to delete access key")
 		http.Error(w, "Failed to delete access key", http.StatusInternalServerError)
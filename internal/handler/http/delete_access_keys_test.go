@@ -123,7 +123,7 @@ func TestService_DeleteAccessKeysHandler(t *testing.T) {
 			}
 
 			// Create service dependencies
-			deleteServiceAccountService := service.NewDeleteServiceAccountService(minioClient)
+			deleteServiceAccountService := service.NewDeleteServiceAccountService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL))
 
 			// Create HTTP service
 			testService := createTestServiceForDelete(t, deleteServiceAccountService)
@@ -236,7 +236,7 @@ func TestService_DeleteAccessKeysHandler_UrlParameterEdgeCases(t *testing.T) {
 			}
 
 			// Create service dependencies
-			deleteServiceAccountService := service.NewDeleteServiceAccountService(minioClient)
+			deleteServiceAccountService := service.NewDeleteServiceAccountService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL))
 
 			// Create HTTP service
 			testService := createTestServiceForDelete(t, deleteServiceAccountService)
@@ -290,9 +290,11 @@ func createTestServiceForDelete(
 
 	// Create service with test dependencies
 	return &Service{
-		config:                      cfg,
-		logger:                      logger,
-		deleteServiceAccountService: deleteServiceAccountService,
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			DeleteServiceAccountService: deleteServiceAccountService,
+		},
 	}
 }
 
@@ -311,7 +313,7 @@ func TestService_DeleteAccessKeysHandler_Integration(t *testing.T) {
 	}
 
 	// Create service dependencies
-	deleteServiceAccountService := service.NewDeleteServiceAccountService(minioClient)
+	deleteServiceAccountService := service.NewDeleteServiceAccountService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL))
 
 	// Create full service
 	testService := createTestServiceForDelete(t, deleteServiceAccountService)
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// DeleteConfigSubsystemHandler handles DELETE /api/config/{subSystem} to reset a target to its defaults.
+// The optional keys query parameter is a comma separated list of keys to reset.
+func (s *Service) DeleteConfigSubsystemHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	subSystem := chi.URLParam(r, "subSystem")
+	if !service.ValidConfigSubsystem(subSystem) {
+		logger.Warn().Str("subSystem", subSystem).Msg("Invalid config subsystem")
+		http.Error(w, "Invalid config subsystem", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	req := service.DeleteConfigRequest{
+		SubSystem: subSystem,
+		Target:    query.Get("target"),
+	}
+	if keys := query.Get("keys"); keys != "" {
+		for _, key := range strings.Split(keys, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				req.Keys = append(req.Keys, key)
+			}
+		}
+	}
+
+	response, err := s.DeleteConfigSubsystemService.Execute(ctx, req)
+	if errors.Is(err, service.ErrInvalidConfig) {
+		logger.Warn().Err(err).Str("subSystem", subSystem).Msg("Invalid config reset")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", subSystem).Msg("Failed to reset config")
+		http.Error(w, "Failed to reset config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("subSystem", subSystem).
+		Str("target", req.Target).
+		Strs("keys", req.Keys).
+		Msg("Successfully reset config")
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_DeleteConfigSubsystemHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		subSystem          string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+		expectedKV         string
+	}{
+		{
+			name:               "reset some keys of a target",
+			subSystem:          "notify_webhook",
+			queryParams:        "?target=primary&keys=queue_limit,%20comment",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"target":"primary"`,
+			expectedKV:         "notify_webhook:primary queue_limit comment",
+		},
+		{
+			name:               "unknown key",
+			subSystem:          "notify_webhook",
+			queryParams:        "?keys=retries",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "unknown key retries",
+		},
+		{
+			name:               "invalid subsystem",
+			subSystem:          "notify_pigeon",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid config subsystem",
+		},
+		{
+			name:      "MinIO server error",
+			subSystem: "notify_webhook",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to reset config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetConfigHelpResponse("notify_webhook", scenarios.NotifyWebhookConfigHelp())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			// Set the subsystem URL parameter
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("subSystem", tt.subSystem)
+			ctx := context.WithValue(context.Background(), chi.RouteCtxKey, rctx)
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/config/"+tt.subSystem+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.DeleteConfigSubsystemHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+
+			if tt.expectedKV != "" {
+				requests := mockServer.Requests("del-config-kv")
+				if len(requests) != 1 || requests[0].Get("kv") != tt.expectedKV {
+					t.Errorf("Expected reset of %q, got %v", tt.expectedKV, requests)
+				}
+			}
+		})
+	}
+}
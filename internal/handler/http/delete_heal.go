@@ -0,0 +1,47 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// DeleteHealHandler handles DELETE /api/heal requests stopping the heal sequence running on a bucket or prefix
+func (s *Service) DeleteHealHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	query := r.URL.Query()
+	req := service.StopHealRequest{
+		Bucket: query.Get("bucket"),
+		Prefix: query.Get("prefix"),
+	}
+
+	if req.Prefix != "" && req.Bucket == "" {
+		logger.Warn().Str("prefix", req.Prefix).Msg("Heal prefix given without bucket")
+		http.Error(w, "Bucket is required when prefix is set", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.StopHealService.Execute(ctx, req)
+	if err != nil {
+		logger.Error().Err(err).Str("bucket", req.Bucket).Msg("Failed to stop heal")
+		http.Error(w, "Failed to stop heal", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("clientToken", response.ClientToken).
+		Str("bucket", response.Bucket).
+		Msg("Successfully stopped heal")
+}
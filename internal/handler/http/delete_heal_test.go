@@ -0,0 +1,98 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_DeleteHealHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedError      string
+	}{
+		{
+			name:        "successful heal stop",
+			queryParams: "?bucket=media",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStartResponse(scenarios.SuccessfulHealStart())
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "prefix without bucket",
+			queryParams:        "?prefix=videos/",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Bucket is required when prefix is set",
+		},
+		{
+			name:        "MinIO server error",
+			queryParams: "?bucket=media",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetHealError(http.StatusNotFound, "No heal sequence running")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to stop heal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForHeal(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodDelete, "/api/heal"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.DeleteHealHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.HealSequence
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if response.Bucket != "media" {
+				t.Errorf("Expected Bucket %q, got %q", "media", response.Bucket)
+			}
+			if requests := mockServer.Requests("heal-stop"); len(requests) != 1 {
+				t.Errorf("Expected %d heal stop request, got %d", 1, len(requests))
+			}
+		})
+	}
+}
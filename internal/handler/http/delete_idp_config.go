@@ -0,0 +1,39 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// DeleteIDPConfigHandler handles DELETE /api/idp/{type}/{name} to remove an identity provider config
+func (s *Service) DeleteIDPConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	idpType, name, ok := parseIDPConfigParams(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := s.DeleteIDPConfigService.Execute(ctx, idpType, name)
+	if err != nil {
+		logger.Error().Err(err).Str("type", idpType).Str("name", name).Msg("Failed to delete IDP config")
+		http.Error(w, "Failed to delete IDP config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("type", idpType).
+		Str("name", name).
+		Bool("restartRequired", response.RestartRequired).
+		Msg("Successfully deleted IDP config")
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_DeleteIDPConfigHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		configName         string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:       "successful deletion",
+			configName: "keycloak",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPConfigResponse(minio.TestScenarios{}.OpenIDConfig())
+				mock.SetConfigRestartRequired(true)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"type":"openid","name":"keycloak","restartRequired":true}`,
+		},
+		{
+			name:               "invalid name",
+			configName:         "../keycloak",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid IDP config name",
+		},
+		{
+			name:               "config not found",
+			configName:         "okta",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to delete IDP config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			// Setup chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("type", "openid")
+			rctx.URLParams.Add("name", tt.configName)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+			req := httptest.NewRequest(http.MethodDelete, "/api/idp/openid/keycloak", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.DeleteIDPConfigHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
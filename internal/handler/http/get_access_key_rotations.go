@@ -0,0 +1,38 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+// GetAccessKeyRotationsHandler handles GET /api/access-keys/rotations requests for the rotations of the cluster
+func (s *Service) GetAccessKeyRotationsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	state := r.URL.Query().Get("state")
+	switch state {
+	case "", store.RotationOverlap, store.RotationDisabled, store.RotationCompleted:
+	default:
+		logger.Warn().Str("state", state).Msg("Invalid rotation state")
+		http.Error(w, "Invalid state parameter. Valid values: overlap, disabled, completed", http.StatusBadRequest)
+		return
+	}
+
+	result, err := s.ListAccessKeyRotationsService.Execute(ctx, state)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list access key rotations")
+		http.Error(w, "Failed to list access key rotations", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode access key rotations response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetAccessKeyRotationsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "rotations of the cluster",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"total":2`,
+		},
+		{
+			name:               "filtered by state",
+			queryParams:        "?state=disabled",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"oldAccessKey":"ALICECI0000000000001","newAccessKey":"ALICECI0000000000003","parentUser":"alice","state":"disabled"`,
+		},
+		{
+			name:               "invalid state",
+			queryParams:        "?state=pending",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid state parameter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotations := newTestRotations(t)
+			created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			for _, rotation := range []store.Rotation{
+				{ID: "a1", Cluster: "default", OldAccessKey: "BOBBACKUP00000000001", NewAccessKey: "BOBBACKUP00000000002", ParentUser: "bob", State: store.RotationOverlap, CreatedAt: created},
+				{ID: "b2", Cluster: "default", OldAccessKey: "ALICECI0000000000001", NewAccessKey: "ALICECI0000000000003", ParentUser: "alice", State: store.RotationDisabled, CreatedAt: created.Add(time.Hour)},
+			} {
+				if err := rotations.Save(rotation); err != nil {
+					t.Fatalf("Failed to seed rotation: %v", err)
+				}
+			}
+
+			// The rotation services do not call MinIO when listing
+			testService := createTestServiceForRotations(t, nil, rotations)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/access-keys/rotations"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetAccessKeyRotationsHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
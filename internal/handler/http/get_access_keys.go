@@ -2,7 +2,9 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/elct9620/minio-lite-admin/internal/service"
 )
@@ -16,6 +18,7 @@ func (s *Service) GetAccessKeysHandler(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 	filterType := query.Get("type")
 	filterUser := query.Get("user")
+	filterProvider := query.Get("provider")
 
 	// Default to "all" if no type specified
 	if filterType == "" {
@@ -36,16 +39,82 @@ func (s *Service) GetAccessKeysHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	logger.Debug().Str("type", filterType).Str("user", filterUser).Msg("Getting access keys")
+	// Default to "all" if no provider specified
+	if filterProvider == "" {
+		filterProvider = "all"
+	}
+
+	// Validate filter provider
+	validProviders := map[string]bool{
+		"all":                            true,
+		service.AccessKeyProviderBuiltin: true,
+		service.AccessKeyProviderLDAP:    true,
+		service.AccessKeyProviderOpenID:  true,
+	}
+
+	if !validProviders[filterProvider] {
+		logger.Warn().Str("provider", filterProvider).Msg("Invalid access key provider filter")
+		http.Error(w, "Invalid provider parameter. Valid values: all, builtin, ldap, openid", http.StatusBadRequest)
+		return
+	}
 
 	// Create options for the service
 	opts := service.ListAccessKeysOptions{
-		Type: filterType,
-		User: filterUser,
+		Type:     filterType,
+		User:     filterUser,
+		Provider: filterProvider,
+		Search:   query.Get("search"),
+		Sort:     query.Get("sort"),
+		Order:    query.Get("order"),
+		Cursor:   query.Get("cursor"),
+	}
+
+	if opts.Sort != "" && !service.ValidAccessKeySort(opts.Sort) {
+		logger.Warn().Str("sort", opts.Sort).Msg("Invalid access key sort field")
+		http.Error(w, "Invalid sort parameter. Valid values: accessKey, name, parent, expiration, status", http.StatusBadRequest)
+		return
+	}
+
+	if opts.Order != "" && opts.Order != service.AccessKeyOrderAsc && opts.Order != service.AccessKeyOrderDesc {
+		logger.Warn().Str("order", opts.Order).Msg("Invalid access key sort order")
+		http.Error(w, "Invalid order parameter. Valid values: asc, desc", http.StatusBadRequest)
+		return
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			logger.Warn().Str("limit", limit).Msg("Invalid access key page limit")
+			http.Error(w, "Invalid limit parameter. Must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		opts.Limit = n
 	}
 
+	if offset := query.Get("offset"); offset != "" {
+		n, err := strconv.Atoi(offset)
+		if err != nil || n < 0 {
+			logger.Warn().Str("offset", offset).Msg("Invalid access key page offset")
+			http.Error(w, "Invalid offset parameter. Must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		opts.Offset = n
+	}
+
+	if opts.Cursor != "" && opts.Offset > 0 {
+		logger.Warn().Msg("Access key cursor combined with offset")
+		http.Error(w, "The cursor and offset parameters cannot be combined", http.StatusBadRequest)
+		return
+	}
+
+	logger.Debug().Str("type", filterType).Str("user", filterUser).Str("provider", filterProvider).Str("search", opts.Search).Str("sort", opts.Sort).Int("limit", opts.Limit).Msg("Getting access keys")
+
 	// Execute the service
-	result, err := s.listAccessKeysService.Execute(ctx, opts)
+	result, err := s.ListAccessKeysService.Execute(ctx, opts)
+	if errors.Is(err, service.ErrInvalidAccessKeyCursor) {
+		http.Error(w, "Invalid cursor parameter", http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to get access keys")
 		http.Error(w, "Failed to get access keys", http.StatusInternalServerError)
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// defaultExpirySweepsLimit is the number of sweeps returned when limit is not given
+	defaultExpirySweepsLimit = 20
+	// maxExpirySweepsLimit bounds the number of sweeps of a single request
+	maxExpirySweepsLimit = 500
+)
+
+// GetAccessKeysExpirySweepsHandler handles GET /api/access-keys/expiry/sweeps requests for the latest expiry sweeps
+func (s *Service) GetAccessKeysExpirySweepsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	limit := defaultExpirySweepsLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 || n > maxExpirySweepsLimit {
+			logger.Warn().Str("limit", value).Msg("Invalid expiry sweeps limit")
+			http.Error(w, "Invalid limit parameter. Must be between 1 and "+strconv.Itoa(maxExpirySweepsLimit), http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	logger.Info().Int("limit", limit).Msg("Fetching access key expiry sweeps")
+
+	result, err := s.ListExpirySweepsService.Execute(ctx, limit)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list access key expiry sweeps")
+		http.Error(w, "Failed to list access key expiry sweeps", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode access key expiry sweeps response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
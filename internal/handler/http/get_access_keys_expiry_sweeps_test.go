@@ -0,0 +1,142 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetAccessKeysExpirySweepsHandler(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		queryParams        string
+		expectedStatusCode int
+		expectedError      string
+		validateResponse   func(t *testing.T, response *service.ListExpirySweepsResponse)
+	}{
+		{
+			name:               "latest sweeps",
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, response *service.ListExpirySweepsResponse) {
+				if len(response.Sweeps) != 3 || response.Total != 3 {
+					t.Fatalf("Expected %d sweeps, got %d of %d", 3, len(response.Sweeps), response.Total)
+				}
+				if !response.Sweeps[0].Timestamp.Equal(start.Add(2 * time.Hour)) {
+					t.Errorf("Expected the latest sweep first, got %v", response.Sweeps[0].Timestamp)
+				}
+				if response.Sweeps[0].Keys[0].AccessKey != "ALICECI0000000000001" {
+					t.Errorf("Expected AccessKey %q, got %q", "ALICECI0000000000001", response.Sweeps[0].Keys[0].AccessKey)
+				}
+			},
+		},
+		{
+			name:               "limited sweeps",
+			queryParams:        "?limit=1",
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, response *service.ListExpirySweepsResponse) {
+				if len(response.Sweeps) != 1 || response.Total != 3 {
+					t.Errorf("Expected %d sweep of %d, got %d of %d", 1, 3, len(response.Sweeps), response.Total)
+				}
+			},
+		},
+		{
+			name:               "invalid limit",
+			queryParams:        "?limit=0",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid limit parameter",
+		},
+		{
+			name:               "limit too large",
+			queryParams:        "?limit=1000",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid limit parameter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+			if err != nil {
+				t.Fatalf("Failed to open database: %v", err)
+			}
+			defer db.Close()
+
+			sweeps, err := store.NewExpirySweeps(db)
+			if err != nil {
+				t.Fatalf("Failed to create expiry sweeps: %v", err)
+			}
+
+			// Hourly sweeps finding the same expired service account
+			for i := range 3 {
+				err := sweeps.Save(store.ExpirySweep{
+					Timestamp: start.Add(time.Duration(i) * time.Hour),
+					Action:    service.ExpiryActionNone,
+					Keys:      []store.ExpiryKey{{AccessKey: "ALICECI0000000000001", Expiration: start, Expired: true}},
+					Expired:   1,
+				})
+				if err != nil {
+					t.Fatalf("Failed to seed sweeps: %v", err)
+				}
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForExpirySweeps(t, service.NewListExpirySweepsService(sweeps))
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/access-keys/expiry/sweeps"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetAccessKeysExpirySweepsHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.ListExpirySweepsResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			if tt.validateResponse != nil {
+				tt.validateResponse(t, &response)
+			}
+		})
+	}
+}
+
+// createTestServiceForExpirySweeps creates a Service instance for testing access key expiry sweeps
+func createTestServiceForExpirySweeps(t *testing.T, listExpirySweepsService *service.ListExpirySweepsService) *Service {
+	return &Service{
+		config: &config.Config{
+			Server: config.Server{
+				Addr: ":8080",
+				Dev:  true,
+			},
+		},
+		logger: zerolog.New(zerolog.NewTestWriter(t)),
+		Services: Services{
+			ListExpirySweepsService: listExpirySweepsService,
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/elct9620/minio-lite-admin/internal/service"
@@ -101,6 +102,131 @@ func TestService_GetAccessKeysHandler(t *testing.T) {
 				"Content-Type": "application/json",
 			},
 		},
+		{
+			name:        "successful access keys request - LDAP provider",
+			method:      http.MethodGet,
+			queryParams: "?provider=ldap",
+			setupMock: func(mockMinIO *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mockMinIO.SetLDAPAccessKeysResponse(scenarios.LDAPAccessKeys())
+			},
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"accessKeys", "total"},
+			checkHeaders: map[string]string{
+				"Content-Type": "application/json",
+			},
+		},
+		{
+			name:           "invalid provider parameter",
+			method:         http.MethodGet,
+			queryParams:    "?provider=saml",
+			setupMock:      nil,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name:        "MinIO LDAP access keys API returns error",
+			method:      http.MethodGet,
+			queryParams: "?provider=ldap",
+			setupMock: func(mockMinIO *minio.MockMinIOServer) {
+				mockMinIO.SetExternalAccessKeysError("ldap", http.StatusBadRequest, "LDAP is not enabled")
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name:        "paginated, sorted and searched access keys",
+			method:      http.MethodGet,
+			queryParams: "?search=account&sort=name&order=desc&limit=1",
+			setupMock: func(mockMinIO *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				users, accessKeys := scenarios.SuccessfulAccessKeys()
+				mockMinIO.SetUsersResponse(users)
+				mockMinIO.SetAccessKeysBulkResponse(accessKeys)
+			},
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"accessKeys", "total", "nextCursor"},
+			checkHeaders: map[string]string{
+				"Content-Type": "application/json",
+			},
+		},
+		{
+			name:           "invalid sort parameter",
+			method:         http.MethodGet,
+			queryParams:    "?sort=createdAt",
+			setupMock:      nil,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name:           "invalid order parameter",
+			method:         http.MethodGet,
+			queryParams:    "?order=random",
+			setupMock:      nil,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name:           "invalid limit parameter",
+			method:         http.MethodGet,
+			queryParams:    "?limit=0",
+			setupMock:      nil,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name:           "invalid offset parameter",
+			method:         http.MethodGet,
+			queryParams:    "?offset=-1",
+			setupMock:      nil,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name:           "cursor combined with offset",
+			method:         http.MethodGet,
+			queryParams:    "?offset=10&cursor=abc",
+			setupMock:      nil,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name:        "invalid cursor parameter",
+			method:      http.MethodGet,
+			queryParams: "?cursor=not-a-cursor",
+			setupMock: func(mockMinIO *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				users, accessKeys := scenarios.SuccessfulAccessKeys()
+				mockMinIO.SetUsersResponse(users)
+				mockMinIO.SetAccessKeysBulkResponse(accessKeys)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: nil,
+			checkHeaders: map[string]string{
+				"Content-Type": "text/plain; charset=utf-8",
+			},
+		},
 		{
 			name:           "invalid type parameter",
 			method:         http.MethodGet,
@@ -284,6 +410,41 @@ func TestService_GetAccessKeysHandler_ResponseFormat(t *testing.T) {
 	}
 }
 
+func TestService_GetAccessKeysHandler_ExternalUser(t *testing.T) {
+	// An LDAP DN without provider is unknown to the built-in listing
+	svc, mockMinIO := testServiceWithMockMinIOAndAccessKeys()
+	defer mockMinIO.Close()
+
+	scenarios := minio.TestScenarios{}
+	users, accessKeys := scenarios.SuccessfulAccessKeys()
+	mockMinIO.SetUsersResponse(users)
+	mockMinIO.SetAccessKeysBulkResponse(accessKeys)
+	mockMinIO.SetLDAPAccessKeysResponse(scenarios.LDAPAccessKeys())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/access-keys?user="+url.QueryEscape("uid=alice,ou=people,dc=example,dc=com"), nil)
+	w := httptest.NewRecorder()
+
+	svc.GetAccessKeysHandler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetAccessKeysHandler() status = %v, want %v: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response service.ListAccessKeysResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("GetAccessKeysHandler() response is not valid JSON: %v", err)
+	}
+
+	if response.Total != 2 {
+		t.Fatalf("GetAccessKeysHandler() total = %d, want the %d LDAP access keys", response.Total, 2)
+	}
+	for _, accessKey := range response.AccessKeys {
+		if accessKey.Provider != service.AccessKeyProviderLDAP {
+			t.Errorf("GetAccessKeysHandler() provider of %s = %q, want %q", accessKey.AccessKey, accessKey.Provider, service.AccessKeyProviderLDAP)
+		}
+	}
+}
+
 func TestService_GetAccessKeysHandler_ErrorHandling(t *testing.T) {
 	// Test error scenarios
 	svc, mockMinIO := testServiceWithMockMinIOAndAccessKeys()
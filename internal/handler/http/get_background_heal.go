@@ -0,0 +1,30 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetBackgroundHealHandler handles GET /api/heal/background requests for the continuous background healing state
+func (s *Service) GetBackgroundHealHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	logger.Info().Msg("Fetching MinIO background heal status")
+
+	result, err := s.GetBackgroundHealStatusService.Execute(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get MinIO background heal status")
+		http.Error(w, "Failed to get MinIO background heal status", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode MinIO background heal status response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
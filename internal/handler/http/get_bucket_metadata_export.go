@@ -0,0 +1,43 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetBucketMetadataExportHandler handles GET /api/buckets/metadata/export by downloading the metadata
+// of every bucket as a zip archive, or of a single bucket when the bucket query parameter is given
+func (s *Service) GetBucketMetadataExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	bucket := r.URL.Query().Get("bucket")
+
+	archive, err := s.ExportBucketMetadataService.Execute(ctx, bucket)
+	if err != nil {
+		logger.Error().Err(err).Str("bucket", bucket).Msg("Failed to export bucket metadata")
+		http.Error(w, "Failed to export bucket metadata", http.StatusInternalServerError)
+		return
+	}
+	defer archive.Close()
+
+	filename := "minio-bucket-metadata.zip"
+	if bucket != "" {
+		filename = fmt.Sprintf("minio-bucket-metadata-%s.zip", bucket)
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+
+	size, err := io.Copy(w, archive)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to write response")
+		return
+	}
+
+	logger.Info().Str("bucket", bucket).Int64("size", size).Msg("Successfully exported bucket metadata")
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetBucketMetadataExportHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedFilename   string
+		expectedError      string
+	}{
+		{
+			name: "export every bucket",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataExportResponse(scenarios.BucketMetadataArchive())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedFilename:   `filename=minio-bucket-metadata.zip`,
+		},
+		{
+			name:        "export a single bucket",
+			queryParams: "?bucket=reports",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataExportResponse(scenarios.BucketMetadataArchive())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedFilename:   `filename=minio-bucket-metadata-reports.zip`,
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to export bucket metadata",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForBucketMetadata(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/buckets/metadata/export"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetBucketMetadataExportHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			if got := w.Header().Get("Content-Disposition"); !strings.Contains(got, tt.expectedFilename) {
+				t.Errorf("Expected Content-Disposition containing %q, got %q", tt.expectedFilename, got)
+			}
+			if !bytes.Equal(w.Body.Bytes(), scenarios.BucketMetadataArchive()) {
+				t.Errorf("Expected the exported archive, got %d bytes", w.Body.Len())
+			}
+		})
+	}
+}
+
+// createTestServiceForBucketMetadata creates a Service instance for testing bucket metadata export and import
+func createTestServiceForBucketMetadata(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			ExportBucketMetadataService: service.NewExportBucketMetadataService(minioClient),
+			ImportBucketMetadataService: service.NewImportBucketMetadataService(minioClient),
+		},
+	}
+}
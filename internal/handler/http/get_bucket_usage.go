@@ -0,0 +1,61 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// GetBucketUsageHandler handles GET /api/data-usage/buckets requests for the per-bucket usage breakdown
+func (s *Service) GetBucketUsageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	// Parse query parameters
+	query := r.URL.Query()
+	opts := service.BucketUsageOptions{
+		SortBy: query.Get("sort"),
+		Order:  query.Get("order"),
+	}
+
+	if opts.SortBy != "" && !service.ValidBucketUsageSort(opts.SortBy) {
+		logger.Warn().Str("sort", opts.SortBy).Msg("Invalid bucket usage sort field")
+		http.Error(w, "Invalid sort parameter. Valid values: name, size, objects, versions, deleteMarkers", http.StatusBadRequest)
+		return
+	}
+
+	if opts.Order != "" && opts.Order != "asc" && opts.Order != "desc" {
+		logger.Warn().Str("order", opts.Order).Msg("Invalid bucket usage sort order")
+		http.Error(w, "Invalid order parameter. Valid values: asc, desc", http.StatusBadRequest)
+		return
+	}
+
+	if top := query.Get("top"); top != "" {
+		n, err := strconv.Atoi(top)
+		if err != nil || n <= 0 {
+			logger.Warn().Str("top", top).Msg("Invalid bucket usage top-N filter")
+			http.Error(w, "Invalid top parameter. Must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		opts.Top = n
+	}
+
+	logger.Info().Str("sort", opts.SortBy).Int("top", opts.Top).Msg("Fetching MinIO bucket usage")
+
+	result, err := s.GetBucketUsageService.Execute(ctx, opts)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get MinIO bucket usage")
+		http.Error(w, "Failed to get MinIO bucket usage", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode MinIO bucket usage response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
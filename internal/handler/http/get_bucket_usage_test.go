@@ -0,0 +1,150 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetBucketUsageHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBuckets    []string
+		expectedError      string
+	}{
+		{
+			name:        "successful bucket usage",
+			queryParams: "",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBuckets:    []string{"backups", "media", "logs"},
+		},
+		{
+			name:        "top bucket by object count",
+			queryParams: "?sort=objects&order=desc&top=1",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBuckets:    []string{"logs"},
+		},
+		{
+			name:               "invalid sort parameter",
+			queryParams:        "?sort=owner",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid sort parameter",
+		},
+		{
+			name:               "invalid order parameter",
+			queryParams:        "?order=up",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid order parameter",
+		},
+		{
+			name:               "invalid top parameter",
+			queryParams:        "?top=0",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid top parameter",
+		},
+		{
+			name:        "MinIO server error",
+			queryParams: "",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetDataUsageError(http.StatusForbidden, "Forbidden")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to get MinIO bucket usage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForBucketUsage(t, service.NewGetBucketUsageService(minioClient))
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/data-usage/buckets"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetBucketUsageHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.BucketUsageResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			if len(response.Buckets) != len(tt.expectedBuckets) {
+				t.Fatalf("Expected %d buckets, got %d", len(tt.expectedBuckets), len(response.Buckets))
+			}
+			for i, name := range tt.expectedBuckets {
+				if response.Buckets[i].Name != name {
+					t.Errorf("Expected bucket %d to be %q, got %q", i, name, response.Buckets[i].Name)
+				}
+			}
+		})
+	}
+}
+
+// createTestServiceForBucketUsage creates a Service instance for testing bucket usage
+func createTestServiceForBucketUsage(t *testing.T, getBucketUsageService *service.GetBucketUsageService) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			GetBucketUsageService: getBucketUsageService,
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetClustersHandler handles the summary of every managed cluster
+func (s *Service) GetClustersHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	logger.Info().Msg("Fetching clusters summary")
+
+	w.Header().Set("Content-Type", "application/json")
+
+	summary, err := s.getClustersSummaryService.Execute(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get clusters summary")
+		http.Error(w, "Failed to get clusters summary", http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode clusters summary response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+)
+
+func TestGetClustersHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+	router := createTestRouterForClusters(t, scenarios.SuccessfulServerInfo(), scenarios.DistributedServerInfo())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/clusters", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("Expected Content-Type %q, got %q", "application/json", contentType)
+	}
+
+	var response service.ClustersSummaryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if response.Total != 2 || response.Online != 2 {
+		t.Fatalf("Expected %d clusters with %d online, got %d with %d online", 2, 2, response.Total, response.Online)
+	}
+	if response.Clusters[0].Name != "primary" || !response.Clusters[0].Default {
+		t.Errorf("Expected default cluster %q first, got %q", "primary", response.Clusters[0].Name)
+	}
+	if response.Clusters[1].Mode != "distributed" {
+		t.Errorf("Expected secondary Mode %q, got %q", "distributed", response.Clusters[1].Mode)
+	}
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetConfigHandler handles GET /api/config by listing the MinIO config subsystems
+func (s *Service) GetConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	result, err := s.ListConfigSubsystemsService.Execute(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list config subsystems")
+		http.Error(w, "Failed to list config subsystems", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Int("count", result.Total).Msg("Successfully listed config subsystems")
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetConfigExportHandler handles GET /api/config/export by downloading the whole server config.
+// Sensitive values are masked unless includeSecrets=true is given.
+func (s *Service) GetConfigExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	includeSecrets := false
+	switch value := r.URL.Query().Get("includeSecrets"); value {
+	case "", "false":
+	case "true":
+		includeSecrets = true
+	default:
+		logger.Warn().Str("includeSecrets", value).Msg("Invalid includeSecrets parameter")
+		http.Error(w, "Invalid includeSecrets parameter. Valid values: true, false", http.StatusBadRequest)
+		return
+	}
+
+	config, err := s.ExportConfigService.Execute(ctx, includeSecrets)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to export config")
+		http.Error(w, "Failed to export config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="minio-config.txt"`)
+
+	if _, err := w.Write(config); err != nil {
+		logger.Error().Err(err).Msg("Failed to write response")
+		return
+	}
+
+	logger.Info().Bool("includeSecrets", includeSecrets).Msg("Successfully exported config")
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetConfigExportHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+		unexpectedBody     string
+	}{
+		{
+			name: "masked export",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerConfigResponse(scenarios.ServerConfig())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "auth_token=********",
+			unexpectedBody:     "s3cr3t-hook-token",
+		},
+		{
+			name:        "export with secrets",
+			queryParams: "?includeSecrets=true",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerConfigResponse(scenarios.ServerConfig())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "auth_token=s3cr3t-hook-token",
+		},
+		{
+			name:               "invalid includeSecrets parameter",
+			queryParams:        "?includeSecrets=yes",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid includeSecrets parameter",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to export config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/config/export"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetConfigExportHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+			if !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+			if tt.unexpectedBody != "" && strings.Contains(body, tt.unexpectedBody) {
+				t.Errorf("Expected body not to contain %q, got %q", tt.unexpectedBody, body)
+			}
+
+			if w.Code == http.StatusOK {
+				if got := w.Header().Get("Content-Disposition"); !strings.Contains(got, "attachment") {
+					t.Errorf("Expected attachment Content-Disposition, got %q", got)
+				}
+			}
+		})
+	}
+}
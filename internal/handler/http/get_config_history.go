@@ -0,0 +1,42 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// GetConfigHistoryHandler handles GET /api/config/history by listing the latest config changes
+func (s *Service) GetConfigHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	var count int
+	if countParam := r.URL.Query().Get("count"); countParam != "" {
+		n, err := strconv.Atoi(countParam)
+		if err != nil || n <= 0 {
+			logger.Warn().Str("count", countParam).Msg("Invalid config history count")
+			http.Error(w, "Invalid count parameter. Must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		count = n
+	}
+
+	result, err := s.ListConfigHistoryService.Execute(ctx, count)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list config history")
+		http.Error(w, "Failed to list config history", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Int("count", result.Total).Msg("Successfully listed config history")
+}
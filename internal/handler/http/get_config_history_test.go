@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetConfigHistoryHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "successful history listing",
+			queryParams: "?count=5",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigHistoryResponse(scenarios.ConfigHistory())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"total":2`,
+		},
+		{
+			name:               "invalid count parameter",
+			queryParams:        "?count=0",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid count parameter",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to list config history",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/config/history"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetConfigHistoryHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+			if !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+			if strings.Contains(body, "s3cr3t") {
+				t.Errorf("Expected secrets to be masked, got %q", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// GetConfigSubsystemHandler handles GET /api/config/{subSystem} to show the keys of every target with their help text.
+// Sensitive values such as webhook auth tokens are masked.
+func (s *Service) GetConfigSubsystemHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	subSystem := chi.URLParam(r, "subSystem")
+	if !service.ValidConfigSubsystem(subSystem) {
+		logger.Warn().Str("subSystem", subSystem).Msg("Invalid config subsystem")
+		http.Error(w, "Invalid config subsystem", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.GetConfigSubsystemService.Execute(ctx, subSystem)
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", subSystem).Msg("Failed to get config subsystem")
+		http.Error(w, "Failed to get config subsystem", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Str("subSystem", subSystem).Msg("Successfully retrieved config subsystem")
+}
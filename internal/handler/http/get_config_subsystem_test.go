@@ -0,0 +1,96 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetConfigSubsystemHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		subSystem          string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+		unexpectedBody     string
+	}{
+		{
+			name:      "secrets are masked",
+			subSystem: "notify_webhook",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigHelpResponse("notify_webhook", scenarios.NotifyWebhookConfigHelp())
+				mock.SetConfigKVResponse("notify_webhook", scenarios.NotifyWebhookConfig())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"target":"primary"`,
+			unexpectedBody:     "s3cr3t-hook-token",
+		},
+		{
+			name:               "invalid subsystem",
+			subSystem:          "notify_pigeon",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid config subsystem",
+		},
+		{
+			name:      "MinIO server error",
+			subSystem: "api",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to get config subsystem",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			// Set the subsystem URL parameter
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("subSystem", tt.subSystem)
+			ctx := context.WithValue(context.Background(), chi.RouteCtxKey, rctx)
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/config/"+tt.subSystem, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetConfigSubsystemHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+			if !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+			if tt.unexpectedBody != "" && strings.Contains(body, tt.unexpectedBody) {
+				t.Errorf("Expected body not to contain %q, got %q", tt.unexpectedBody, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetConfigHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedError      string
+	}{
+		{
+			name: "successful subsystems listing",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigHelpResponse("", scenarios.ConfigSubsystemsHelp())
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to list config subsystems",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/config", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetConfigHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.ListConfigSubsystemsResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if response.Total != 4 {
+				t.Errorf("Expected Total %d, got %d", 4, response.Total)
+			}
+		})
+	}
+}
+
+// createTestServiceForConfig creates a Service instance for testing the config viewer and editor
+func createTestServiceForConfig(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	tokens := service.NewConfirmationTokens(service.DefaultConfirmationTTL)
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			ListConfigSubsystemsService:  service.NewListConfigSubsystemsService(minioClient),
+			GetConfigSubsystemService:    service.NewGetConfigSubsystemService(minioClient),
+			SetConfigSubsystemService:    service.NewSetConfigSubsystemService(minioClient),
+			DeleteConfigSubsystemService: service.NewDeleteConfigSubsystemService(minioClient),
+			ListConfigHistoryService:     service.NewListConfigHistoryService(minioClient),
+			RestoreConfigHistoryService:  service.NewRestoreConfigHistoryService(minioClient),
+			ExportConfigService:          service.NewExportConfigService(minioClient),
+			PrepareConfigImportService:   service.NewPrepareConfigImportService(minioClient, tokens),
+			ImportConfigService:          service.NewImportConfigService(minioClient, tokens),
+		},
+	}
+}
@@ -43,7 +43,7 @@ func (s *Service) GetDataUsageHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
 	// Use the same ServerInfo API call to get disk usage information
-	combinedInfo, err := s.getServerInfoService.Execute(ctx)
+	combinedInfo, err := s.GetServerInfoService.Execute(ctx)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to get MinIO data usage info")
 		http.Error(w, "Failed to get MinIO data usage info", http.StatusInternalServerError)
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// defaultUsageHistoryRange is the range returned when from is not given
+const defaultUsageHistoryRange = 7 * 24 * time.Hour
+
+// GetDataUsageHistoryHandler handles GET /api/data-usage/history requests for stored usage snapshots
+func (s *Service) GetDataUsageHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	// Parse query parameters
+	query := r.URL.Query()
+	opts := service.UsageHistoryOptions{
+		To: time.Now().UTC(),
+	}
+
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			logger.Warn().Str("to", to).Msg("Invalid usage history end time")
+			http.Error(w, "Invalid to parameter. Must be an RFC 3339 timestamp", http.StatusBadRequest)
+			return
+		}
+		opts.To = t
+	}
+
+	opts.From = opts.To.Add(-defaultUsageHistoryRange)
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			logger.Warn().Str("from", from).Msg("Invalid usage history start time")
+			http.Error(w, "Invalid from parameter. Must be an RFC 3339 timestamp", http.StatusBadRequest)
+			return
+		}
+		opts.From = t
+	}
+
+	if !opts.To.After(opts.From) {
+		logger.Warn().Time("from", opts.From).Time("to", opts.To).Msg("Invalid usage history range")
+		http.Error(w, "Invalid time range. from must be before to", http.StatusBadRequest)
+		return
+	}
+
+	if step := query.Get("step"); step != "" {
+		d, err := parseStep(step)
+		if err != nil || d <= 0 {
+			logger.Warn().Str("step", step).Msg("Invalid usage history step")
+			http.Error(w, "Invalid step parameter. Use a duration like 1h or a number of days like 1d", http.StatusBadRequest)
+			return
+		}
+		if opts.To.Sub(opts.From)/d > service.MaxUsageHistoryPoints {
+			logger.Warn().Str("step", step).Msg("Usage history step too small")
+			http.Error(w, "Step too small. At most "+strconv.Itoa(service.MaxUsageHistoryPoints)+" points can be returned", http.StatusBadRequest)
+			return
+		}
+		opts.Step = d
+	}
+
+	logger.Info().Time("from", opts.From).Time("to", opts.To).Msg("Fetching MinIO usage history")
+
+	result, err := s.GetUsageHistoryService.Execute(ctx, opts)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get MinIO usage history")
+		http.Error(w, "Failed to get MinIO usage history", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode MinIO usage history response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// parseStep accepts a Go duration ("6h") or a number of days ("7d")
+func parseStep(value string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(value, "d"); found {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,174 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetDataUsageHistoryHandler(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		queryParams        string
+		expectedStatusCode int
+		expectedError      string
+		validateResponse   func(t *testing.T, response *service.UsageHistoryResponse)
+	}{
+		{
+			name:               "successful history with step",
+			queryParams:        "?from=2025-01-01T00:00:00Z&to=2025-01-08T00:00:00Z&step=2d",
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, response *service.UsageHistoryResponse) {
+				if len(response.Points) != 2 {
+					t.Errorf("Expected %d points, got %d", 2, len(response.Points))
+				}
+				if response.Forecast == nil {
+					t.Error("Expected forecast, got nil")
+				}
+			},
+		},
+		{
+			name:               "successful history with duration step",
+			queryParams:        "?from=2025-01-01T00:00:00Z&to=2025-01-08T00:00:00Z&step=12h",
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, response *service.UsageHistoryResponse) {
+				if len(response.Points) != 4 {
+					t.Errorf("Expected %d points, got %d", 4, len(response.Points))
+				}
+			},
+		},
+		{
+			name:               "range without snapshots",
+			queryParams:        "?from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z",
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, response *service.UsageHistoryResponse) {
+				if len(response.Points) != 0 {
+					t.Errorf("Expected no points, got %d", len(response.Points))
+				}
+			},
+		},
+		{
+			name:               "invalid from parameter",
+			queryParams:        "?from=yesterday",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid from parameter",
+		},
+		{
+			name:               "invalid to parameter",
+			queryParams:        "?to=2025-13-01",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid to parameter",
+		},
+		{
+			name:               "from after to",
+			queryParams:        "?from=2025-01-08T00:00:00Z&to=2025-01-01T00:00:00Z",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid time range",
+		},
+		{
+			name:               "invalid step parameter",
+			queryParams:        "?step=weekly",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid step parameter",
+		},
+		{
+			name:               "step too small",
+			queryParams:        "?from=2024-01-01T00:00:00Z&to=2025-01-01T00:00:00Z&step=1m",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Step too small",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+			if err != nil {
+				t.Fatalf("Failed to open database: %v", err)
+			}
+			defer db.Close()
+
+			history, err := store.NewUsageHistory(db)
+			if err != nil {
+				t.Fatalf("Failed to create usage history: %v", err)
+			}
+
+			// Daily snapshots growing by 1 GiB
+			for i := range 4 {
+				err := history.Save(store.UsageSnapshot{
+					Timestamp:     start.Add(time.Duration(i) * 24 * time.Hour),
+					TotalCapacity: 100 << 30,
+					UsedCapacity:  uint64(i+1) << 30,
+					FreeCapacity:  uint64(99-i) << 30,
+				})
+				if err != nil {
+					t.Fatalf("Failed to seed history: %v", err)
+				}
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForUsageHistory(t, service.NewGetUsageHistoryService(history))
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/data-usage/history"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetDataUsageHistoryHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.UsageHistoryResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			if tt.validateResponse != nil {
+				tt.validateResponse(t, &response)
+			}
+		})
+	}
+}
+
+// createTestServiceForUsageHistory creates a Service instance for testing usage history
+func createTestServiceForUsageHistory(t *testing.T, getUsageHistoryService *service.GetUsageHistoryService) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			GetUsageHistoryService: getUsageHistoryService,
+		},
+	}
+}
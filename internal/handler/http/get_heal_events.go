@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// healPollInterval is how often the heal status is polled for the progress stream
+const healPollInterval = time.Second
+
+// GetHealEventsHandler handles GET /api/heal/{clientToken}/events by streaming heal progress as Server-Sent Events.
+// A progress event is sent on every poll and the stream ends with a done event once the sequence finished or stopped.
+func (s *Service) GetHealEventsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	req, ok := parseHealStatusRequest(w, r)
+	if !ok {
+		return
+	}
+
+	stream := newEventStream(w)
+	if err := stream.Open(); err != nil {
+		logger.Error().Err(err).Msg("Failed to open heal progress stream")
+		return
+	}
+
+	poll := time.NewTicker(healPollInterval)
+	defer poll.Stop()
+
+	for {
+		status, err := s.GetHealStatusService.Execute(ctx, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Error().Err(err).Str("clientToken", req.ClientToken).Msg("Heal progress stream ended with error")
+			_ = stream.Send("error", map[string]string{"message": err.Error()})
+			return
+		}
+
+		if status.Done {
+			_ = stream.Send("done", status)
+			return
+		}
+
+		if err := stream.Send("progress", status); err != nil {
+			logger.Debug().Err(err).Msg("Heal progress stream client went away")
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetHealEventsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		clientToken        string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedEvents     []string
+		expectedError      string
+	}{
+		{
+			name:        "progress until finished",
+			clientToken: "3f1c1b8e-heal-token",
+			queryParams: "?bucket=media",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStatusResponses(scenarios.RunningThenFinishedHealStatus()...)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedEvents: []string{
+				"event: progress\ndata: {\"summary\":\"running\"",
+				"\"object\":\"videos/intro.mp4\"",
+				"event: done\ndata: {\"summary\":\"finished\"",
+			},
+		},
+		{
+			name:        "MinIO error is sent as error event",
+			clientToken: "unknown",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetHealError(http.StatusBadRequest, "Invalid client token")
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedEvents: []string{
+				"event: error\n",
+			},
+		},
+		{
+			name:               "prefix without bucket",
+			clientToken:        "3f1c1b8e-heal-token",
+			queryParams:        "?prefix=videos/",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Bucket is required when prefix is set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForHeal(t, minioClient)
+
+			// Bound the stream in case it never finishes
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/heal/"+tt.clientToken+"/events"+tt.queryParams, nil).WithContext(ctx)
+
+			// Add chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("clientToken", tt.clientToken)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+
+			testService.GetHealEventsHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+
+			if tt.expectedError != "" {
+				if !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			if ctx.Err() != nil {
+				t.Fatal("Expected stream to end on its own, it timed out")
+			}
+
+			for _, event := range tt.expectedEvents {
+				if !strings.Contains(body, event) {
+					t.Errorf("Expected stream to contain %q, got %q", event, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// GetHealStatusHandler handles GET /api/heal/{clientToken} requests polling the progress of a heal sequence
+func (s *Service) GetHealStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	req, ok := parseHealStatusRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := s.GetHealStatusService.Execute(ctx, req)
+	if err != nil {
+		logger.Error().Err(err).Str("clientToken", req.ClientToken).Msg("Failed to get heal status")
+		http.Error(w, "Failed to get heal status", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode heal status response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// parseHealStatusRequest reads the client token from the path and the heal path from the query,
+// writing a bad request response when they are invalid
+func parseHealStatusRequest(w http.ResponseWriter, r *http.Request) (service.GetHealStatusRequest, bool) {
+	logger := zerolog.Ctx(r.Context())
+	query := r.URL.Query()
+
+	req := service.GetHealStatusRequest{
+		ClientToken: chi.URLParam(r, "clientToken"),
+		Bucket:      query.Get("bucket"),
+		Prefix:      query.Get("prefix"),
+	}
+
+	if req.ClientToken == "" {
+		logger.Warn().Msg("Heal client token is missing")
+		http.Error(w, "Client token is required", http.StatusBadRequest)
+		return req, false
+	}
+
+	if req.Prefix != "" && req.Bucket == "" {
+		logger.Warn().Str("prefix", req.Prefix).Msg("Heal prefix given without bucket")
+		http.Error(w, "Bucket is required when prefix is set", http.StatusBadRequest)
+		return req, false
+	}
+
+	return req, true
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetHealStatusHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		clientToken        string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedError      string
+	}{
+		{
+			name:        "successful heal status",
+			clientToken: "3f1c1b8e-heal-token",
+			queryParams: "?bucket=media&prefix=videos/",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStatusResponses(scenarios.RunningThenFinishedHealStatus()...)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "prefix without bucket",
+			clientToken:        "3f1c1b8e-heal-token",
+			queryParams:        "?prefix=videos/",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Bucket is required when prefix is set",
+		},
+		{
+			name:        "MinIO server error",
+			clientToken: "unknown",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetHealError(http.StatusBadRequest, "Invalid client token")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to get heal status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForHeal(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/heal/"+tt.clientToken+tt.queryParams, nil).WithContext(ctx)
+
+			// Add chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("clientToken", tt.clientToken)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+
+			testService.GetHealStatusHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.HealStatus
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if response.Summary != service.HealStatusRunning {
+				t.Errorf("Expected Summary %q, got %q", service.HealStatusRunning, response.Summary)
+			}
+			if len(response.Items) != 1 {
+				t.Errorf("Expected %d item, got %d", 1, len(response.Items))
+			}
+
+			requests := mockServer.Requests("heal-status")
+			if len(requests) != 1 {
+				t.Fatalf("Expected %d heal status request, got %d", 1, len(requests))
+			}
+			if got := requests[0].Get("clientToken"); got != tt.clientToken {
+				t.Errorf("Expected clientToken %q, got %q", tt.clientToken, got)
+			}
+		})
+	}
+}
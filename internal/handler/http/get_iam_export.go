@@ -0,0 +1,33 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetIAMExportHandler handles GET /api/iam/export by downloading every IAM entity as a zip bundle
+func (s *Service) GetIAMExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	bundle, err := s.ExportIAMService.Execute(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to export IAM")
+		http.Error(w, "Failed to export IAM", http.StatusInternalServerError)
+		return
+	}
+	defer bundle.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="minio-iam.zip"`)
+
+	size, err := io.Copy(w, bundle)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to write response")
+		return
+	}
+
+	logger.Info().Int64("size", size).Msg("Successfully exported IAM")
+}
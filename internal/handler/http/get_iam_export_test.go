@@ -0,0 +1,115 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetIAMExportHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       []byte
+		expectedError      string
+	}{
+		{
+			name: "successful export",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMExportResponse(scenarios.IAMExportBundle())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       scenarios.IAMExportBundle(),
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to export IAM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIAM(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/iam/export", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetIAMExportHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			if got := w.Header().Get("Content-Type"); got != "application/zip" {
+				t.Errorf("Expected Content-Type %q, got %q", "application/zip", got)
+			}
+			if got := w.Header().Get("Content-Disposition"); !strings.Contains(got, "minio-iam.zip") {
+				t.Errorf("Expected attachment minio-iam.zip, got %q", got)
+			}
+			if !bytes.Equal(w.Body.Bytes(), tt.expectedBody) {
+				t.Errorf("Expected bundle of %d bytes, got %d bytes", len(tt.expectedBody), w.Body.Len())
+			}
+		})
+	}
+}
+
+// createTestServiceForIAM creates a Service instance for testing IAM export and import
+func createTestServiceForIAM(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			ExportIAMService: service.NewExportIAMService(minioClient),
+			ImportIAMService: service.NewImportIAMService(minioClient),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// GetIDPConfigHandler handles GET /api/idp/{type}/{name} to view an identity provider config with sensitive values masked
+func (s *Service) GetIDPConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	idpType, name, ok := parseIDPConfigParams(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := s.GetIDPConfigService.Execute(ctx, idpType, name)
+	if err != nil {
+		logger.Error().Err(err).Str("type", idpType).Str("name", name).Msg("Failed to get IDP config")
+		http.Error(w, "Failed to get IDP config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Str("type", idpType).Str("name", name).Msg("Successfully retrieved IDP config")
+}
+
+// parseIDPConfigParams reads the IDP type and config name from the path,
+// writing a bad request response when they are invalid
+func parseIDPConfigParams(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	logger := zerolog.Ctx(r.Context())
+
+	idpType := chi.URLParam(r, "type")
+	if !service.ValidIDPType(idpType) {
+		logger.Warn().Str("type", idpType).Msg("Invalid IDP type")
+		http.Error(w, "Invalid IDP type. Must be ldap or openid", http.StatusBadRequest)
+		return "", "", false
+	}
+
+	name := chi.URLParam(r, "name")
+	if !service.ValidIDPConfigName(name) {
+		logger.Warn().Str("name", name).Msg("Invalid IDP config name")
+		http.Error(w, "Invalid IDP config name", http.StatusBadRequest)
+		return "", "", false
+	}
+
+	return idpType, name, true
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// GetIDPConfigCheckHandler handles GET /api/idp/{type}/{name}/check to test the LDAP lookup bind.
+// MinIO only supports checking LDAP configs.
+func (s *Service) GetIDPConfigCheckHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	idpType, name, ok := parseIDPConfigParams(w, r)
+	if !ok {
+		return
+	}
+
+	if idpType != madmin.LDAPIDPCfg {
+		logger.Warn().Str("type", idpType).Msg("IDP config check not supported")
+		http.Error(w, "Only LDAP configs can be checked", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.CheckLDAPConfigService.Execute(ctx, name)
+	if err != nil {
+		logger.Error().Err(err).Str("name", name).Msg("Failed to check LDAP config")
+		http.Error(w, "Failed to check LDAP config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Str("name", name).Str("status", response.Status).Msg("Successfully checked LDAP config")
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetIDPConfigCheckHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		idpType            string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "lookup bind succeeds",
+			idpType:            "ldap",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"ok":true,"status":"none"}`,
+		},
+		{
+			name:    "invalid lookup bind credentials",
+			idpType: "ldap",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPCheckResponse(madmin.CheckIDPConfigResp{
+					ErrType: madmin.IDPErrInvalid,
+					ErrMsg:  "LDAP Result Code 49 \"Invalid Credentials\"",
+				})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"ok":false,"status":"invalid","error":"LDAP Result Code 49 \"Invalid Credentials\""}`,
+		},
+		{
+			name:               "OpenID not supported",
+			idpType:            "openid",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Only LDAP configs can be checked",
+		},
+		{
+			name:    "MinIO server error",
+			idpType: "ldap",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to check LDAP config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			// Setup chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("type", tt.idpType)
+			rctx.URLParams.Add("name", "_")
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+			req := httptest.NewRequest(http.MethodGet, "/api/idp/"+tt.idpType+"/_/check", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetIDPConfigCheckHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
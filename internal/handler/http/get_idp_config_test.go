@@ -0,0 +1,107 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetIDPConfigHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		idpType            string
+		configName         string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+		unexpectedBody     string
+	}{
+		{
+			name:       "OpenID config with masked secret",
+			idpType:    "openid",
+			configName: "keycloak",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPConfigResponse(scenarios.OpenIDConfig())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"key":"client_secret","value":"********","sensitive":true,"envOverride":false}`,
+			unexpectedBody:     "0pen1d-s3cret",
+		},
+		{
+			name:               "invalid type",
+			idpType:            "saml",
+			configName:         "_",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid IDP type",
+		},
+		{
+			name:               "invalid name",
+			idpType:            "openid",
+			configName:         "-keycloak",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid IDP config name",
+		},
+		{
+			name:               "config not found",
+			idpType:            "openid",
+			configName:         "okta",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to get IDP config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			// Setup chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("type", tt.idpType)
+			rctx.URLParams.Add("name", tt.configName)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+			req := httptest.NewRequest(http.MethodGet, "/api/idp/"+tt.idpType+"/"+tt.configName, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetIDPConfigHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+			if !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+			if tt.unexpectedBody != "" && strings.Contains(body, tt.unexpectedBody) {
+				t.Errorf("Expected body not to contain %q, got %q", tt.unexpectedBody, body)
+			}
+		})
+	}
+}
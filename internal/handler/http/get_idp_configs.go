@@ -0,0 +1,38 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// GetIDPConfigsHandler handles GET /api/idp to list the LDAP and OpenID configs, optionally filtered by type
+func (s *Service) GetIDPConfigsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	idpType := r.URL.Query().Get("type")
+	if idpType != "" && !service.ValidIDPType(idpType) {
+		logger.Warn().Str("type", idpType).Msg("Invalid IDP type")
+		http.Error(w, "Invalid IDP type. Must be ldap or openid", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.ListIDPConfigsService.Execute(ctx, idpType)
+	if err != nil {
+		logger.Error().Err(err).Str("type", idpType).Msg("Failed to list IDP configs")
+		http.Error(w, "Failed to list IDP configs", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Int("count", response.Total).Msg("Successfully listed IDP configs")
+}
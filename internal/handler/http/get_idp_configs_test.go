@@ -0,0 +1,125 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetIDPConfigsHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		query              string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "all identity providers",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPConfigResponse(scenarios.LDAPConfig())
+				mock.SetIDPConfigResponse(scenarios.OpenIDConfig())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"configs":[{"type":"ldap","name":"_","enabled":true},{"type":"openid","name":"keycloak","enabled":false,"roleArn":"arn:minio:iam:::role/dfOSBR8BdCPeCRy1"}],"total":2}`,
+		},
+		{
+			name:  "filtered by type",
+			query: "?type=ldap",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPConfigResponse(scenarios.LDAPConfig())
+				mock.SetIDPConfigResponse(scenarios.OpenIDConfig())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"total":1`,
+		},
+		{
+			name:               "invalid type",
+			query:              "?type=saml",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid IDP type",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to list IDP configs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/idp"+tt.query, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetIDPConfigsHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
+
+// createTestServiceForIDP creates a test service with the identity provider services
+func createTestServiceForIDP(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			ListIDPConfigsService:          service.NewListIDPConfigsService(minioClient),
+			GetIDPConfigService:            service.NewGetIDPConfigService(minioClient),
+			SetIDPConfigService:            service.NewSetIDPConfigService(minioClient),
+			DeleteIDPConfigService:         service.NewDeleteIDPConfigService(minioClient),
+			CheckLDAPConfigService:         service.NewCheckLDAPConfigService(minioClient),
+			LookupLDAPUserService:          service.NewLookupLDAPUserService(minioClient),
+			ListLDAPPolicyMappingsService:  service.NewListLDAPPolicyMappingsService(minioClient),
+			UpdateLDAPPolicyMappingService: service.NewUpdateLDAPPolicyMappingService(minioClient),
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// GetKMSKeyHandler handles GET /api/kms/keys/{keyId} to describe a KMS key and check that MinIO can use it
+func (s *Service) GetKMSKeyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	keyID := chi.URLParam(r, "keyId")
+	if !service.ValidKMSKeyID(keyID) {
+		logger.Warn().Str("keyId", keyID).Msg("Invalid KMS key ID")
+		http.Error(w, "Invalid KMS key ID", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.DescribeKMSKeyService.Execute(ctx, keyID)
+	if errors.Is(err, service.ErrKMSKeyNotFound) {
+		http.Error(w, "KMS key not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("keyId", keyID).Msg("Failed to describe KMS key")
+		http.Error(w, "Failed to describe KMS key", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Str("keyId", keyID).Bool("usable", response.Check.Usable).Msg("Successfully described KMS key")
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetKMSKeyHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		keyID              string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:  "usable key",
+			keyID: "tenant-a-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"check":{"keyId":"tenant-a-key","usable":true}`,
+		},
+		{
+			name:  "key failing decryption",
+			keyID: "tenant-a-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+				mock.SetKMSKeyStatusResponse(madmin.KMSKeyStatus{KeyID: "tenant-a-key", DecryptionErr: "key is disabled"})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"usable":false,"decryptionError":"key is disabled"`,
+		},
+		{
+			name:  "unknown key",
+			keyID: "tenant-z-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       "KMS key not found",
+		},
+		{
+			name:               "invalid key ID",
+			keyID:              "tenant-*",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid KMS key ID",
+		},
+		{
+			name:  "MinIO server error",
+			keyID: "tenant-a-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to describe KMS key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForKMS(t, minioClient)
+
+			// Setup chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("keyId", tt.keyID)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+			req := httptest.NewRequest(http.MethodGet, "/api/kms/keys/"+tt.keyID, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetKMSKeyHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetKMSKeysHandler handles GET /api/kms/keys to list the KMS keys, optionally matching a glob pattern
+func (s *Service) GetKMSKeysHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	pattern := r.URL.Query().Get("pattern")
+
+	response, err := s.ListKMSKeysService.Execute(ctx, pattern)
+	if err != nil {
+		logger.Error().Err(err).Str("pattern", pattern).Msg("Failed to list KMS keys")
+		http.Error(w, "Failed to list KMS keys", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Int("count", response.Total).Msg("Successfully listed KMS keys")
+}
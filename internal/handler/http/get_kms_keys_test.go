@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetKMSKeysHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       []string
+	}{
+		{
+			name: "every key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       []string{`"name":"minio-default-key"`, `"name":"tenant-a-key"`, `"total":2`},
+		},
+		{
+			name:        "keys matching a pattern",
+			queryParams: "?pattern=tenant-*",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       []string{`"name":"tenant-a-key"`, `"total":1`},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       []string{"Failed to list KMS keys"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForKMS(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/kms/keys"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetKMSKeysHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+			for _, expected := range tt.expectedBody {
+				if !strings.Contains(body, expected) {
+					t.Errorf("Expected body containing %q, got %q", expected, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// GetKMSStatusHandler handles GET /api/kms/status to show the KMS endpoints, state and metrics
+// along with whether MinIO can encrypt and decrypt with the default key
+func (s *Service) GetKMSStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	response, err := s.GetKMSStatusService.Execute(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get KMS status")
+		http.Error(w, "Failed to get KMS status", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Str("name", response.Name).Msg("Successfully retrieved KMS status")
+}
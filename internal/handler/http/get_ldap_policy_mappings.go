@@ -0,0 +1,40 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// GetLDAPPolicyMappingsHandler handles GET /api/ldap/policy-mappings to list the policies mapped to LDAP users and groups.
+// The user, group and policy parameters may be repeated, DNs contain commas so they are not comma separated.
+func (s *Service) GetLDAPPolicyMappingsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	query := r.URL.Query()
+	response, err := s.ListLDAPPolicyMappingsService.Execute(ctx, service.LDAPPolicyMappingsQuery{
+		Users:    query["user"],
+		Groups:   query["group"],
+		Policies: query["policy"],
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list LDAP policy mappings")
+		http.Error(w, "Failed to list LDAP policy mappings", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().
+		Int("users", len(response.Users)).
+		Int("groups", len(response.Groups)).
+		Msg("Successfully listed LDAP policy mappings")
+}
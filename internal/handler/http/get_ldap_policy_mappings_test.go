@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetLDAPPolicyMappingsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              url.Values
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+		expectedGroups     []string
+	}{
+		{
+			name:  "repeated group filter keeps DNs intact",
+			query: url.Values{"group": {"cn=admins,ou=groups,dc=example,dc=com", "cn=ops,ou=groups,dc=example,dc=com"}},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPPolicyEntitiesResponse(minio.TestScenarios{}.LDAPPolicyEntities())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"policies":[{"policy":"consoleAdmin","users":[],"groups":["cn=admins,ou=groups,dc=example,dc=com"]}`,
+			expectedGroups:     []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=ops,ou=groups,dc=example,dc=com"},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(http.StatusBadRequest, "LDAP is not enabled")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to list LDAP policy mappings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/ldap/policy-mappings?"+tt.query.Encode(), nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetLDAPPolicyMappingsHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+
+			if tt.expectedGroups != nil {
+				requests := mockServer.Requests("ldap-policy-entities")
+				if len(requests) != 1 {
+					t.Fatalf("Expected %d policy entities request, got %d", 1, len(requests))
+				}
+				if got := requests[0]["group"]; strings.Join(got, "|") != strings.Join(tt.expectedGroups, "|") {
+					t.Errorf("Expected groups %v, got %v", tt.expectedGroups, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// GetLDAPUserHandler handles GET /api/ldap/user?user= to look up the DN of an LDAP user
+func (s *Service) GetLDAPUserHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user := r.URL.Query().Get("user")
+	if user == "" {
+		logger.Warn().Msg("LDAP user is missing")
+		http.Error(w, "User is required", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.LookupLDAPUserService.Execute(ctx, user)
+	switch {
+	case errors.Is(err, service.ErrLDAPUserNotFound):
+		logger.Warn().Err(err).Str("user", user).Msg("LDAP user not found")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		logger.Error().Err(err).Str("user", user).Msg("Failed to look up LDAP user")
+		http.Error(w, "Failed to look up LDAP user", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Debug().Str("user", user).Str("dn", response.DN).Msg("Successfully looked up LDAP user")
+}
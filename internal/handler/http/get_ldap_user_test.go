@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetLDAPUserHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:  "user resolved to DN",
+			query: "?user=alice",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPAccessKeysResponse(minio.TestScenarios{}.LDAPAccessKeys())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"user":"alice","dn":"uid=alice,ou=people,dc=example,dc=com"}`,
+		},
+		{
+			name:  "unknown user",
+			query: "?user=mallory",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPAccessKeysResponse(minio.TestScenarios{}.LDAPAccessKeys())
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       "LDAP user not found",
+		},
+		{
+			name:               "missing user",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "User is required",
+		},
+		{
+			name:  "MinIO server error",
+			query: "?user=alice",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetExternalAccessKeysError("ldap", http.StatusBadRequest, "LDAP is not enabled")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to look up LDAP user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/ldap/user"+tt.query, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetLDAPUserHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// sseKeepAliveInterval is how often a comment is sent to keep idle streams open through proxies
+	sseKeepAliveInterval = 15 * time.Second
+	// sseWriteTimeout bounds how long a single write may block on a stalled client
+	sseWriteTimeout = 10 * time.Second
+)
+
+// GetLogsHandler handles GET /api/logs by streaming MinIO server logs as Server-Sent Events
+func (s *Service) GetLogsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	// Parse query parameters
+	query := r.URL.Query()
+	opts := service.GetLogsOptions{
+		Node: query.Get("node"),
+		Kind: query.Get("kind"),
+	}
+
+	if opts.Kind == "" {
+		opts.Kind = "all"
+	}
+
+	if !service.ValidLogKind(opts.Kind) {
+		logger.Warn().Str("kind", opts.Kind).Msg("Invalid log kind filter")
+		http.Error(w, "Invalid kind parameter. Valid values: minio, application, all", http.StatusBadRequest)
+		return
+	}
+
+	if limit := query.Get("last"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 {
+			logger.Warn().Str("last", limit).Msg("Invalid log last-N filter")
+			http.Error(w, "Invalid last parameter. Must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		opts.Limit = n
+	}
+
+	logs, err := s.GetLogsService.Execute(ctx, opts)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to start log stream")
+		http.Error(w, "Failed to start log stream", http.StatusInternalServerError)
+		return
+	}
+
+	stream := newEventStream(w)
+	if err := stream.Open(); err != nil {
+		logger.Error().Err(err).Msg("Failed to open log stream")
+		return
+	}
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if err := stream.Comment("keep-alive"); err != nil {
+				logger.Debug().Err(err).Msg("Log stream client went away")
+				return
+			}
+		case msg, ok := <-logs:
+			if !ok {
+				return
+			}
+
+			if msg.Err != nil {
+				logger.Error().Err(msg.Err).Msg("Log stream ended with error")
+				_ = stream.Send("error", map[string]string{"message": msg.Err.Error()})
+				return
+			}
+
+			if err := stream.Send("log", msg.Entry); err != nil {
+				logger.Debug().Err(err).Msg("Log stream client went away")
+				return
+			}
+		}
+	}
+}
+
+// eventStream writes Server-Sent Events, failing writes that block longer than sseWriteTimeout
+type eventStream struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+}
+
+func newEventStream(w http.ResponseWriter) *eventStream {
+	return &eventStream{
+		w:          w,
+		controller: http.NewResponseController(w),
+	}
+}
+
+// Open sends the SSE headers to the client
+func (e *eventStream) Open() error {
+	e.w.Header().Set("Content-Type", "text/event-stream")
+	e.w.Header().Set("Cache-Control", "no-cache")
+	e.w.Header().Set("Connection", "keep-alive")
+	e.w.Header().Set("X-Accel-Buffering", "no")
+	e.w.WriteHeader(http.StatusOK)
+
+	return e.flush()
+}
+
+// Send writes a named event with a JSON encoded payload
+func (e *eventStream) Send(event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	e.extendDeadline()
+	if _, err := fmt.Fprintf(e.w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+
+	return e.flush()
+}
+
+// Comment writes an SSE comment line, ignored by clients
+func (e *eventStream) Comment(text string) error {
+	e.extendDeadline()
+	if _, err := fmt.Fprintf(e.w, ": %s\n\n", text); err != nil {
+		return err
+	}
+
+	return e.flush()
+}
+
+func (e *eventStream) extendDeadline() {
+	// Not every ResponseWriter supports deadlines (e.g. httptest.ResponseRecorder)
+	_ = e.controller.SetWriteDeadline(time.Now().Add(sseWriteTimeout))
+}
+
+func (e *eventStream) flush() error {
+	if err := e.controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetLogsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedEvents     []string
+		expectedError      string
+	}{
+		{
+			name:        "successful log stream",
+			queryParams: "",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetLogsResponse(scenarios.SuccessfulLogs())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedEvents: []string{
+				"event: log\ndata: {\"node\":\"node1:9000\"",
+				"event: log\ndata: {\"node\":\"node2:9000\"",
+			},
+		},
+		{
+			name:        "successful log stream with filters",
+			queryParams: "?node=node1:9000&kind=minio&last=20",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetLogsResponse(scenarios.SuccessfulLogs()[:1])
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedEvents: []string{
+				"\"message\":\"Server started\"",
+			},
+		},
+		{
+			name:        "MinIO error is sent as error event",
+			queryParams: "",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLogsError(http.StatusForbidden, "Forbidden")
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedEvents: []string{
+				"event: error\n",
+			},
+		},
+		{
+			name:               "invalid kind parameter",
+			queryParams:        "?kind=debug",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid kind parameter",
+		},
+		{
+			name:               "invalid last parameter",
+			queryParams:        "?last=-5",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid last parameter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForLogs(t, service.NewGetLogsService(minioClient))
+
+			// The stream only ends when the client goes away, so bound it with a timeout
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/logs"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetLogsHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+
+			if tt.expectedError != "" {
+				if !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			if got := w.Header().Get("Content-Type"); got != "text/event-stream" {
+				t.Errorf("Expected Content-Type %q, got %q", "text/event-stream", got)
+			}
+
+			for _, event := range tt.expectedEvents {
+				if !strings.Contains(body, event) {
+					t.Errorf("Expected stream to contain %q, got %q", event, body)
+				}
+			}
+		})
+	}
+}
+
+// createTestServiceForLogs creates a Service instance for testing log streaming
+func createTestServiceForLogs(t *testing.T, getLogsService *service.GetLogsService) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			GetLogsService: getLogsService,
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// GetMetricsHandler handles GET /api/metrics requests for sampled cluster metrics
+func (s *Service) GetMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	// Parse query parameters
+	query := r.URL.Query()
+	opts := service.ClusterMetricsOptions{
+		Types: splitList(query.Get("type")),
+		Hosts: splitList(query.Get("hosts")),
+	}
+
+	for _, metricType := range opts.Types {
+		if !service.ValidMetricType(metricType) {
+			logger.Warn().Str("type", metricType).Msg("Invalid metric type filter")
+			http.Error(w, "Invalid type parameter. Valid values: "+strings.Join(service.MetricTypeNames(), ", "), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if interval := query.Get("interval"); interval != "" {
+		d, err := parseInterval(interval)
+		if err != nil || d <= 0 {
+			logger.Warn().Str("interval", interval).Msg("Invalid metrics interval")
+			http.Error(w, "Invalid interval parameter. Use a duration like 5s or a number of seconds", http.StatusBadRequest)
+			return
+		}
+		opts.Interval = d
+	}
+
+	if samples := query.Get("samples"); samples != "" {
+		n, err := strconv.Atoi(samples)
+		if err != nil || n <= 0 || n > service.MaxMetricsSamples {
+			logger.Warn().Str("samples", samples).Msg("Invalid metrics samples")
+			http.Error(w, "Invalid samples parameter. Must be between 1 and "+strconv.Itoa(service.MaxMetricsSamples), http.StatusBadRequest)
+			return
+		}
+		opts.Samples = n
+	}
+
+	samples := opts.Samples
+	if samples == 0 {
+		samples = service.DefaultMetricsSamples
+	}
+	interval := opts.Interval
+	if interval == 0 {
+		interval = service.DefaultMetricsInterval
+	}
+	if time.Duration(samples)*interval > service.MaxMetricsDuration {
+		logger.Warn().Int("samples", samples).Dur("interval", interval).Msg("Metrics sampling window too long")
+		http.Error(w, "Sampling window too long. samples x interval must be at most "+service.MaxMetricsDuration.String(), http.StatusBadRequest)
+		return
+	}
+
+	logger.Info().Strs("types", opts.Types).Msg("Fetching MinIO cluster metrics")
+
+	result, err := s.GetClusterMetricsService.Execute(ctx, opts)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get MinIO cluster metrics")
+		http.Error(w, "Failed to get MinIO cluster metrics", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode MinIO cluster metrics response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// splitList splits a comma separated query parameter, ignoring empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseInterval accepts either a Go duration ("5s", "1m") or a plain number of seconds
+func parseInterval(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,170 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_GetMetricsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedError      string
+		validateResponse   func(t *testing.T, response *service.ClusterMetricsResponse)
+	}{
+		{
+			name:        "successful disk and network metrics",
+			queryParams: "?type=disk,net&interval=1s&samples=2",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetMetricsResponse(scenarios.SuccessfulMetrics())
+			},
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, response *service.ClusterMetricsResponse) {
+				if len(response.Series) != 2 {
+					t.Errorf("Expected %d series, got %d", 2, len(response.Series))
+				}
+				disk := response.Series["disk"]
+				if len(disk) != 2 {
+					t.Fatalf("Expected %d disk points, got %d", 2, len(disk))
+				}
+				if got := disk[0].Values["readIops"]; got != 100 {
+					t.Errorf("Expected readIops %v, got %v", 100, got)
+				}
+				if got := response.Series["net"][0].Values["rxBytesPerSec"]; got != 1024*1024 {
+					t.Errorf("Expected rxBytesPerSec %v, got %v", 1024*1024, got)
+				}
+			},
+		},
+		{
+			name:        "interval as number of seconds",
+			queryParams: "?type=net&interval=1",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetMetricsResponse(scenarios.SuccessfulMetrics())
+			},
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, response *service.ClusterMetricsResponse) {
+				if response.Interval != "1s" {
+					t.Errorf("Expected interval %q, got %q", "1s", response.Interval)
+				}
+			},
+		},
+		{
+			name:               "invalid type parameter",
+			queryParams:        "?type=disk,gpu",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid type parameter",
+		},
+		{
+			name:               "invalid interval parameter",
+			queryParams:        "?interval=soon",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid interval parameter",
+		},
+		{
+			name:               "invalid samples parameter",
+			queryParams:        "?samples=0",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid samples parameter",
+		},
+		{
+			name:               "sampling window too long",
+			queryParams:        "?samples=60&interval=1m",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Sampling window too long",
+		},
+		{
+			name:        "MinIO server error",
+			queryParams: "?type=disk",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetMetricsError(http.StatusForbidden, "Forbidden")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to get MinIO cluster metrics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForMetrics(t, service.NewGetClusterMetricsService(minioClient))
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodGet, "/api/metrics"+tt.queryParams, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.GetMetricsHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.ClusterMetricsResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			if tt.validateResponse != nil {
+				tt.validateResponse(t, &response)
+			}
+		})
+	}
+}
+
+// createTestServiceForMetrics creates a Service instance for testing cluster metrics
+func createTestServiceForMetrics(t *testing.T, getClusterMetricsService *service.GetClusterMetricsService) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			GetClusterMetricsService: getClusterMetricsService,
+		},
+	}
+}
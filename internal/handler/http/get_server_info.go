@@ -4,14 +4,16 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/elct9620/minio-lite-admin/internal/service"
 	"github.com/rs/zerolog"
 )
 
 // ServerInfoResponse represents the MinIO server info response
 type ServerInfoResponse struct {
-	Mode         string `json:"mode"`
-	Region       string `json:"region"`
-	DeploymentID string `json:"deploymentId"`
+	Mode         string            `json:"mode"`
+	Region       string            `json:"region"`
+	DeploymentID string            `json:"deploymentId"`
+	Topology     *service.Topology `json:"topology"`
 }
 
 // GetServerInfoHandler handles MinIO server info requests
@@ -23,7 +25,7 @@ func (s *Service) GetServerInfoHandler(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json")
 
-	info, err := s.getServerInfoService.Execute(ctx)
+	info, err := s.GetServerInfoService.Execute(ctx)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to get MinIO server info")
 		http.Error(w, "Failed to get MinIO server info", http.StatusInternalServerError)
@@ -34,6 +36,7 @@ func (s *Service) GetServerInfoHandler(w http.ResponseWriter, r *http.Request) {
 		Mode:         info.ServerInfo.Mode,
 		Region:       info.ServerInfo.Region,
 		DeploymentID: info.ServerInfo.DeploymentID,
+		Topology:     info.Topology,
 	}
 
 	if err := json.NewEncoder(w).Encode(response); err != nil {
@@ -232,3 +232,38 @@ func TestService_GetServerInfoHandler_ErrorHandling(t *testing.T) {
 		t.Error("GetServerInfoHandler() error response should have non-empty body")
 	}
 }
+
+func TestService_GetServerInfoHandler_Topology(t *testing.T) {
+	svc, mockMinIO := testServiceWithMockMinIO()
+	defer mockMinIO.Close()
+
+	scenarios := minio.TestScenarios{}
+	mockMinIO.SetServerInfoResponse(scenarios.TopologyServerInfo())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/server-info", nil)
+	w := httptest.NewRecorder()
+
+	svc.GetServerInfoHandler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetServerInfoHandler() status = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	var response ServerInfoResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("GetServerInfoHandler() failed to unmarshal response: %v", err)
+	}
+
+	if response.Topology == nil {
+		t.Fatal("GetServerInfoHandler() topology missing")
+	}
+	if len(response.Topology.Servers) != 2 {
+		t.Errorf("GetServerInfoHandler() servers = %v, want %v", len(response.Topology.Servers), 2)
+	}
+	if len(response.Topology.Pools) != 1 || len(response.Topology.Pools[0].Sets) != 2 {
+		t.Fatalf("GetServerInfoHandler() pools = %+v, want 1 pool with 2 sets", response.Topology.Pools)
+	}
+	if !response.Topology.Pools[0].Sets[0].CanTolerateDriveLoss {
+		t.Errorf("GetServerInfoHandler() set 0 canTolerateDriveLoss = false, want true")
+	}
+}
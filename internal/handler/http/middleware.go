@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/elct9620/minio-lite-admin/internal/metrics"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/zerolog"
 )
@@ -70,3 +72,30 @@ func ContextLogger(logger zerolog.Logger) func(next http.Handler) http.Handler {
 		})
 	}
 }
+
+// Metrics middleware records request counts and latencies per route pattern
+func Metrics(m *metrics.Metrics) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			// The route pattern is only known after routing, and keeps label cardinality bounded
+			route := "unmatched"
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				if pattern := rctx.RoutePattern(); pattern != "" {
+					route = pattern
+				}
+			}
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			m.ObserveHTTPRequest(r.Method, route, status, time.Since(start))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package http
+
+import (
+	"embed"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/metrics"
+	"github.com/rs/zerolog"
+)
+
+func TestMetrics_Middleware(t *testing.T) {
+	tests := []struct {
+		name            string
+		metricsEnabled  bool
+		expectedStatus  int
+		expectedMetrics []string
+	}{
+		{
+			name:           "metrics endpoint exports requests by route pattern",
+			metricsEnabled: true,
+			expectedStatus: http.StatusOK,
+			expectedMetrics: []string{
+				`minio_lite_admin_http_requests_total{code="200",method="GET",route="/api/health"} 1`,
+				`minio_lite_admin_http_request_duration_seconds_count{method="GET",route="/api/health"} 1`,
+			},
+		},
+		{
+			name:           "metrics endpoint is not registered when disabled",
+			metricsEnabled: false,
+			expectedStatus: http.StatusOK, // Falls through to the SPA handler
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Server: config.Server{
+					Addr: ":8080",
+					Dev:  true,
+				},
+				Metrics: config.Metrics{
+					Enabled: tt.metricsEnabled,
+					Path:    "/metrics",
+				},
+			}
+
+			var appMetrics *metrics.Metrics
+			if tt.metricsEnabled {
+				appMetrics = metrics.New()
+			}
+
+			var distFS embed.FS
+			router, err := NewService(cfg, zerolog.Nop(), []string{"default"}, func(string) (Services, error) { return Services{}, nil }, nil, appMetrics, distFS)
+			if err != nil {
+				t.Fatalf("Failed to create service: %v", err)
+			}
+
+			// Serve a request to be recorded
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/health", nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected health status %d, got %d", http.StatusOK, w.Code)
+			}
+
+			// Scrape the metrics endpoint
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatus, w.Code)
+			}
+
+			body := w.Body.String()
+			for _, line := range tt.expectedMetrics {
+				if !strings.Contains(body, line) {
+					t.Errorf("Expected metrics to contain %q, got %q", line, body)
+				}
+			}
+
+			if !tt.metricsEnabled && strings.Contains(body, "minio_lite_admin_") {
+				t.Error("Expected metrics not to be exported when disabled")
+			}
+		})
+	}
+}
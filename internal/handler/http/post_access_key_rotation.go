@@ -0,0 +1,122 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// PostAccessKeyRotationHandler handles POST /api/access-keys/{accessKey}/rotation to replace a service account by a sibling.
+// The new credentials are downloaded as a bundle instead when the format query parameter is set.
+func (s *Service) PostAccessKeyRotationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	accessKey := strings.TrimSpace(chi.URLParam(r, "accessKey"))
+	if accessKey == "" {
+		logger.Error().Msg("Access key parameter is required")
+		http.Error(w, "Access key is required", http.StatusBadRequest)
+		return
+	}
+
+	// Windows are optional, the configured defaults are used when omitted
+	var body struct {
+		Overlap    string `json:"overlap,omitempty"` // Duration like 72h or a number of days like 7d
+		Grace      string `json:"grace,omitempty"`
+		RevealLink bool   `json:"revealLink,omitempty"` // Return a one-time reveal link instead of the secret key
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		logger.Error().Err(err).Str("accessKey", accessKey).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	bundle, ok := s.parseCredentialBundleRequest(w, r)
+	if !ok {
+		return
+	}
+	if body.RevealLink && bundle != nil {
+		logger.Warn().Str("accessKey", accessKey).Msg("Reveal link requested with a credential bundle")
+		http.Error(w, "A reveal link cannot be combined with a credential bundle", http.StatusBadRequest)
+		return
+	}
+	if body.RevealLink && s.CreateSecretRevealService == nil {
+		logger.Warn().Str("accessKey", accessKey).Msg("Reveal link requested but reveal links are disabled")
+		http.Error(w, "Reveal links are not enabled", http.StatusBadRequest)
+		return
+	}
+
+	req := service.StartAccessKeyRotationRequest{AccessKey: accessKey}
+	for _, window := range []struct {
+		name  string
+		value string
+		into  *time.Duration
+	}{
+		{name: "overlap", value: body.Overlap, into: &req.Overlap},
+		{name: "grace", value: body.Grace, into: &req.Grace},
+	} {
+		if window.value == "" {
+			continue
+		}
+		d, err := parseStep(window.value)
+		if err != nil || d <= 0 {
+			logger.Warn().Str(window.name, window.value).Msg("Invalid rotation window")
+			http.Error(w, "Invalid "+window.name+". Use a duration like 72h or a number of days like 7d", http.StatusBadRequest)
+			return
+		}
+		*window.into = d
+	}
+
+	response, err := s.StartAccessKeyRotationService.Execute(ctx, req)
+	switch {
+	case errors.Is(err, service.ErrRotationInProgress):
+		logger.Warn().Err(err).Str("accessKey", accessKey).Msg("Access key is already being rotated")
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case errors.Is(err, service.ErrAccessKeyExpired):
+		logger.Warn().Err(err).Str("accessKey", accessKey).Msg("Expired access key cannot be rotated")
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		logger.Error().Err(err).Str("accessKey", accessKey).Msg("Failed to start access key rotation")
+		http.Error(w, "Failed to start access key rotation", http.StatusInternalServerError)
+		return
+	}
+
+	if bundle != nil {
+		w.Header().Set("X-Rotation-ID", response.Rotation.ID)
+		s.writeCredentialBundle(w, r, bundle, response.AccessKey, response.SecretKey)
+		return
+	}
+
+	if body.RevealLink {
+		response.Reveal, err = s.revealSecret(ctx, &response.SecretKey)
+		if err != nil {
+			// The rotation continues, the new access key has to be rotated again to get a secret key
+			logger.Error().Err(err).Str("newAccessKey", response.AccessKey).Msg("Failed to create reveal link")
+			http.Error(w, "Rotation "+response.Rotation.ID+" started but the reveal link of "+response.AccessKey+" failed, rotate it again", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("accessKey", accessKey).
+		Str("newAccessKey", response.AccessKey).
+		Str("rotation", response.Rotation.ID).
+		Msg("Successfully started access key rotation")
+}
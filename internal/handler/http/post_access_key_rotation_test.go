@@ -0,0 +1,195 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// createTestServiceForRotations creates a Service with the rotation services of the default cluster
+func createTestServiceForRotations(t *testing.T, minioClient *madmin.AdminClient, rotations *store.Rotations) *Service {
+	return &Service{
+		config: &config.Config{
+			Server: config.Server{
+				Addr: ":8080",
+				Dev:  true,
+			},
+		},
+		logger: zerolog.New(zerolog.NewTestWriter(t)),
+		Services: Services{
+			StartAccessKeyRotationService: service.NewStartAccessKeyRotationService(minioClient, "default", rotations, service.RotationPolicy{Overlap: 7 * 24 * time.Hour, Grace: 24 * time.Hour}, service.DefaultCredentialRules()),
+			ListAccessKeyRotationsService: service.NewListAccessKeyRotationsService("default", rotations),
+			RenderCredentialsService:      service.NewRenderCredentialsService("http://localhost:9000"),
+		},
+	}
+}
+
+// newTestRotations creates rotations backed by a temporary database
+func newTestRotations(t *testing.T) *store.Rotations {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	rotations, err := store.NewRotations(db)
+	if err != nil {
+		t.Fatalf("Failed to create rotations: %v", err)
+	}
+
+	return rotations
+}
+
+func TestService_PostAccessKeyRotationHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		accessKey          string
+		query              string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		setupStore         func(*testing.T, *store.Rotations)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:      "rotation with custom overlap",
+			accessKey: "BOBBACKUP00000000001",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetAddServiceAccountResponse(minio.AddServiceAccountResponse{
+					Credentials: minio.AddServiceAccountCredentials{AccessKey: "BOBBACKUP00000000002", SecretKey: "new-secret-key"},
+				})
+			},
+			requestBody:        `{"overlap":"3d","grace":"12h"}`,
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `"accessKey":"BOBBACKUP00000000002","secretKey":"new-secret-key"`,
+		},
+		{
+			name:               "rotation without body uses the defaults",
+			accessKey:          "BOBBACKUP00000000001",
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `"state":"overlap"`,
+		},
+		{
+			name:               "invalid overlap",
+			accessKey:          "BOBBACKUP00000000001",
+			requestBody:        `{"overlap":"soon"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid overlap",
+		},
+		{
+			name:               "rotation as rclone bundle",
+			accessKey:          "BOBBACKUP00000000001",
+			query:              "?format=rclone&name=backup",
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       "[backup]\ntype = s3\nprovider = Minio\n",
+		},
+		{
+			name:               "invalid bundle options",
+			accessKey:          "BOBBACKUP00000000001",
+			query:              "?format=rclone&endpoint=localhost",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "endpoint must be an http or https URL",
+		},
+		{
+			name:               "reveal link while reveal links are disabled",
+			accessKey:          "BOBBACKUP00000000001",
+			requestBody:        `{"revealLink":true}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Reveal links are not enabled",
+		},
+		{
+			name:               "invalid request body",
+			accessKey:          "BOBBACKUP00000000001",
+			requestBody:        `{"overlap":`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+		{
+			name:      "access key already being rotated",
+			accessKey: "BOBBACKUP00000000001",
+			setupStore: func(t *testing.T, rotations *store.Rotations) {
+				if err := rotations.Save(store.Rotation{ID: "pending", Cluster: "default", OldAccessKey: "BOBBACKUP00000000001", State: store.RotationOverlap}); err != nil {
+					t.Fatalf("Failed to seed rotation: %v", err)
+				}
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       "already being rotated",
+		},
+		{
+			name:      "expired access key",
+			accessKey: "EXPIRED000000000001",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				expiration := time.Now().Add(-time.Hour)
+				mock.AddServiceAccountToStore("EXPIRED000000000001", "", "", "", "enabled", "bob", nil, &expiration)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       "access key has expired",
+		},
+		{
+			name:               "unknown service account",
+			accessKey:          "UNKNOWN000000000000",
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to start access key rotation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			mockServer.AddServiceAccountToStore("BOBBACKUP00000000001", "", "backup", "", "enabled", "bob", nil, nil)
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			rotations := newTestRotations(t)
+			if tt.setupStore != nil {
+				tt.setupStore(t, rotations)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForRotations(t, minioClient, rotations)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/access-keys/"+tt.accessKey+"/rotation"+tt.query, strings.NewReader(tt.requestBody))
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("accessKey", tt.accessKey)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			req = req.WithContext(zerolog.New(zerolog.NewTestWriter(t)).WithContext(req.Context()))
+			w := httptest.NewRecorder()
+
+			testService.PostAccessKeyRotationHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
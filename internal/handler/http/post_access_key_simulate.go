@@ -0,0 +1,70 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// PostAccessKeySimulateHandler handles POST /api/access-keys/{accessKey}/simulate to evaluate whether
+// the effective policy of a service account allows an action on a resource
+func (s *Service) PostAccessKeySimulateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	accessKey := strings.TrimSpace(chi.URLParam(r, "accessKey"))
+	if accessKey == "" {
+		logger.Error().Msg("Access key parameter is required")
+		http.Error(w, "Access key is required", http.StatusBadRequest)
+		return
+	}
+
+	var body struct {
+		Action     string              `json:"action"`
+		Resource   string              `json:"resource,omitempty"`   // bucket/object or arn:aws:s3:::bucket/object
+		Conditions map[string][]string `json:"conditions,omitempty"` // e.g. {"aws:SourceIp":["10.0.0.1"]}
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		logger.Error().Err(err).Str("accessKey", accessKey).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.SimulateAccessKeyPolicyService.Execute(ctx, service.SimulateAccessKeyPolicyRequest{
+		AccessKey:  accessKey,
+		Action:     body.Action,
+		Resource:   body.Resource,
+		Conditions: body.Conditions,
+	})
+	switch {
+	case errors.Is(err, service.ErrInvalidPolicySimulation):
+		logger.Warn().Err(err).Str("accessKey", accessKey).Msg("Invalid policy simulation")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, service.ErrServiceAccountNotFound):
+		logger.Warn().Err(err).Str("accessKey", accessKey).Msg("Service account to simulate not found")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, service.ErrUnresolvedParentPolicy):
+		logger.Warn().Err(err).Str("accessKey", accessKey).Msg("Parent policies cannot be resolved")
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	case err != nil:
+		logger.Error().Err(err).Str("accessKey", accessKey).Msg("Failed to simulate access key policy")
+		http.Error(w, "Failed to simulate access key policy", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostAccessKeySimulateHandler(t *testing.T) {
+	readBackups := `{"Version":"2012-10-17","Statement":[{"Sid":"ReadBackups","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::backups/*"]}]}`
+
+	tests := []struct {
+		name               string
+		accessKey          string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "allowed action",
+			accessKey:          "BOBBACKUP00000000001",
+			requestBody:        `{"action":"s3:GetObject","resource":"arn:aws:s3:::backups/db.tar.gz"}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"decision":"allow"`,
+		},
+		{
+			name:               "denied action",
+			accessKey:          "BOBBACKUP00000000001",
+			requestBody:        `{"action":"s3:DeleteObject","resource":"backups/db.tar.gz"}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"decision":"deny"`,
+		},
+		{
+			name:               "unknown action",
+			accessKey:          "BOBBACKUP00000000001",
+			requestBody:        `{"action":"s3:Teleport","resource":"backups/db.tar.gz"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "unknown action",
+		},
+		{
+			name:               "invalid request body",
+			accessKey:          "BOBBACKUP00000000001",
+			requestBody:        `{"action":`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+		{
+			name:               "unknown service account",
+			accessKey:          "UNKNOWN000000000000",
+			requestBody:        `{"action":"s3:GetObject","resource":"backups/db.tar.gz"}`,
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       "service account not found",
+		},
+		{
+			name:        "LDAP parent",
+			accessKey:   "LDAPKEY0000000000001",
+			requestBody: `{"action":"s3:GetObject","resource":"backups/db.tar.gz"}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPPolicyEntitiesResponse(madmin.PolicyEntitiesResult{
+					UserMappings: []madmin.UserPolicyEntities{
+						{User: "uid=carol,dc=example,dc=org", Policies: []string{"readbackups"}},
+					},
+				})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"decision":"allow"`,
+		},
+		{
+			name:               "parent is neither a built-in nor a mapped LDAP user",
+			accessKey:          "OIDCKEY0000000000001",
+			requestBody:        `{"action":"s3:GetObject","resource":"backups/db.tar.gz"}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       "is neither a built-in user nor a mapped LDAP user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			mockServer.AddServiceAccountToStore("BOBBACKUP00000000001", "", "backup", "", "enabled", "bob", nil, nil)
+			mockServer.AddServiceAccountToStore("LDAPKEY0000000000001", "", "", "", "enabled", "uid=carol,dc=example,dc=org", nil, nil)
+			mockServer.AddServiceAccountToStore("OIDCKEY0000000000001", "", "", "", "enabled", "openid-subject", nil, nil)
+			mockServer.SetUserInfoResponse("bob", madmin.UserInfo{PolicyName: "readbackups", Status: madmin.AccountEnabled})
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+			mockServer.SetCannedPolicyResponse("readbackups", json.RawMessage(readBackups))
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := &Service{
+				config: &config.Config{
+					Server: config.Server{
+						Addr: ":8080",
+						Dev:  true,
+					},
+				},
+				logger: zerolog.New(zerolog.NewTestWriter(t)),
+				Services: Services{
+					SimulateAccessKeyPolicyService: service.NewSimulateAccessKeyPolicyService(minioClient, "minioadmin"),
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/access-keys/"+tt.accessKey+"/simulate", strings.NewReader(tt.requestBody))
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("accessKey", tt.accessKey)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			req = req.WithContext(zerolog.New(zerolog.NewTestWriter(t)).WithContext(req.Context()))
+			w := httptest.NewRecorder()
+
+			testService.PostAccessKeySimulateHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
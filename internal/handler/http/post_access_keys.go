@@ -2,27 +2,52 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/elct9620/minio-lite-admin/internal/service"
 	"github.com/rs/zerolog"
 )
 
-// PostAccessKeysHandler handles POST /api/access-keys to create a new service account
+// PostAccessKeysHandler handles POST /api/access-keys to create a new service account.
+// The credentials are downloaded as a bundle instead when the format query parameter is set.
 func (s *Service) PostAccessKeysHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := zerolog.Ctx(ctx)
 
+	bundle, ok := s.parseCredentialBundleRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse request body
-	var req service.CreateServiceAccountRequest
+	var req struct {
+		service.CreateServiceAccountRequest
+		RevealLink bool `json:"revealLink,omitempty"` // Return a one-time reveal link instead of the secret key
+	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		logger.Error().Err(err).Msg("Failed to decode request body")
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
+	if req.RevealLink && bundle != nil {
+		logger.Warn().Msg("Reveal link requested with a credential bundle")
+		http.Error(w, "A reveal link cannot be combined with a credential bundle", http.StatusBadRequest)
+		return
+	}
+	if req.RevealLink && s.CreateSecretRevealService == nil {
+		logger.Warn().Msg("Reveal link requested but reveal links are disabled")
+		http.Error(w, "Reveal links are not enabled", http.StatusBadRequest)
+		return
+	}
 
 	// Create service account
-	response, err := s.addServiceAccountService.Execute(ctx, req)
+	response, err := s.AddServiceAccountService.Execute(ctx, req.CreateServiceAccountRequest)
+	if errors.Is(err, service.ErrWeakSecretKey) {
+		logger.Warn().Err(err).Msg("Rejected weak secret key")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to create service account")
 		// Pass through the actual error message for better debugging
@@ -30,6 +55,21 @@ func (s *Service) PostAccessKeysHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if bundle != nil {
+		s.writeCredentialBundle(w, r, bundle, response.AccessKey, response.SecretKey)
+		return
+	}
+
+	if req.RevealLink {
+		response.Reveal, err = s.revealSecret(ctx, &response.SecretKey)
+		if err != nil {
+			// The service account exists but its secret key is lost, it has to be deleted or rotated
+			logger.Error().Err(err).Str("accessKey", response.AccessKey).Msg("Failed to create reveal link")
+			http.Error(w, "Service account "+response.AccessKey+" was created but its reveal link failed, delete or rotate it", http.StatusInternalServerError)
+			return
+		}
+	}
+
 	// Return response
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -45,3 +85,57 @@ func (s *Service) PostAccessKeysHandler(w http.ResponseWriter, r *http.Request)
 		Str("name", response.Name).
 		Msg("Successfully created service account")
 }
+
+// parseCredentialBundleRequest reads the credential bundle options from the query, nil when no format is requested.
+// The options are checked before the credentials are created, writing a bad request response when they are invalid.
+func (s *Service) parseCredentialBundleRequest(w http.ResponseWriter, r *http.Request) (*service.RenderCredentialsRequest, bool) {
+	query := r.URL.Query()
+	if !query.Has("format") {
+		return nil, true
+	}
+
+	req := &service.RenderCredentialsRequest{
+		Format:    query.Get("format"),
+		Name:      query.Get("name"),
+		Endpoint:  query.Get("endpoint"),
+		Region:    query.Get("region"),
+		Namespace: query.Get("namespace"),
+	}
+	if err := s.RenderCredentialsService.Validate(req); err != nil {
+		zerolog.Ctx(r.Context()).Warn().Err(err).Str("format", req.Format).Msg("Invalid credential bundle options")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return req, true
+}
+
+// writeCredentialBundle renders the credentials as a downloaded file with the access key in the X-Access-Key header
+func (s *Service) writeCredentialBundle(w http.ResponseWriter, r *http.Request, req *service.RenderCredentialsRequest, accessKey, secretKey string) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	req.AccessKey = accessKey
+	req.SecretKey = secretKey
+
+	bundle, err := s.RenderCredentialsService.Execute(ctx, *req)
+	if err != nil {
+		// The credentials exist but the secret key is lost, the access key has to be deleted or rotated
+		logger.Error().Err(err).Str("accessKey", accessKey).Msg("Failed to render credential bundle")
+		http.Error(w, "Access key "+accessKey+" was created but its credential bundle failed, delete or rotate it", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", bundle.ContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+bundle.Filename+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Access-Key", accessKey)
+	w.WriteHeader(http.StatusCreated)
+
+	if _, err := w.Write(bundle.Content); err != nil {
+		logger.Error().Err(err).Msg("Failed to write credential bundle")
+		return
+	}
+
+	logger.Info().Str("accessKey", accessKey).Str("format", req.Format).Msg("Successfully rendered credential bundle")
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostAccessKeysBulkHandler handles POST /api/access-keys/bulk to change many service accounts at once
+func (s *Service) PostAccessKeysBulkHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	// Parse request body
+	var req service.BulkAccessKeysRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.BulkAccessKeysService.Execute(ctx, req)
+	switch {
+	case errors.Is(err, service.ErrInvalidBulkAccessKeyAction):
+		logger.Warn().Str("action", req.Action).Msg("Invalid bulk access key action")
+		http.Error(w, "Invalid action. Valid values: enable, disable, delete, setExpiration", http.StatusBadRequest)
+		return
+	case errors.Is(err, service.ErrEmptyAccessKeySelector), errors.Is(err, service.ErrMissingBulkExpiration):
+		logger.Warn().Err(err).Str("action", req.Action).Msg("Invalid bulk access key request")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		logger.Error().Err(err).Str("action", req.Action).Msg("Failed to run bulk access key operation")
+		http.Error(w, "Failed to run bulk access key operation", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("action", req.Action).
+		Bool("dryRun", req.DryRun).
+		Int("succeeded", response.Succeeded).
+		Int("failed", response.Failed).
+		Msg("Successfully ran bulk access key operation")
+}
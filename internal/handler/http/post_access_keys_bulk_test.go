@@ -0,0 +1,137 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// createTestServiceForBulkAccessKeys creates a Service with the bulk access key service
+func createTestServiceForBulkAccessKeys(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	return &Service{
+		config: &config.Config{
+			Server: config.Server{
+				Addr: ":8080",
+				Dev:  true,
+			},
+		},
+		logger: zerolog.New(zerolog.NewTestWriter(t)),
+		Services: Services{
+			BulkAccessKeysService: service.NewBulkAccessKeysService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL)),
+		},
+	}
+}
+
+func TestService_PostAccessKeysBulkHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "dry run of a parent user",
+			requestBody:        `{"action":"delete","selector":{"parentUser":"bob"},"dryRun":true}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"action":"delete","dryRun":true,"results":[{"accessKey":"BOBBACKUP00000000001","parentUser":"bob","name":"backup","status":"planned"}],"total":1,"succeeded":0,"failed":0}`,
+		},
+		{
+			name:        "partial failure is reported per access key",
+			requestBody: `{"action":"disable","selector":{"namePrefix":"ci-"}}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceAccountError("ALICECI0000000000001", http.StatusBadRequest, "Access Denied")
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"total":2,"succeeded":1,"failed":1`,
+		},
+		{
+			name:               "invalid action",
+			requestBody:        `{"action":"rotate","selector":{"parentUser":"bob"}}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid action",
+		},
+		{
+			name:               "empty selector",
+			requestBody:        `{"action":"delete","selector":{}}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "at least one access key selector is required",
+		},
+		{
+			name:               "set expiration without expiration",
+			requestBody:        `{"action":"setExpiration","selector":{"parentUser":"bob"}}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "expiration is required",
+		},
+		{
+			name:               "invalid request body",
+			requestBody:        `{"action":`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+		{
+			name:        "MinIO server error",
+			requestBody: `{"action":"delete","selector":{"parentUser":"bob"}}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetAccessKeysBulkError(http.StatusBadRequest, "Bad Request")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to run bulk access key operation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			users, accessKeys, stored := scenarios.BulkServiceAccounts()
+			mockServer.SetUsersResponse(users)
+			mockServer.SetAccessKeysBulkResponse(accessKeys)
+			for _, info := range stored {
+				mockServer.AddServiceAccountToStore(info.AccessKey, "", info.Name, info.Description, info.Status, info.ParentUser, nil, info.Expiration)
+			}
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForBulkAccessKeys(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodPost, "/api/access-keys/bulk", strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			testService.PostAccessKeysBulkHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
@@ -188,6 +188,23 @@ func TestService_PostAccessKeysHandler(t *testing.T) {
 			expectedStatusCode: http.StatusBadRequest,
 			expectedError:      "Invalid request body",
 		},
+		{
+			name:      "weak secret key",
+			setupMock: func(mock *minio.MockMinIOServer) {},
+			requestBody: service.CreateServiceAccountRequest{
+				Name:      "weak-service-account",
+				SecretKey: "aaaaaaaaaaaa",
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "secret key is too weak",
+		},
+		{
+			name:               "reveal link while reveal links are disabled",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			requestBody:        `{"name":"reveal-service-account","revealLink":true}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Reveal links are not enabled",
+		},
 		{
 			name: "MinIO server error",
 			setupMock: func(mock *minio.MockMinIOServer) {
@@ -227,7 +244,7 @@ func TestService_PostAccessKeysHandler(t *testing.T) {
 			}
 
 			// Create service dependencies
-			addServiceAccountService := service.NewAddServiceAccountService(minioClient)
+			addServiceAccountService := service.NewAddServiceAccountService(minioClient, service.DefaultCredentialRules())
 
 			// Create HTTP service
 			testService := createTestService(t, nil, nil, addServiceAccountService)
@@ -332,7 +349,7 @@ func TestService_PostAccessKeysHandler_ContentTypeValidation(t *testing.T) {
 			}
 
 			// Create service dependencies
-			addServiceAccountService := service.NewAddServiceAccountService(minioClient)
+			addServiceAccountService := service.NewAddServiceAccountService(minioClient, service.DefaultCredentialRules())
 
 			// Create HTTP service
 			testService := createTestService(t, nil, nil, addServiceAccountService)
@@ -456,7 +473,7 @@ func TestService_PostAccessKeysHandler_EdgeCases(t *testing.T) {
 			}
 
 			// Create service dependencies
-			addServiceAccountService := service.NewAddServiceAccountService(minioClient)
+			addServiceAccountService := service.NewAddServiceAccountService(minioClient, service.DefaultCredentialRules())
 
 			// Create HTTP service
 			testService := createTestService(t, nil, nil, addServiceAccountService)
@@ -530,11 +547,13 @@ func createTestService(
 
 	// Create service with test dependencies
 	return &Service{
-		config:                   cfg,
-		logger:                   logger,
-		getServerInfoService:     getServerInfoService,
-		listAccessKeysService:    listAccessKeysService,
-		addServiceAccountService: addServiceAccountService,
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			GetServerInfoService:     getServerInfoService,
+			ListAccessKeysService:    listAccessKeysService,
+			AddServiceAccountService: addServiceAccountService,
+		},
 	}
 }
 
@@ -554,7 +573,7 @@ func TestService_PostAccessKeysHandler_Integration(t *testing.T) {
 	}
 
 	// Create service dependencies
-	addServiceAccountService := service.NewAddServiceAccountService(minioClient)
+	addServiceAccountService := service.NewAddServiceAccountService(minioClient, service.DefaultCredentialRules())
 
 	// Create full service
 	testService := createTestService(t, nil, nil, addServiceAccountService)
@@ -613,3 +632,80 @@ func TestService_PostAccessKeysHandler_Integration(t *testing.T) {
 		t.Errorf("Expected Description %q, got %q", "Integration test service account", response.Description)
 	}
 }
+
+func TestService_PostAccessKeysHandler_CredentialBundle(t *testing.T) {
+	tests := []struct {
+		name                string
+		query               string
+		requestBody         string
+		expectedStatusCode  int
+		expectedDisposition string
+		expectedBody        string
+		expectedCreated     bool
+	}{
+		{
+			name:                "env bundle",
+			query:               "?format=env&name=backup",
+			requestBody:         `{"name":"backup","secretKey":"wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"}`,
+			expectedStatusCode:  http.StatusCreated,
+			expectedDisposition: `attachment; filename=".env"`,
+			expectedBody:        `AWS_SECRET_ACCESS_KEY="wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"`,
+			expectedCreated:     true,
+		},
+		{
+			name:               "unknown format is rejected before creating",
+			query:              "?format=xml",
+			requestBody:        `{"name":"backup"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "unknown format",
+		},
+		{
+			name:               "bundle with reveal link",
+			query:              "?format=env",
+			requestBody:        `{"name":"backup","revealLink":true}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "cannot be combined with a credential bundle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestService(t, nil, nil, service.NewAddServiceAccountService(minioClient, service.DefaultCredentialRules()))
+			testService.RenderCredentialsService = service.NewRenderCredentialsService("http://localhost:9000")
+
+			req := httptest.NewRequest(http.MethodPost, "/api/access-keys"+tt.query, strings.NewReader(tt.requestBody))
+			req = req.WithContext(zerolog.New(zerolog.NewTestWriter(t)).WithContext(req.Context()))
+			w := httptest.NewRecorder()
+
+			testService.PostAccessKeysHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if disposition := w.Header().Get("Content-Disposition"); disposition != tt.expectedDisposition {
+				t.Errorf("Expected Content-Disposition %q, got %q", tt.expectedDisposition, disposition)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+
+			if created := len(mockServer.Requests("add-service-account")) > 0; created != tt.expectedCreated {
+				t.Errorf("Expected service account created to be %v, got %v", tt.expectedCreated, created)
+			}
+		})
+	}
+}
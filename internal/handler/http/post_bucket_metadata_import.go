@@ -0,0 +1,52 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostBucketMetadataImportHandler handles POST /api/buckets/metadata/import to import the bucket
+// metadata zip archive in the body. Only the bucket query parameter is imported when given.
+// Settings which cannot be applied are reported per bucket instead of failing the request.
+func (s *Service) PostBucketMetadataImportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	bucket := r.URL.Query().Get("bucket")
+
+	archive, err := io.ReadAll(http.MaxBytesReader(w, r.Body, service.MaxBucketMetadataSize))
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to read bucket metadata archive")
+		http.Error(w, "Invalid request body. Archive must be at most 32 MiB", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.ImportBucketMetadataService.Execute(ctx, bucket, archive)
+	if errors.Is(err, service.ErrInvalidBucketMetadata) {
+		logger.Warn().Err(err).Msg("Invalid bucket metadata archive")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("bucket", bucket).Msg("Failed to import bucket metadata")
+		http.Error(w, "Failed to import bucket metadata", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Int("buckets", response.Total).
+		Int("failed", response.Failed).
+		Msg("Successfully imported bucket metadata")
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostBucketMetadataImportHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		queryParams        string
+		requestBody        []byte
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       []string
+	}{
+		{
+			name:        "per bucket report",
+			requestBody: scenarios.BucketMetadataArchive(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataImportResponse(scenarios.PartialBucketMetadataImport())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: []string{
+				`{"bucket":"logs","settings":[`,
+				`{"setting":"quota","applied":false,"error":"quota is not supported in gateway mode"}`,
+				`"total":2,"failed":1`,
+			},
+		},
+		{
+			name:               "invalid archive",
+			requestBody:        []byte("not a zip"),
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       []string{"invalid bucket metadata archive: not a zip archive"},
+		},
+		{
+			name:        "MinIO server error",
+			queryParams: "?bucket=reports",
+			requestBody: scenarios.BucketMetadataArchive(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       []string{"Failed to import bucket metadata"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForBucketMetadata(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodPost, "/api/buckets/metadata/import"+tt.queryParams, bytes.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/zip")
+			w := httptest.NewRecorder()
+
+			testService.PostBucketMetadataImportHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+			for _, expected := range tt.expectedBody {
+				if !strings.Contains(body, expected) {
+					t.Errorf("Expected body containing %q, got %q", expected, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// PostClusterActionHandler handles POST /api/cluster/{action} to restart, stop or update every MinIO server.
+// The request must carry a token issued by POST /api/cluster/{action}/confirmation.
+func (s *Service) PostClusterActionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	action := chi.URLParam(r, "action")
+	if !service.ValidClusterAction(action) {
+		logger.Warn().Str("action", action).Msg("Invalid cluster action")
+		http.Error(w, "Invalid action parameter. Valid values: restart, stop, update", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req service.ClusterActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	req.Action = action
+
+	if req.ConfirmationToken == "" {
+		logger.Warn().Str("action", action).Msg("Cluster action without confirmation token")
+		http.Error(w, "Confirmation token is required", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.RunClusterActionService.Execute(ctx, req)
+	if errors.Is(err, service.ErrInvalidConfirmationToken) {
+		http.Error(w, "Invalid or expired confirmation token", http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("action", action).Msg("Failed to run cluster action")
+		http.Error(w, "Failed to run cluster action", http.StatusInternalServerError)
+		return
+	}
+
+	// Servers restart in the background once the action is accepted
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().Str("action", action).Msg("Successfully ran cluster action")
+}
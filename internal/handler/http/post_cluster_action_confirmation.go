@@ -0,0 +1,51 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// PostClusterActionConfirmationHandler handles POST /api/cluster/{action}/confirmation by dry-running
+// the restart, stop or update and issuing the confirmation token required to run it
+func (s *Service) PostClusterActionConfirmationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	action := chi.URLParam(r, "action")
+	if !service.ValidClusterAction(action) {
+		logger.Warn().Str("action", action).Msg("Invalid cluster action")
+		http.Error(w, "Invalid action parameter. Valid values: restart, stop, update", http.StatusBadRequest)
+		return
+	}
+
+	// The body is optional, it only carries the update URL
+	var req service.ClusterActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	req.Action = action
+
+	response, err := s.PrepareClusterActionService.Execute(ctx, req)
+	if err != nil {
+		logger.Error().Err(err).Str("action", action).Msg("Failed to prepare cluster action")
+		http.Error(w, "Failed to prepare cluster action", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().Str("action", action).Msg("Successfully prepared cluster action")
+}
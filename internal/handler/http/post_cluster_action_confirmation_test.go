@@ -0,0 +1,132 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostClusterActionConfirmationHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		action             string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedError      string
+		expectedVersion    string
+	}{
+		{
+			name:   "restart confirmation without body",
+			action: "restart",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServiceActionResponse(scenarios.SuccessfulServiceAction())
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:        "update confirmation shows target version",
+			action:      "update",
+			requestBody: `{"updateUrl":"https://dl.example.com/minio"}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerUpdateResponse(scenarios.SuccessfulServerUpdate())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedVersion:    "RELEASE.2025-04-22T22-12-26Z",
+		},
+		{
+			name:               "invalid action",
+			action:             "freeze",
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid action parameter",
+		},
+		{
+			name:               "invalid request body",
+			action:             "update",
+			requestBody:        `{"updateUrl":`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid request body",
+		},
+		{
+			name:   "MinIO server error",
+			action: "stop",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceActionError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to prepare cluster action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForClusterAction(t, minioClient)
+
+			// Set the action URL parameter
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("action", tt.action)
+			ctx := context.WithValue(context.Background(), chi.RouteCtxKey, rctx)
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/cluster/"+tt.action+"/confirmation", strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			testService.PostClusterActionConfirmationHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.ClusterActionConfirmation
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if response.Action != tt.action {
+				t.Errorf("Expected Action %q, got %q", tt.action, response.Action)
+			}
+			if response.ConfirmationToken == "" {
+				t.Error("Expected confirmation token to be issued")
+			}
+			if tt.expectedVersion != "" && response.Peers[0].TargetVersion != tt.expectedVersion {
+				t.Errorf("Expected TargetVersion %q, got %q", tt.expectedVersion, response.Peers[0].TargetVersion)
+			}
+			if mockServer.RestartRequested() || mockServer.UpdateRequested() {
+				t.Error("Expected confirmation to only dry-run the action")
+			}
+		})
+	}
+}
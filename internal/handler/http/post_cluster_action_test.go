@@ -0,0 +1,166 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostClusterActionHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		action             string
+		confirm            bool // Request a confirmation token for the action first
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedError      string
+		expectedRestart    bool
+	}{
+		{
+			name:    "confirmed restart",
+			action:  "restart",
+			confirm: true,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServiceActionResponse(scenarios.SuccessfulServiceAction())
+			},
+			expectedStatusCode: http.StatusAccepted,
+			expectedRestart:    true,
+		},
+		{
+			name:               "missing confirmation token",
+			action:             "restart",
+			requestBody:        `{}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Confirmation token is required",
+		},
+		{
+			name:               "unknown confirmation token",
+			action:             "restart",
+			requestBody:        `{"confirmationToken":"guessed"}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusForbidden,
+			expectedError:      "Invalid or expired confirmation token",
+		},
+		{
+			name:               "invalid action",
+			action:             "freeze",
+			requestBody:        `{"confirmationToken":"guessed"}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid action parameter",
+		},
+		{
+			name:               "invalid request body",
+			action:             "stop",
+			requestBody:        `{"confirmationToken":`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid request body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForClusterAction(t, minioClient)
+
+			// Set the action URL parameter
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("action", tt.action)
+			ctx := context.WithValue(context.Background(), chi.RouteCtxKey, rctx)
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			requestBody := tt.requestBody
+			if tt.confirm {
+				confirmation, err := testService.PrepareClusterActionService.Execute(ctx, service.ClusterActionRequest{Action: tt.action})
+				if err != nil {
+					t.Fatalf("Failed to prepare cluster action: %v", err)
+				}
+				requestBody = `{"confirmationToken":"` + confirmation.ConfirmationToken + `"}`
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/cluster/"+tt.action, strings.NewReader(requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			testService.PostClusterActionHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if got := mockServer.RestartRequested(); got != tt.expectedRestart {
+				t.Errorf("Expected restart requested %v, got %v", tt.expectedRestart, got)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.ClusterActionResult
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if response.Action != tt.action {
+				t.Errorf("Expected Action %q, got %q", tt.action, response.Action)
+			}
+			if len(response.Peers) != 2 {
+				t.Errorf("Expected %d peers, got %d", 2, len(response.Peers))
+			}
+		})
+	}
+}
+
+// createTestServiceForClusterAction creates a Service instance for testing cluster service controls
+func createTestServiceForClusterAction(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	tokens := service.NewConfirmationTokens(service.DefaultConfirmationTTL)
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			PrepareClusterActionService: service.NewPrepareClusterActionService(minioClient, tokens),
+			RunClusterActionService:     service.NewRunClusterActionService(minioClient, tokens),
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// PostConfigHistoryRestoreHandler handles POST /api/config/history/{restoreId}/restore
+// to restore the config saved with a history entry
+func (s *Service) PostConfigHistoryRestoreHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	restoreID := chi.URLParam(r, "restoreId")
+	if restoreID == "" {
+		logger.Error().Msg("Restore ID is required")
+		http.Error(w, "Restore ID is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.RestoreConfigHistoryService.Execute(ctx, restoreID); err != nil {
+		logger.Error().Err(err).Str("restoreId", restoreID).Msg("Failed to restore config history")
+		http.Error(w, "Failed to restore config history", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	logger.Info().Str("restoreId", restoreID).Msg("Successfully restored config history")
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostConfigHistoryRestoreHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		restoreID          string
+		expectedStatusCode int
+		expectedError      string
+	}{
+		{
+			name:               "successful restore",
+			restoreID:          "a1b2c3d4-restore-2",
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:               "unknown history entry",
+			restoreID:          "missing",
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to restore config history",
+		},
+		{
+			name:               "missing restore ID",
+			restoreID:          "",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Restore ID is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetConfigHistoryResponse(scenarios.ConfigHistory())
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			// Set the restore ID URL parameter
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("restoreId", tt.restoreID)
+			ctx := context.WithValue(context.Background(), chi.RouteCtxKey, rctx)
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/config/history/"+tt.restoreID+"/restore", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			testService.PostConfigHistoryRestoreHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostConfigImportHandler handles POST /api/config/import to replace the whole server config with
+// the config lines in the body. The confirmationToken query parameter must carry a token issued by
+// POST /api/config/import/confirmation for the same config.
+func (s *Service) PostConfigImportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	confirmationToken := r.URL.Query().Get("confirmationToken")
+	if confirmationToken == "" {
+		logger.Warn().Msg("Config import without confirmation token")
+		http.Error(w, "Confirmation token is required", http.StatusBadRequest)
+		return
+	}
+
+	imported, ok := readConfigImport(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := s.ImportConfigService.Execute(ctx, imported, confirmationToken)
+	if errors.Is(err, service.ErrInvalidConfig) {
+		logger.Warn().Err(err).Msg("Invalid config import")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, service.ErrInvalidConfirmationToken) {
+		http.Error(w, "Invalid or expired confirmation token", http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to import config")
+		http.Error(w, "Failed to import config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().Int("changes", response.Changes).Msg("Successfully imported config")
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostConfigImportConfirmationHandler handles POST /api/config/import/confirmation by validating
+// the config lines in the body, comparing them with the current config and issuing the
+// confirmation token required to import them
+func (s *Service) PostConfigImportConfirmationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	imported, ok := readConfigImport(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := s.PrepareConfigImportService.Execute(ctx, imported)
+	if errors.Is(err, service.ErrInvalidConfig) {
+		logger.Warn().Err(err).Msg("Invalid config import")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to prepare config import")
+		http.Error(w, "Failed to prepare config import", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().Int("changes", response.Total).Msg("Successfully prepared config import")
+}
+
+// readConfigImport reads the config lines of an import from the request body
+func readConfigImport(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	logger := zerolog.Ctx(r.Context())
+
+	imported, err := io.ReadAll(http.MaxBytesReader(w, r.Body, service.MaxConfigSize))
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to read config import")
+		http.Error(w, "Invalid request body. Config must be at most 256 KiB", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return imported, true
+}
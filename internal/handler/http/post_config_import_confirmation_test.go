@@ -0,0 +1,89 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostConfigImportConfirmationHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "diff with confirmation token",
+			requestBody:        "api requests_max=2000 requests_deadline=10s\nscanner speed=default\nnotify_webhook:primary endpoint=https://hooks.example.com/minio auth_token=******** queue_limit=10000\nregion name=us-east-1\n",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"changes":[{"target":"api","key":"requests_max","change":"changed","current":"1000","imported":"2000"}],"total":1`,
+		},
+		{
+			name:               "invalid config",
+			requestBody:        "notify_pigeon enable=on",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "unknown subsystem notify_pigeon",
+		},
+		{
+			name:               "config too large",
+			requestBody:        strings.Repeat("#", 256*1024+1),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Config must be at most 256 KiB",
+		},
+		{
+			name:        "MinIO server error",
+			requestBody: "api requests_max=2000",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to prepare config import",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetServerConfigResponse(scenarios.ServerConfig())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodPost, "/api/config/import/confirmation", strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "text/plain")
+			w := httptest.NewRecorder()
+
+			testService.PostConfigImportConfirmationHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
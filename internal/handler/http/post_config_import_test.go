@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostConfigImportHandler(t *testing.T) {
+	const imported = "api requests_max=2000\n"
+
+	tests := []struct {
+		name               string
+		confirm            bool // Request a confirmation token for the import first
+		token              string
+		requestBody        string
+		expectedStatusCode int
+		expectedBody       string
+		expectedImport     bool
+	}{
+		{
+			name:               "confirmed import",
+			confirm:            true,
+			requestBody:        imported,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"restartRequired":true`,
+			expectedImport:     true,
+		},
+		{
+			name:               "missing confirmation token",
+			requestBody:        imported,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Confirmation token is required",
+		},
+		{
+			name:               "unknown confirmation token",
+			token:              "guessed",
+			requestBody:        imported,
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       "Invalid or expired confirmation token",
+		},
+		{
+			name:               "invalid config",
+			token:              "guessed",
+			requestBody:        "notify_pigeon enable=on",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "unknown subsystem notify_pigeon",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetServerConfigResponse(scenarios.ServerConfig())
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			token := tt.token
+			if tt.confirm {
+				preview, err := testService.PrepareConfigImportService.Execute(ctx, []byte(tt.requestBody))
+				if err != nil {
+					t.Fatalf("Failed to prepare config import: %v", err)
+				}
+				token = preview.ConfirmationToken
+			}
+
+			target := "/api/config/import"
+			if token != "" {
+				target += "?confirmationToken=" + token
+			}
+
+			req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "text/plain")
+			w := httptest.NewRecorder()
+
+			testService.PostConfigImportHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+
+			if got := len(mockServer.Requests("set-config")) == 1; got != tt.expectedImport {
+				t.Errorf("Expected config imported %v, got %v", tt.expectedImport, got)
+			}
+		})
+	}
+}
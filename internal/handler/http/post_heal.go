@@ -0,0 +1,52 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostHealHandler handles POST /api/heal to start a heal sequence on the cluster, a bucket or a prefix
+func (s *Service) PostHealHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	// Parse request body
+	var req service.StartHealRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if strings.TrimSpace(req.Prefix) != "" && strings.TrimSpace(req.Bucket) == "" {
+		logger.Warn().Str("prefix", req.Prefix).Msg("Heal prefix given without bucket")
+		http.Error(w, "Bucket is required when prefix is set", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.StartHealService.Execute(ctx, req)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to start heal")
+		http.Error(w, "Failed to start heal", http.StatusInternalServerError)
+		return
+	}
+
+	// Healing continues in MinIO, progress is polled with the client token
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("clientToken", response.ClientToken).
+		Str("bucket", response.Bucket).
+		Str("prefix", response.Prefix).
+		Msg("Successfully started heal")
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostHealHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedError      string
+	}{
+		{
+			name:        "successful heal start",
+			requestBody: `{"bucket":"media","prefix":"videos/","deepScan":true,"recursive":true}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStartResponse(scenarios.SuccessfulHealStart())
+			},
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:               "invalid request body",
+			requestBody:        `{"bucket":`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Invalid request body",
+		},
+		{
+			name:               "prefix without bucket",
+			requestBody:        `{"prefix":"videos/"}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Bucket is required when prefix is set",
+		},
+		{
+			name:        "MinIO server error",
+			requestBody: `{"bucket":"media"}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetHealError(http.StatusConflict, "Heal already running")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "Failed to start heal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForHeal(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodPost, "/api/heal", strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			testService.PostHealHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				if body := w.Body.String(); !strings.Contains(body, tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, body)
+				}
+				return
+			}
+
+			var response service.HealSequence
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if response.ClientToken != "3f1c1b8e-heal-token" {
+				t.Errorf("Expected ClientToken %q, got %q", "3f1c1b8e-heal-token", response.ClientToken)
+			}
+			if response.Prefix != "videos/" {
+				t.Errorf("Expected Prefix %q, got %q", "videos/", response.Prefix)
+			}
+		})
+	}
+}
+
+// createTestServiceForHeal creates a Service instance for testing heal control
+func createTestServiceForHeal(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	// Create test config
+	cfg := &config.Config{
+		Server: config.Server{
+			Addr: ":8080",
+			Dev:  true,
+		},
+	}
+
+	// Create test logger
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	return &Service{
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			StartHealService:               service.NewStartHealService(minioClient),
+			GetHealStatusService:           service.NewGetHealStatusService(minioClient),
+			StopHealService:                service.NewStopHealService(minioClient),
+			GetBackgroundHealStatusService: service.NewGetBackgroundHealStatusService(minioClient),
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostIAMImportHandler handles POST /api/iam/import to import the IAM zip bundle in the body.
+// Entities which cannot be imported are reported instead of failing the request.
+func (s *Service) PostIAMImportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	bundle, err := io.ReadAll(http.MaxBytesReader(w, r.Body, service.MaxIAMBundleSize))
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to read IAM bundle")
+		http.Error(w, "Invalid request body. IAM bundle must be at most 32 MiB", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.ImportIAMService.Execute(ctx, bundle)
+	if errors.Is(err, service.ErrInvalidIAMBundle) {
+		logger.Warn().Err(err).Msg("Invalid IAM bundle")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to import IAM")
+		http.Error(w, "Failed to import IAM", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().Int("failed", len(response.Failed)).Msg("Successfully imported IAM")
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostIAMImportHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		requestBody        []byte
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       []string
+	}{
+		{
+			name:        "partial import",
+			requestBody: scenarios.IAMExportBundle(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMImportResponse(scenarios.PartialIAMImport())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: []string{
+				`"policies":["readonly-reports"]`,
+				`"groupPolicies":{"auditors":["audit-policy"]}`,
+				`"failed":[{"entity":"user","name":"alice"}]`,
+			},
+		},
+		{
+			name:               "invalid bundle",
+			requestBody:        []byte("not a zip"),
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       []string{"invalid IAM bundle: not a zip archive"},
+		},
+		{
+			name:        "MinIO server error",
+			requestBody: scenarios.IAMExportBundle(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       []string{"Failed to import IAM"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIAM(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodPost, "/api/iam/import", bytes.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/zip")
+			w := httptest.NewRecorder()
+
+			testService.PostIAMImportHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			body := w.Body.String()
+			for _, expected := range tt.expectedBody {
+				if !strings.Contains(body, expected) {
+					t.Errorf("Expected body containing %q, got %q", expected, body)
+				}
+			}
+		})
+	}
+}
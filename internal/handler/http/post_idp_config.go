@@ -0,0 +1,70 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostIDPConfigHandler handles POST /api/idp/{type}/{name} to add an identity provider config
+func (s *Service) PostIDPConfigHandler(w http.ResponseWriter, r *http.Request) {
+	s.setIDPConfig(w, r, false)
+}
+
+// PutIDPConfigHandler handles PUT /api/idp/{type}/{name} to edit keys of an identity provider config
+func (s *Service) PutIDPConfigHandler(w http.ResponseWriter, r *http.Request) {
+	s.setIDPConfig(w, r, true)
+}
+
+func (s *Service) setIDPConfig(w http.ResponseWriter, r *http.Request, update bool) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	idpType, name, ok := parseIDPConfigParams(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req service.SetIDPConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	req.Type = idpType
+	req.Name = name
+	req.Update = update
+
+	response, err := s.SetIDPConfigService.Execute(ctx, req)
+	if errors.Is(err, service.ErrInvalidConfig) {
+		logger.Warn().Err(err).Str("type", idpType).Str("name", name).Msg("Invalid IDP config")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("type", idpType).Str("name", name).Msg("Failed to set IDP config")
+		http.Error(w, "Failed to set IDP config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !update {
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("type", idpType).
+		Str("name", name).
+		Bool("update", update).
+		Bool("restartRequired", response.RestartRequired).
+		Msg("Successfully set IDP config")
+}
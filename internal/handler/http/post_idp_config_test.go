@@ -0,0 +1,125 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostIDPConfigHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		method             string
+		idpType            string
+		configName         string
+		requestBody        string
+		expectedStatusCode int
+		expectedBody       string
+		expectedOperation  string
+	}{
+		{
+			name:               "add OpenID config",
+			method:             http.MethodPost,
+			idpType:            "openid",
+			configName:         "keycloak",
+			requestBody:        `{"values":{"config_url":"https://sso.example.com/.well-known/openid-configuration","client_id":"minio"}}`,
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"type":"openid","name":"keycloak","restartRequired":false}`,
+			expectedOperation:  "add-idp-config",
+		},
+		{
+			name:               "edit LDAP config",
+			method:             http.MethodPut,
+			idpType:            "ldap",
+			configName:         "_",
+			requestBody:        `{"values":{"server_addr":"ldap2.example.com:636","lookup_bind_password":"********"}}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"type":"ldap","name":"_","restartRequired":false}`,
+			expectedOperation:  "update-idp-config",
+		},
+		{
+			name:               "named LDAP config",
+			method:             http.MethodPost,
+			idpType:            "ldap",
+			configName:         "corp",
+			requestBody:        `{"values":{"enable":"on"}}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "LDAP only supports the default config",
+		},
+		{
+			name:               "unknown key",
+			method:             http.MethodPut,
+			idpType:            "openid",
+			configName:         "keycloak",
+			requestBody:        `{"values":{"redirect":"on"}}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "unknown key redirect",
+		},
+		{
+			name:               "invalid request body",
+			method:             http.MethodPost,
+			idpType:            "openid",
+			configName:         "keycloak",
+			requestBody:        `{"values":`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetConfigHelpResponse("identity_ldap", scenarios.LDAPConfigHelp())
+			mockServer.SetConfigHelpResponse("identity_openid", scenarios.OpenIDConfigHelp())
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			// Setup chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("type", tt.idpType)
+			rctx.URLParams.Add("name", tt.configName)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+			req := httptest.NewRequest(tt.method, "/api/idp/"+tt.idpType+"/"+tt.configName, strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tt.method == http.MethodPut {
+				testService.PutIDPConfigHandler(w, req)
+			} else {
+				testService.PostIDPConfigHandler(w, req)
+			}
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+
+			if tt.expectedOperation != "" && len(mockServer.Requests(tt.expectedOperation)) != 1 {
+				t.Errorf("Expected one %s request", tt.expectedOperation)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// PostKMSKeysHandler handles POST /api/kms/keys to create a KMS key and check that MinIO can use it
+func (s *Service) PostKMSKeysHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	var req service.CreateKMSKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.CreateKMSKeyService.Execute(ctx, req)
+	if errors.Is(err, service.ErrInvalidKMSKeyID) {
+		logger.Warn().Err(err).Msg("Invalid KMS key ID")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("keyId", req.KeyID).Msg("Failed to create KMS key")
+		http.Error(w, "Failed to create KMS key", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().Str("keyId", response.KeyID).Msg("Successfully created KMS key")
+}
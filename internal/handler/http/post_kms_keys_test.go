@@ -0,0 +1,92 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostKMSKeysHandler(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "successful creation",
+			requestBody: `{"keyId":"tenant-b-key"}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"keyId":"tenant-b-key","check":{"keyId":"tenant-b-key","usable":true}}`,
+		},
+		{
+			name:               "invalid key ID",
+			requestBody:        `{"keyId":"tenant b"}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "invalid KMS key ID",
+		},
+		{
+			name:               "invalid request body",
+			requestBody:        `{"keyId":`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+		{
+			name:        "existing key",
+			requestBody: `{"keyId":"tenant-a-key"}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to create KMS key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForKMS(t, minioClient)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			req := httptest.NewRequest(http.MethodPost, "/api/kms/keys", strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			testService.PostKMSKeysHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// PostLDAPPolicyHandler handles POST /api/ldap/policy/{action} to attach or detach policies of an LDAP user or group
+func (s *Service) PostLDAPPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	action := chi.URLParam(r, "action")
+	if !service.ValidLDAPPolicyAction(action) {
+		logger.Warn().Str("action", action).Msg("Invalid LDAP policy action")
+		http.Error(w, "Invalid action. Must be attach or detach", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req service.LDAPPolicyMappingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	req.Action = action
+
+	response, err := s.UpdateLDAPPolicyMappingService.Execute(ctx, req)
+	if errors.Is(err, service.ErrInvalidLDAPPolicyMapping) {
+		logger.Warn().Err(err).Str("action", action).Msg("Invalid LDAP policy mapping")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("action", action).Msg("Failed to update LDAP policy mapping")
+		http.Error(w, "Failed to "+action+" LDAP policy", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("action", action).
+		Str("user", req.User).
+		Str("group", req.Group).
+		Msg("Successfully updated LDAP policy mapping")
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PostLDAPPolicyHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		action             string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "attach policy to user",
+			action:      "attach",
+			requestBody: `{"user":"uid=alice,ou=people,dc=example,dc=com","policies":["readwrite"]}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPPolicyAssociationResponse(madmin.PolicyAssociationResp{
+					PoliciesAttached: []string{"readwrite"},
+					UpdatedAt:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"attached":["readwrite"],"detached":[],"updatedAt":"2025-01-01T00:00:00Z"}`,
+		},
+		{
+			name:               "invalid action",
+			action:             "replace",
+			requestBody:        `{"user":"uid=alice","policies":["readwrite"]}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid action",
+		},
+		{
+			name:               "missing user and group",
+			action:             "detach",
+			requestBody:        `{"policies":["readwrite"]}`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "no user or group association was given",
+		},
+		{
+			name:               "invalid request body",
+			action:             "attach",
+			requestBody:        `{"policies":`,
+			setupMock:          func(mock *minio.MockMinIOServer) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+		{
+			name:        "MinIO server error",
+			action:      "detach",
+			requestBody: `{"group":"cn=admins,ou=groups,dc=example,dc=com","policies":["consoleAdmin"]}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(http.StatusBadRequest, "policy change is already in effect")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to detach LDAP policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForIDP(t, minioClient)
+
+			// Setup chi URL parameters
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("action", tt.action)
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+			req := httptest.NewRequest(http.MethodPost, "/api/ldap/policy/"+tt.action, strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			testService.PostLDAPPolicyHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// errRevealLinksDisabled is returned when a reveal link is requested but the feature is disabled
+var errRevealLinksDisabled = errors.New("reveal links are not enabled")
+
+// PostRevealHandler handles POST /api/reveals/{revealId} to view the secret of a one-time reveal link.
+// The key from the link fragment is sent in the body, the reveal is burned by the first request.
+func (s *Service) PostRevealHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	revealID := strings.TrimSpace(chi.URLParam(r, "revealId"))
+	if revealID == "" {
+		logger.Error().Msg("Reveal ID parameter is required")
+		http.Error(w, "Reveal ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var body struct {
+		Key string `json:"key"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Key == "" {
+		logger.Error().Err(err).Str("reveal", revealID).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body, the reveal key is required", http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.OpenSecretRevealService.Execute(ctx, revealID, body.Key)
+	switch {
+	case errors.Is(err, service.ErrRevealNotFound):
+		logger.Warn().Str("reveal", revealID).Msg("Reveal link not found")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, service.ErrInvalidRevealKey):
+		logger.Warn().Str("reveal", revealID).Msg("Invalid reveal key")
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case err != nil:
+		logger.Error().Err(err).Str("reveal", revealID).Msg("Failed to open reveal link")
+		http.Error(w, "Failed to open reveal link", http.StatusInternalServerError)
+		return
+	}
+
+	// The secret must not be kept by caches
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode reveal response")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// revealSecret replaces the secret by a one-time reveal link
+func (s *Service) revealSecret(ctx context.Context, secret *string) (*service.RevealLink, error) {
+	if s.CreateSecretRevealService == nil {
+		return nil, errRevealLinksDisabled
+	}
+
+	link, err := s.CreateSecretRevealService.Execute(ctx, *secret)
+	if err != nil {
+		return nil, err
+	}
+
+	*secret = ""
+	return link, nil
+}
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// createTestServiceForReveals creates a Service with reveal links backed by a temporary database
+func createTestServiceForReveals(t *testing.T, minioClient *madmin.AdminClient) *Service {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	reveals, err := store.NewReveals(db)
+	if err != nil {
+		t.Fatalf("Failed to create reveals: %v", err)
+	}
+
+	return &Service{
+		config: &config.Config{
+			Server: config.Server{
+				Addr: ":8080",
+				Dev:  true,
+			},
+		},
+		logger: zerolog.New(zerolog.NewTestWriter(t)),
+		Services: Services{
+			AddServiceAccountService:  service.NewAddServiceAccountService(minioClient, service.DefaultCredentialRules()),
+			CreateSecretRevealService: service.NewCreateSecretRevealService(reveals, time.Hour, "https://admin.example.com"),
+			OpenSecretRevealService:   service.NewOpenSecretRevealService(reveals),
+		},
+	}
+}
+
+// openTestReveal posts the key of a reveal link to the reveal handler
+func openTestReveal(t *testing.T, testService *Service, revealID string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/reveals/"+revealID, strings.NewReader(body))
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("revealId", revealID)
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	req = req.WithContext(zerolog.New(zerolog.NewTestWriter(t)).WithContext(req.Context()))
+	w := httptest.NewRecorder()
+
+	testService.PostRevealHandler(w, req)
+	return w
+}
+
+func TestService_PostRevealHandler(t *testing.T) {
+	// Setup mock MinIO server
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	// Create MinIO client
+	minioClient, err := mockServer.CreateMinIOClient()
+	if err != nil {
+		t.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	testService := createTestServiceForReveals(t, minioClient)
+
+	// Create a service account with a reveal link instead of the secret key
+	req := httptest.NewRequest(http.MethodPost, "/api/access-keys", strings.NewReader(`{"name":"shared","secretKey":"wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY","revealLink":true}`))
+	req = req.WithContext(zerolog.New(zerolog.NewTestWriter(t)).WithContext(req.Context()))
+	w := httptest.NewRecorder()
+
+	testService.PostAccessKeysHandler(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if strings.Contains(w.Body.String(), "wJalrXUtnFEMI") {
+		t.Fatalf("Expected response without the secret key, got %q", w.Body.String())
+	}
+
+	var created service.CreateServiceAccountResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if created.Reveal == nil {
+		t.Fatal("Expected reveal link, got nil")
+	}
+
+	revealID, key, found := strings.Cut(strings.TrimPrefix(created.Reveal.URL, "https://admin.example.com/reveal/"), "#")
+	if !found {
+		t.Fatalf("Expected reveal URL with key fragment, got %q", created.Reveal.URL)
+	}
+
+	tests := []struct {
+		name               string
+		revealID           string
+		requestBody        string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "missing key",
+			revealID:           revealID,
+			requestBody:        `{}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "the reveal key is required",
+		},
+		{
+			name:               "unknown link",
+			revealID:           "unknown",
+			requestBody:        `{"key":"` + key + `"}`,
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       "does not exist",
+		},
+		{
+			name:               "first view",
+			revealID:           revealID,
+			requestBody:        `{"key":"` + key + `"}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"secret":"wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"`,
+		},
+		{
+			name:               "burned after first view",
+			revealID:           revealID,
+			requestBody:        `{"key":"` + key + `"}`,
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       "already used",
+		},
+	}
+
+	// Cases run in order, the link is burned by the first view
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := openTestReveal(t, testService, tt.revealID, tt.requestBody)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
+
+func TestService_PostRevealHandler_WrongKey(t *testing.T) {
+	testService := createTestServiceForReveals(t, nil)
+
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+	link, err := testService.CreateSecretRevealService.Execute(ctx, "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY")
+	if err != nil {
+		t.Fatalf("Failed to create reveal link: %v", err)
+	}
+	revealID, _, _ := strings.Cut(strings.TrimPrefix(link.URL, "https://admin.example.com/reveal/"), "#")
+
+	w := openTestReveal(t, testService, revealID, `{"key":"`+strings.Repeat("A", 43)+`"}`)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected status code %d, got %d", http.StatusForbidden, w.Code)
+	}
+
+	// A wrong key burns the link as well
+	w = openTestReveal(t, testService, revealID, `{"key":"`+strings.Repeat("A", 43)+`"}`)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
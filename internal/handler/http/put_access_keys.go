@@ -53,7 +53,7 @@ func (svc *Service) PutAccessKeysHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Execute service request
-	response, err := svc.updateServiceAccountService.Execute(ctx, serviceReq)
+	response, err := svc.UpdateServiceAccountService.Execute(ctx, serviceReq)
 	if err != nil {
 		logger.Error().
 			Err(err).
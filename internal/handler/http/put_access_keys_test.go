@@ -251,7 +251,7 @@ func TestService_PutAccessKeysHandler(t *testing.T) {
 			}
 
 			// Create service dependencies
-			updateServiceAccountService := service.NewUpdateServiceAccountService(minioClient)
+			updateServiceAccountService := service.NewUpdateServiceAccountService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL))
 
 			// Create HTTP service
 			testService := createTestServiceForUpdate(t, updateServiceAccountService)
@@ -401,7 +401,7 @@ func TestService_PutAccessKeysHandler_UrlParameterEdgeCases(t *testing.T) {
 			}
 
 			// Create service dependencies
-			updateServiceAccountService := service.NewUpdateServiceAccountService(minioClient)
+			updateServiceAccountService := service.NewUpdateServiceAccountService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL))
 
 			// Create HTTP service
 			testService := createTestServiceForUpdate(t, updateServiceAccountService)
@@ -455,9 +455,11 @@ func createTestServiceForUpdate(
 
 	// Create service with test dependencies
 	return &Service{
-		config:                      cfg,
-		logger:                      logger,
-		updateServiceAccountService: updateServiceAccountService,
+		config: cfg,
+		logger: logger,
+		Services: Services{
+			UpdateServiceAccountService: updateServiceAccountService,
+		},
 	}
 }
 
@@ -488,7 +490,7 @@ func TestService_PutAccessKeysHandler_Integration(t *testing.T) {
 	}
 
 	// Create service dependencies
-	updateServiceAccountService := service.NewUpdateServiceAccountService(minioClient)
+	updateServiceAccountService := service.NewUpdateServiceAccountService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL))
 
 	// Create full service
 	testService := createTestServiceForUpdate(t, updateServiceAccountService)
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// PutConfigSubsystemHandler handles PUT /api/config/{subSystem} to change keys of the default or a named target
+func (s *Service) PutConfigSubsystemHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	subSystem := chi.URLParam(r, "subSystem")
+	if !service.ValidConfigSubsystem(subSystem) {
+		logger.Warn().Str("subSystem", subSystem).Msg("Invalid config subsystem")
+		http.Error(w, "Invalid config subsystem", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req service.SetConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	req.SubSystem = subSystem
+
+	response, err := s.SetConfigSubsystemService.Execute(ctx, req)
+	if errors.Is(err, service.ErrInvalidConfig) {
+		logger.Warn().Err(err).Str("subSystem", subSystem).Msg("Invalid config change")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", subSystem).Msg("Failed to set config")
+		http.Error(w, "Failed to set config", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode response")
+		return
+	}
+
+	logger.Info().
+		Str("subSystem", subSystem).
+		Str("target", req.Target).
+		Bool("restartRequired", response.RestartRequired).
+		Msg("Successfully set config")
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestService_PutConfigSubsystemHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		subSystem          string
+		requestBody        string
+		setupMock          func(*minio.MockMinIOServer)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "successful change requiring restart",
+			subSystem:   "notify_webhook",
+			requestBody: `{"target":"primary","values":{"enable":"on"}}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigRestartRequired(true)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"restartRequired":true`,
+		},
+		{
+			name:               "validation error",
+			subSystem:          "notify_webhook",
+			requestBody:        `{"values":{"endpoint":"not a url"}}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "value of endpoint must be an absolute URL",
+		},
+		{
+			name:               "invalid subsystem",
+			subSystem:          "notify_pigeon",
+			requestBody:        `{"values":{"enable":"on"}}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid config subsystem",
+		},
+		{
+			name:               "invalid request body",
+			subSystem:          "notify_webhook",
+			requestBody:        `{"values":`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+		{
+			name:        "MinIO server error",
+			subSystem:   "notify_webhook",
+			requestBody: `{"values":{"enable":"on"}}`,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(http.StatusForbidden, "Access Denied")
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "Failed to set config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetConfigHelpResponse("notify_webhook", scenarios.NotifyWebhookConfigHelp())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create HTTP service
+			testService := createTestServiceForConfig(t, minioClient)
+
+			// Set the subsystem URL parameter
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("subSystem", tt.subSystem)
+			ctx := context.WithValue(context.Background(), chi.RouteCtxKey, rctx)
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/config/"+tt.subSystem, strings.NewReader(tt.requestBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			testService.PutConfigSubsystemHandler(w, req)
+
+			// Validate status code
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if body := w.Body.String(); !strings.Contains(body, tt.expectedBody) {
+				t.Errorf("Expected body containing %q, got %q", tt.expectedBody, body)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package http
 
 import (
 	"embed"
+	"errors"
 	"net/http"
 
 	"github.com/elct9620/minio-lite-admin/internal/config"
+	"github.com/elct9620/minio-lite-admin/internal/metrics"
 	"github.com/elct9620/minio-lite-admin/internal/service"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -13,38 +15,104 @@ import (
 
 // Service handles all HTTP requests and contains all dependencies
 type Service struct {
-	config                      *config.Config
-	logger                      zerolog.Logger
-	getServerInfoService        *service.GetServerInfoService
-	listAccessKeysService       *service.ListAccessKeysService
-	addServiceAccountService    *service.AddServiceAccountService
-	deleteServiceAccountService *service.DeleteServiceAccountService
-	updateServiceAccountService *service.UpdateServiceAccountService
-	distFS                      embed.FS
+	Services // Services of the cluster served by the API router, empty for the cluster independent routes
+
+	config                    *config.Config
+	logger                    zerolog.Logger
+	getClustersSummaryService *service.GetClustersSummaryService
+	metrics                   *metrics.Metrics
+	distFS                    embed.FS
+}
+
+// Services are the services bound to a single MinIO cluster.
+// GetUsageHistoryService, ListExpirySweepsService, the rotation and the reveal services may be nil when the related feature is disabled.
+type Services struct {
+	GetServerInfoService           *service.GetServerInfoService
+	ListAccessKeysService          *service.ListAccessKeysService
+	AddServiceAccountService       *service.AddServiceAccountService
+	DeleteServiceAccountService    *service.DeleteServiceAccountService
+	UpdateServiceAccountService    *service.UpdateServiceAccountService
+	BulkAccessKeysService          *service.BulkAccessKeysService
+	ListExpirySweepsService        *service.ListExpirySweepsService
+	StartAccessKeyRotationService  *service.StartAccessKeyRotationService
+	ListAccessKeyRotationsService  *service.ListAccessKeyRotationsService
+	CreateSecretRevealService      *service.CreateSecretRevealService
+	OpenSecretRevealService        *service.OpenSecretRevealService
+	RenderCredentialsService       *service.RenderCredentialsService
+	SimulateAccessKeyPolicyService *service.SimulateAccessKeyPolicyService
+	GetLogsService                 *service.GetLogsService
+	GetClusterMetricsService       *service.GetClusterMetricsService
+	GetUsageHistoryService         *service.GetUsageHistoryService
+	GetBucketUsageService          *service.GetBucketUsageService
+	StartHealService               *service.StartHealService
+	GetHealStatusService           *service.GetHealStatusService
+	StopHealService                *service.StopHealService
+	GetBackgroundHealStatusService *service.GetBackgroundHealStatusService
+	PrepareClusterActionService    *service.PrepareClusterActionService
+	RunClusterActionService        *service.RunClusterActionService
+	ListConfigSubsystemsService    *service.ListConfigSubsystemsService
+	GetConfigSubsystemService      *service.GetConfigSubsystemService
+	SetConfigSubsystemService      *service.SetConfigSubsystemService
+	DeleteConfigSubsystemService   *service.DeleteConfigSubsystemService
+	ListConfigHistoryService       *service.ListConfigHistoryService
+	RestoreConfigHistoryService    *service.RestoreConfigHistoryService
+	ExportConfigService            *service.ExportConfigService
+	PrepareConfigImportService     *service.PrepareConfigImportService
+	ImportConfigService            *service.ImportConfigService
+	ExportIAMService               *service.ExportIAMService
+	ImportIAMService               *service.ImportIAMService
+	ExportBucketMetadataService    *service.ExportBucketMetadataService
+	ImportBucketMetadataService    *service.ImportBucketMetadataService
+	GetKMSStatusService            *service.GetKMSStatusService
+	ListKMSKeysService             *service.ListKMSKeysService
+	CreateKMSKeyService            *service.CreateKMSKeyService
+	DescribeKMSKeyService          *service.DescribeKMSKeyService
+	ListIDPConfigsService          *service.ListIDPConfigsService
+	GetIDPConfigService            *service.GetIDPConfigService
+	SetIDPConfigService            *service.SetIDPConfigService
+	DeleteIDPConfigService         *service.DeleteIDPConfigService
+	CheckLDAPConfigService         *service.CheckLDAPConfigService
+	LookupLDAPUserService          *service.LookupLDAPUserService
+	ListLDAPPolicyMappingsService  *service.ListLDAPPolicyMappingsService
+	UpdateLDAPPolicyMappingService *service.UpdateLDAPPolicyMappingService
 }
 
-// NewService creates a new HTTP service with all dependencies and returns the configured router
+// ServicesFactory creates the services bound to the named cluster
+type ServicesFactory func(cluster string) (Services, error)
+
+// NewService creates a new HTTP service with all dependencies and returns the configured router.
+// The first of clusters is the default cluster, the services of each cluster are created on first use.
+// metrics may be nil when the related feature is disabled.
 func NewService(
 	cfg *config.Config,
 	logger zerolog.Logger,
-	getServerInfoService *service.GetServerInfoService,
-	listAccessKeysService *service.ListAccessKeysService,
-	addServiceAccountService *service.AddServiceAccountService,
-	deleteServiceAccountService *service.DeleteServiceAccountService,
-	updateServiceAccountService *service.UpdateServiceAccountService,
+	clusters []string,
+	newServices ServicesFactory,
+	getClustersSummaryService *service.GetClustersSummaryService,
+	metrics *metrics.Metrics,
 	distFS embed.FS,
 ) (http.Handler, error) {
+	if len(clusters) == 0 {
+		return nil, errors.New("at least one cluster is required")
+	}
+
 	svc := &Service{
-		config:                      cfg,
-		logger:                      logger,
-		getServerInfoService:        getServerInfoService,
-		listAccessKeysService:       listAccessKeysService,
-		addServiceAccountService:    addServiceAccountService,
-		deleteServiceAccountService: deleteServiceAccountService,
-		updateServiceAccountService: updateServiceAccountService,
-		distFS:                      distFS,
+		config:                    cfg,
+		logger:                    logger,
+		getClustersSummaryService: getClustersSummaryService,
+		metrics:                   metrics,
+		distFS:                    distFS,
 	}
 
+	apiRouters := newClusterRouters(clusters, func(cluster string) (http.Handler, error) {
+		services, err := newServices(cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		return newClusterService(cfg, logger, services, metrics, distFS).apiRouter(), nil
+	})
+
 	router := chi.NewRouter()
 
 	// Add middleware
@@ -53,15 +121,21 @@ func NewService(
 	router.Use(ContextLogger(logger))
 	router.Use(Logger())
 
-	// API routes
+	// Metrics are optional, nil when disabled in the configuration
+	if metrics != nil {
+		router.Use(Metrics(metrics))
+		router.Handle(cfg.Metrics.Path, metrics.Handler())
+	}
+
+	// API routes, the target cluster is selected by the path prefix or the cluster header
 	router.Route("/api", func(r chi.Router) {
-		r.Get("/health", svc.GetHealthHandler)
-		r.Get("/server-info", svc.GetServerInfoHandler)
-		r.Get("/data-usage", svc.GetDataUsageHandler)
-		r.Get("/access-keys", svc.GetAccessKeysHandler)
-		r.Post("/access-keys", svc.PostAccessKeysHandler)
-		r.Put("/access-keys/{accessKey}", svc.PutAccessKeysHandler)
-		r.Delete("/access-keys/{accessKey}", svc.DeleteAccessKeysHandler)
+		r.Get("/clusters", svc.GetClustersHandler)
+		r.Mount("/clusters/{cluster}", apiRouters.Handler(func(r *http.Request) string {
+			return chi.URLParam(r, "cluster")
+		}))
+		r.Mount("/", apiRouters.Handler(func(r *http.Request) string {
+			return r.Header.Get(ClusterHeader)
+		}))
 	})
 
 	// Frontend routes
@@ -69,3 +143,83 @@ func NewService(
 
 	return router, nil
 }
+
+// newClusterService creates the HTTP service serving the API of a single cluster
+func newClusterService(cfg *config.Config, logger zerolog.Logger, services Services, metrics *metrics.Metrics, distFS embed.FS) *Service {
+	return &Service{
+		Services: services,
+		config:   cfg,
+		logger:   logger,
+		metrics:  metrics,
+		distFS:   distFS,
+	}
+}
+
+// apiRouter creates the router of the API routes relative to the /api prefix
+func (s *Service) apiRouter() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/health", s.GetHealthHandler)
+	r.Get("/server-info", s.GetServerInfoHandler)
+	r.Get("/data-usage", s.GetDataUsageHandler)
+	r.Get("/data-usage/buckets", s.GetBucketUsageHandler)
+	// Usage history is optional, nil when disabled in the configuration
+	if s.GetUsageHistoryService != nil {
+		r.Get("/data-usage/history", s.GetDataUsageHistoryHandler)
+	}
+	r.Get("/buckets/metadata/export", s.GetBucketMetadataExportHandler)
+	r.Post("/buckets/metadata/import", s.PostBucketMetadataImportHandler)
+	r.Get("/metrics", s.GetMetricsHandler)
+	r.Get("/access-keys", s.GetAccessKeysHandler)
+	r.Post("/access-keys", s.PostAccessKeysHandler)
+	r.Post("/access-keys/bulk", s.PostAccessKeysBulkHandler)
+	// Expiry sweeps are optional, nil when disabled in the configuration
+	if s.ListExpirySweepsService != nil {
+		r.Get("/access-keys/expiry/sweeps", s.GetAccessKeysExpirySweepsHandler)
+	}
+	// Rotations are optional, nil when disabled in the configuration
+	if s.StartAccessKeyRotationService != nil {
+		r.Get("/access-keys/rotations", s.GetAccessKeyRotationsHandler)
+		r.Post("/access-keys/{accessKey}/rotation", s.PostAccessKeyRotationHandler)
+	}
+	// Reveal links are optional, nil when disabled in the configuration
+	if s.OpenSecretRevealService != nil {
+		r.Post("/reveals/{revealId}", s.PostRevealHandler)
+	}
+	r.Post("/access-keys/{accessKey}/simulate", s.PostAccessKeySimulateHandler)
+	r.Put("/access-keys/{accessKey}", s.PutAccessKeysHandler)
+	r.Delete("/access-keys/{accessKey}", s.DeleteAccessKeysHandler)
+	r.Get("/logs", s.GetLogsHandler)
+	r.Post("/heal", s.PostHealHandler)
+	r.Delete("/heal", s.DeleteHealHandler)
+	r.Get("/heal/background", s.GetBackgroundHealHandler)
+	r.Get("/heal/{clientToken}", s.GetHealStatusHandler)
+	r.Get("/heal/{clientToken}/events", s.GetHealEventsHandler)
+	r.Post("/cluster/{action}/confirmation", s.PostClusterActionConfirmationHandler)
+	r.Post("/cluster/{action}", s.PostClusterActionHandler)
+	r.Get("/config", s.GetConfigHandler)
+	r.Get("/config/export", s.GetConfigExportHandler)
+	r.Post("/config/import/confirmation", s.PostConfigImportConfirmationHandler)
+	r.Post("/config/import", s.PostConfigImportHandler)
+	r.Get("/config/history", s.GetConfigHistoryHandler)
+	r.Post("/config/history/{restoreId}/restore", s.PostConfigHistoryRestoreHandler)
+	r.Get("/config/{subSystem}", s.GetConfigSubsystemHandler)
+	r.Put("/config/{subSystem}", s.PutConfigSubsystemHandler)
+	r.Delete("/config/{subSystem}", s.DeleteConfigSubsystemHandler)
+	r.Get("/kms/status", s.GetKMSStatusHandler)
+	r.Get("/kms/keys", s.GetKMSKeysHandler)
+	r.Post("/kms/keys", s.PostKMSKeysHandler)
+	r.Get("/kms/keys/{keyId}", s.GetKMSKeyHandler)
+	r.Get("/idp", s.GetIDPConfigsHandler)
+	r.Get("/idp/{type}/{name}", s.GetIDPConfigHandler)
+	r.Post("/idp/{type}/{name}", s.PostIDPConfigHandler)
+	r.Put("/idp/{type}/{name}", s.PutIDPConfigHandler)
+	r.Delete("/idp/{type}/{name}", s.DeleteIDPConfigHandler)
+	r.Get("/idp/{type}/{name}/check", s.GetIDPConfigCheckHandler)
+	r.Get("/ldap/user", s.GetLDAPUserHandler)
+	r.Get("/ldap/policy-mappings", s.GetLDAPPolicyMappingsHandler)
+	r.Post("/ldap/policy/{action}", s.PostLDAPPolicyHandler)
+	r.Get("/iam/export", s.GetIAMExportHandler)
+	r.Post("/iam/import", s.PostIAMImportHandler)
+
+	return r
+}
@@ -36,10 +36,12 @@ func testService() *Service {
 	var distFS embed.FS
 
 	return &Service{
-		config:               cfg,
-		logger:               logger,
-		getServerInfoService: getServerInfoService,
-		distFS:               distFS,
+		config: cfg,
+		logger: logger,
+		distFS: distFS,
+		Services: Services{
+			GetServerInfoService: getServerInfoService,
+		},
 	}
 }
 
@@ -78,10 +80,12 @@ func testServiceWithMockMinIO() (*Service, *minio.MockMinIOServer) {
 	var distFS embed.FS
 
 	svc := &Service{
-		config:               cfg,
-		logger:               logger,
-		getServerInfoService: getServerInfoService,
-		distFS:               distFS,
+		config: cfg,
+		logger: logger,
+		distFS: distFS,
+		Services: Services{
+			GetServerInfoService: getServerInfoService,
+		},
 	}
 
 	return svc, mockMinIO
@@ -117,17 +121,19 @@ func testServiceWithMockMinIOAndAccessKeys() (*Service, *minio.MockMinIOServer)
 
 	// Create services with mock MinIO client
 	getServerInfoService := service.NewGetServerInfoService(minioClient)
-	listAccessKeysService := service.NewListAccessKeysService(minioClient)
+	listAccessKeysService := service.NewListAccessKeysService(minioClient, service.NewServiceAccountCache(service.DefaultServiceAccountCacheTTL))
 
 	// Create empty embed.FS for testing
 	var distFS embed.FS
 
 	svc := &Service{
-		config:                cfg,
-		logger:                logger,
-		getServerInfoService:  getServerInfoService,
-		listAccessKeysService: listAccessKeysService,
-		distFS:                distFS,
+		config: cfg,
+		logger: logger,
+		distFS: distFS,
+		Services: Services{
+			GetServerInfoService:  getServerInfoService,
+			ListAccessKeysService: listAccessKeysService,
+		},
 	}
 
 	return svc, mockMinIO
@@ -12,9 +12,13 @@ import (
 )
 
 type MinIOConfig struct {
+	Name     string // Name of the cluster, only used by MinIOClientRegistry
 	URL      string
 	RootUser string
 	Password string
+
+	// WrapTransport optionally wraps the default transport, e.g. to instrument upstream calls
+	WrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 func NewMinIOClient(cfg MinIOConfig) (*madmin.AdminClient, error) {
@@ -26,10 +30,16 @@ func NewMinIOClient(cfg MinIOConfig) (*madmin.AdminClient, error) {
 	useSSL := endpoint.Scheme == "https"
 	host := endpoint.Host
 
-	client, err := madmin.NewWithOptions(host, &madmin.Options{
+	opts := &madmin.Options{
 		Creds:  credentials.NewStaticV4(cfg.RootUser, cfg.Password, ""),
 		Secure: useSSL,
-	})
+	}
+
+	if cfg.WrapTransport != nil {
+		opts.Transport = cfg.WrapTransport(madmin.DefaultTransport(useSSL))
+	}
+
+	client, err := madmin.NewWithOptions(host, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create MinIO admin client: %w", err)
 	}
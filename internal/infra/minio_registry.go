@@ -0,0 +1,86 @@
+package infra
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+var (
+	ErrUnknownCluster     = errors.New("unknown MinIO cluster")
+	ErrInvalidClusterName = errors.New("invalid MinIO cluster name")
+)
+
+var clusterNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// MinIOClientRegistry creates the admin client of each named cluster on first use
+type MinIOClientRegistry struct {
+	names   []string
+	configs map[string]MinIOConfig
+
+	mu      sync.Mutex
+	clients map[string]*madmin.AdminClient
+}
+
+// NewMinIOClientRegistry creates a registry of named clusters, the first one is the default cluster
+func NewMinIOClientRegistry(configs []MinIOConfig) (*MinIOClientRegistry, error) {
+	if len(configs) == 0 {
+		return nil, errors.New("at least one MinIO cluster is required")
+	}
+
+	registry := &MinIOClientRegistry{
+		names:   make([]string, 0, len(configs)),
+		configs: make(map[string]MinIOConfig, len(configs)),
+		clients: make(map[string]*madmin.AdminClient, len(configs)),
+	}
+
+	for _, cfg := range configs {
+		if !clusterNamePattern.MatchString(cfg.Name) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidClusterName, cfg.Name)
+		}
+		if _, exists := registry.configs[cfg.Name]; exists {
+			return nil, fmt.Errorf("duplicate MinIO cluster name: %q", cfg.Name)
+		}
+
+		registry.names = append(registry.names, cfg.Name)
+		registry.configs[cfg.Name] = cfg
+	}
+
+	return registry, nil
+}
+
+// Names returns the cluster names in configuration order
+func (r *MinIOClientRegistry) Names() []string {
+	return append([]string(nil), r.names...)
+}
+
+// Default returns the name of the default cluster
+func (r *MinIOClientRegistry) Default() string {
+	return r.names[0]
+}
+
+// Client returns the admin client of the named cluster, creating it when first requested
+func (r *MinIOClientRegistry) Client(name string) (*madmin.AdminClient, error) {
+	cfg, ok := r.configs[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCluster, name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, ok := r.clients[name]; ok {
+		return client, nil
+	}
+
+	client, err := NewMinIOClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client of cluster %q: %w", name, err)
+	}
+
+	r.clients[name] = client
+	return client, nil
+}
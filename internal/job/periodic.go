@@ -0,0 +1,40 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Periodic runs a task on a fixed interval until its context is cancelled
+type Periodic struct {
+	Name     string
+	Interval time.Duration
+	Task     func(ctx context.Context) error
+}
+
+// Run executes the task once immediately and then on every interval, blocking until ctx is done.
+// Failures are logged and do not stop later runs.
+func (p Periodic) Run(ctx context.Context) {
+	logger := zerolog.Ctx(ctx).With().Str("job", p.Name).Logger()
+	ctx = logger.WithContext(ctx)
+
+	logger.Info().Dur("interval", p.Interval).Msg("Background job started")
+
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Task(ctx); err != nil && ctx.Err() == nil {
+			logger.Error().Err(err).Msg("Background job failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info().Msg("Background job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
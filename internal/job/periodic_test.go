@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestPeriodic_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		taskErr error
+	}{
+		{
+			name: "runs repeatedly until cancelled",
+		},
+		{
+			name:    "keeps running after failures",
+			taskErr: errors.New("upstream unavailable"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background()))
+			defer cancel()
+
+			var runs atomic.Int32
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+				Periodic{
+					Name:     "test",
+					Interval: 10 * time.Millisecond,
+					Task: func(ctx context.Context) error {
+						if runs.Add(1) == 3 {
+							cancel()
+						}
+						return tt.taskErr
+					},
+				}.Run(ctx)
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Expected job to stop after cancellation")
+			}
+
+			if got := runs.Load(); got != 3 {
+				t.Errorf("Expected %d runs, got %d", 3, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package metrics
+
+import (
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "minio_lite_admin"
+
+// Metrics holds the Prometheus collectors exported by the admin tool
+type Metrics struct {
+	registry         *prometheus.Registry
+	httpRequests     *prometheus.CounterVec
+	httpDuration     *prometheus.HistogramVec
+	upstreamDuration *prometheus.HistogramVec
+	upstreamErrors   *prometheus.CounterVec
+}
+
+// New creates the collectors and registers them on a dedicated registry
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Total number of HTTP requests by method, route pattern and status code.",
+		}, []string{"method", "route", "code"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by method and route pattern.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		upstreamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "upstream",
+			Name:      "request_duration_seconds",
+			Help:      "MinIO admin API call latency by operation.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+		upstreamErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "upstream",
+			Name:      "errors_total",
+			Help:      "MinIO admin API call failures by operation and status code, code is empty for transport errors.",
+		}, []string{"operation", "code"}),
+	}
+
+	buildInfo := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "build_info",
+		Help:      "Build information of the running binary, the value is always 1.",
+	}, []string{"version", "revision", "goversion"})
+	version, revision := readBuildInfo()
+	buildInfo.WithLabelValues(version, revision, runtime.Version()).Set(1)
+
+	m.registry.MustRegister(
+		m.httpRequests,
+		m.httpDuration,
+		m.upstreamDuration,
+		m.upstreamErrors,
+		buildInfo,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return m
+}
+
+// Handler returns the HTTP handler serving the metrics in Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveHTTPRequest records a served HTTP request
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, elapsed time.Duration) {
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+// InstrumentRoundTripper wraps a MinIO admin client transport to record upstream call metrics
+func (m *Metrics) InstrumentRoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		operation := Operation(req.URL.Path)
+		start := time.Now()
+
+		resp, err := next.RoundTrip(req)
+
+		m.upstreamDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		switch {
+		case err != nil:
+			m.upstreamErrors.WithLabelValues(operation, "").Inc()
+		case resp.StatusCode >= http.StatusBadRequest:
+			m.upstreamErrors.WithLabelValues(operation, strconv.Itoa(resp.StatusCode)).Inc()
+		}
+
+		return resp, err
+	})
+}
+
+// Operation derives the operation name from a MinIO admin API path,
+// e.g. "/minio/admin/v4/list-users" becomes "list-users"
+func Operation(path string) string {
+	for _, prefix := range []string{"/minio/admin/", "/minio/kms/"} {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(path, prefix)
+		// Drop the API version segment (v3, v4, ...)
+		if version, operation, found := strings.Cut(rest, "/"); found && strings.HasPrefix(version, "v") {
+			rest = operation
+		}
+
+		if rest != "" {
+			return rest
+		}
+	}
+
+	return path
+}
+
+// readBuildInfo returns the module version and VCS revision stamped by the Go toolchain
+func readBuildInfo() (version, revision string) {
+	version, revision = "unknown", "unknown"
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return version, revision
+	}
+
+	if info.Main.Version != "" {
+		version = info.Main.Version
+	}
+
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			revision = setting.Value
+		}
+	}
+
+	return version, revision
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
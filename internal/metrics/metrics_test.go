@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+)
+
+func TestOperation(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "admin API v4 path",
+			path:     "/minio/admin/v4/list-users",
+			expected: "list-users",
+		},
+		{
+			name:     "admin API v3 path",
+			path:     "/minio/admin/v3/info",
+			expected: "info",
+		},
+		{
+			name:     "KMS API path",
+			path:     "/minio/kms/v1/key/list",
+			expected: "key/list",
+		},
+		{
+			name:     "unknown path",
+			path:     "/other",
+			expected: "/other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Operation(tt.path); got != tt.expected {
+				t.Errorf("Operation(%q) = %q, want %q", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMetrics_InstrumentRoundTripper(t *testing.T) {
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	m := New()
+
+	minioClient, err := infra.NewMinIOClient(infra.MinIOConfig{
+		URL:           mockServer.URL(),
+		RootUser:      "minioadmin",
+		Password:      "minioadmin",
+		WrapTransport: m.InstrumentRoundTripper,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	// Successful call
+	if _, err := minioClient.ServerInfo(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Failing call with a non-retryable status code
+	mockServer.SetUsersError(http.StatusForbidden, "Forbidden")
+	if _, err := minioClient.ListUsers(context.Background()); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	body := scrape(t, m)
+
+	expected := []string{
+		`minio_lite_admin_upstream_request_duration_seconds_count{operation="info"} 1`,
+		`minio_lite_admin_upstream_request_duration_seconds_count{operation="list-users"} 1`,
+		`minio_lite_admin_upstream_errors_total{code="403",operation="list-users"} 1`,
+		`minio_lite_admin_build_info{`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(body, line) {
+			t.Errorf("Expected metrics to contain %q", line)
+		}
+	}
+
+	if strings.Contains(body, `minio_lite_admin_upstream_errors_total{code="",operation="info"}`) {
+		t.Error("Expected no error recorded for successful operation")
+	}
+}
+
+func TestMetrics_ObserveHTTPRequest(t *testing.T) {
+	m := New()
+
+	m.ObserveHTTPRequest(http.MethodGet, "/api/health", http.StatusOK, 10*time.Millisecond)
+	m.ObserveHTTPRequest(http.MethodGet, "/api/health", http.StatusOK, 20*time.Millisecond)
+
+	body := scrape(t, m)
+
+	expected := []string{
+		`minio_lite_admin_http_requests_total{code="200",method="GET",route="/api/health"} 2`,
+		`minio_lite_admin_http_request_duration_seconds_count{method="GET",route="/api/health"} 2`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(body, line) {
+			t.Errorf("Expected metrics to contain %q", line)
+		}
+	}
+}
+
+// scrape returns the metrics exposition served by the handler
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, err := io.ReadAll(w.Body)
+	if err != nil {
+		t.Fatalf("Failed to read metrics: %v", err)
+	}
+
+	return string(body)
+}
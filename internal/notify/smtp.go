@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+)
+
+type SMTPConfig struct {
+	Addr     string // host:port of the mail server
+	Username string // Optional, PLAIN authentication is used when set
+	Password string
+	From     string
+	To       []string
+}
+
+// SMTP mails expiry sweeps as plain text
+type SMTP struct {
+	cfg SMTPConfig
+}
+
+func NewSMTP(cfg SMTPConfig) *SMTP {
+	return &SMTP{cfg: cfg}
+}
+
+func (n *SMTP) Name() string {
+	return "smtp"
+}
+
+// Notify mails the sweep to every recipient. net/smtp has no context support, so ctx is only checked before sending.
+func (n *SMTP) Notify(ctx context.Context, sweep store.ExpirySweep) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if n.cfg.Username != "" {
+		host, _, err := net.SplitHostPort(n.cfg.Addr)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP address: %w", err)
+		}
+		auth = smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, host)
+	}
+
+	if err := smtp.SendMail(n.cfg.Addr, auth, n.cfg.From, n.cfg.To, n.message(sweep)); err != nil {
+		return fmt.Errorf("failed to send expiry mail: %w", err)
+	}
+
+	return nil
+}
+
+// message renders the mail headers and a line for every service account of the sweep
+func (n *SMTP) message(sweep store.ExpirySweep) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(n.cfg.To, ", "))
+	fmt.Fprintf(&b, "Subject: [%s] %d expired and %d expiring access keys\r\n", sweep.Cluster, sweep.Expired, sweep.Expiring)
+	fmt.Fprintf(&b, "Date: %s\r\n", sweep.Timestamp.Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+
+	fmt.Fprintf(&b, "Access key expiry sweep of cluster %s at %s\r\n\r\n", sweep.Cluster, sweep.Timestamp.Format(time.RFC3339))
+	for _, key := range sweep.Keys {
+		state := "expires"
+		if key.Expired {
+			state = "expired"
+		}
+		fmt.Fprintf(&b, "- %s (%s, parent %s) %s at %s", key.AccessKey, key.Name, key.ParentUser, state, key.Expiration.Format(time.RFC3339))
+		if key.Action != "" {
+			if key.Error != "" {
+				fmt.Fprintf(&b, ", %s failed: %s", key.Action, key.Error)
+			} else {
+				fmt.Fprintf(&b, ", %s applied", key.Action)
+			}
+		}
+		b.WriteString("\r\n")
+	}
+
+	return []byte(b.String())
+}
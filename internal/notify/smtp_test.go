@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+)
+
+func TestSMTP_Message(t *testing.T) {
+	expiration := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	notifier := NewSMTP(SMTPConfig{
+		Addr: "localhost:25",
+		From: "minio@example.com",
+		To:   []string{"ops@example.com", "security@example.com"},
+	})
+
+	message := string(notifier.message(store.ExpirySweep{
+		Timestamp: expiration.Add(time.Hour),
+		Cluster:   "default",
+		Action:    "disable",
+		Keys: []store.ExpiryKey{
+			{AccessKey: "ALICECI0000000000001", Name: "ci-deploy", ParentUser: "alice", Expiration: expiration, Expired: true, Action: "disable"},
+			{AccessKey: "BOBBACKUP00000000001", Name: "backup", ParentUser: "bob", Expiration: expiration.Add(48 * time.Hour)},
+		},
+		Expiring: 1,
+		Expired:  1,
+	}))
+
+	for _, expected := range []string{
+		"To: ops@example.com, security@example.com\r\n",
+		"Subject: [default] 1 expired and 1 expiring access keys\r\n",
+		"- ALICECI0000000000001 (ci-deploy, parent alice) expired at 2025-01-01T00:00:00Z, disable applied\r\n",
+		"- BOBBACKUP00000000001 (backup, parent bob) expires at 2025-01-03T00:00:00Z\r\n",
+	} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("Expected message containing %q, got %q", expected, message)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+var ErrInvalidAccessKeyCursor = errors.New("invalid access key cursor")
+
+// Fields access keys are sorted by, ties are broken by the access key to keep pages stable
+const (
+	AccessKeySortAccessKey  = "accessKey"
+	AccessKeySortName       = "name"
+	AccessKeySortParent     = "parent"
+	AccessKeySortExpiration = "expiration"
+	AccessKeySortStatus     = "status"
+)
+
+// Sort orders of access keys
+const (
+	AccessKeyOrderAsc  = "asc"
+	AccessKeyOrderDesc = "desc"
+)
+
+// ValidAccessKeySort reports whether field is a supported sort field
+func ValidAccessKeySort(field string) bool {
+	switch field {
+	case AccessKeySortAccessKey, AccessKeySortName, AccessKeySortParent, AccessKeySortExpiration, AccessKeySortStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+// accessKeyCursor points after the last access key of a page by its sort value, a removed key does not shift later pages
+type accessKeyCursor struct {
+	Sort      string  `json:"s"`
+	Order     string  `json:"o"`
+	Value     *string `json:"v,omitempty"`
+	AccessKey string  `json:"k"`
+}
+
+// encodeAccessKeyCursor creates the opaque cursor continuing after key
+func encodeAccessKeyCursor(key AccessKeyInfo, field, order string) string {
+	data, _ := json.Marshal(accessKeyCursor{
+		Sort:      field,
+		Order:     order,
+		Value:     accessKeySortValue(key, field),
+		AccessKey: key.AccessKey,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeAccessKeyCursor parses a cursor, it is only valid for the sort it was created with
+func decodeAccessKeyCursor(cursor, field, order string) (*accessKeyCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidAccessKeyCursor
+	}
+
+	var decoded accessKeyCursor
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, ErrInvalidAccessKeyCursor
+	}
+	if decoded.Sort != field || decoded.Order != order {
+		return nil, ErrInvalidAccessKeyCursor
+	}
+
+	return &decoded, nil
+}
+
+// searchAccessKeys keeps the access keys whose access key, name or description contains the search text, ignoring case
+func searchAccessKeys(accessKeys []AccessKeyInfo, search string) []AccessKeyInfo {
+	search = strings.ToLower(strings.TrimSpace(search))
+	if search == "" {
+		return accessKeys
+	}
+
+	matched := make([]AccessKeyInfo, 0, len(accessKeys))
+	for _, key := range accessKeys {
+		if strings.Contains(strings.ToLower(key.AccessKey), search) ||
+			strings.Contains(strings.ToLower(key.Name), search) ||
+			strings.Contains(strings.ToLower(key.Description), search) {
+			matched = append(matched, key)
+		}
+	}
+
+	return matched
+}
+
+// sortAccessKeys sorts the access keys in place by the field and order
+func sortAccessKeys(accessKeys []AccessKeyInfo, field, order string) {
+	slices.SortFunc(accessKeys, func(a, b AccessKeyInfo) int {
+		return compareAccessKeys(field, order, accessKeySortValue(a, field), a.AccessKey, accessKeySortValue(b, field), b.AccessKey)
+	})
+}
+
+// compareAccessKeys compares two access keys by their sort values, keys without a value are always last
+func compareAccessKeys(field, order string, aValue *string, aKey string, bValue *string, bKey string) int {
+	result := 0
+	switch {
+	case aValue == nil && bValue == nil:
+	case aValue == nil:
+		return 1
+	case bValue == nil:
+		return -1
+	case field == AccessKeySortExpiration:
+		result = compareExpiration(*aValue, *bValue)
+	default:
+		result = cmp.Compare(*aValue, *bValue)
+	}
+
+	if result == 0 {
+		result = cmp.Compare(aKey, bKey)
+	}
+	if order == AccessKeyOrderDesc {
+		return -result
+	}
+	return result
+}
+
+// compareExpiration compares ISO 8601 expirations by time, falling back to text when one is malformed
+func compareExpiration(a, b string) int {
+	aTime, aErr := time.Parse(time.RFC3339, a)
+	bTime, bErr := time.Parse(time.RFC3339, b)
+	if aErr != nil || bErr != nil {
+		return cmp.Compare(a, b)
+	}
+	return aTime.Compare(bTime)
+}
+
+// accessKeySortValue returns the value of the sort field, nil when the access key has no expiration
+func accessKeySortValue(key AccessKeyInfo, field string) *string {
+	switch field {
+	case AccessKeySortName:
+		return &key.Name
+	case AccessKeySortParent:
+		return &key.ParentUser
+	case AccessKeySortExpiration:
+		return key.Expiration
+	case AccessKeySortStatus:
+		return &key.AccountStatus
+	default:
+		return &key.AccessKey
+	}
+}
@@ -11,14 +11,15 @@ import (
 
 type AddServiceAccountService struct {
 	minioClient *madmin.AdminClient
+	rules       CredentialRules
 }
 
 // CreateServiceAccountRequest represents the request to create a service account
 type CreateServiceAccountRequest struct {
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
-	AccessKey   string `json:"accessKey,omitempty"`  // Optional - MinIO generates if empty
-	SecretKey   string `json:"secretKey,omitempty"`  // Optional - MinIO generates if empty
+	AccessKey   string `json:"accessKey,omitempty"`  // Optional - generated by the credential rules if empty
+	SecretKey   string `json:"secretKey,omitempty"`  // Optional - generated by the credential rules if empty
 	Policy      string `json:"policy,omitempty"`     // JSON policy document
 	TargetUser  string `json:"targetUser,omitempty"` // User this service account belongs to
 	Expiration  *int64 `json:"expiration,omitempty"` // Unix timestamp in seconds
@@ -26,17 +27,19 @@ type CreateServiceAccountRequest struct {
 
 // CreateServiceAccountResponse represents the response from creating a service account
 type CreateServiceAccountResponse struct {
-	AccessKey    string    `json:"accessKey"`
-	SecretKey    string    `json:"secretKey"`
-	SessionToken string    `json:"sessionToken,omitempty"`
-	Expiration   time.Time `json:"expiration,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Description  string    `json:"description,omitempty"`
+	AccessKey    string      `json:"accessKey"`
+	SecretKey    string      `json:"secretKey,omitempty"` // Empty when replaced by a reveal link
+	SessionToken string      `json:"sessionToken,omitempty"`
+	Expiration   time.Time   `json:"expiration,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Reveal       *RevealLink `json:"reveal,omitempty"`
 }
 
-func NewAddServiceAccountService(minioClient *madmin.AdminClient) *AddServiceAccountService {
+func NewAddServiceAccountService(minioClient *madmin.AdminClient, rules CredentialRules) *AddServiceAccountService {
 	return &AddServiceAccountService{
 		minioClient: minioClient,
+		rules:       rules,
 	}
 }
 
@@ -49,6 +52,28 @@ func (s *AddServiceAccountService) Execute(ctx context.Context, req CreateServic
 		Bool("hasCustomAccessKey", req.AccessKey != "").
 		Msg("Creating service account")
 
+	// Supplied secret keys must be as strong as the rules require, missing keys are generated
+	if req.SecretKey != "" {
+		if err := s.rules.CheckSecretKey(req.SecretKey); err != nil {
+			logger.Warn().Err(err).Msg("Rejected weak secret key")
+			return nil, err
+		}
+	}
+	if req.AccessKey == "" {
+		accessKey, err := s.rules.GenerateAccessKey()
+		if err != nil {
+			return nil, err
+		}
+		req.AccessKey = accessKey
+	}
+	if req.SecretKey == "" {
+		secretKey, err := s.rules.GenerateSecretKey()
+		if err != nil {
+			return nil, err
+		}
+		req.SecretKey = secretKey
+	}
+
 	// Prepare the MinIO request
 	addReq := madmin.AddServiceAccountReq{
 		Name:        req.Name,
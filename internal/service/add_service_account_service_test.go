@@ -180,6 +180,30 @@ func TestAddServiceAccountService_Execute(t *testing.T) {
 			},
 			expectedError: "", // Negative timestamps are technically valid, just in the past
 		},
+		{
+			name:      "keys generated by the credential rules",
+			setupMock: func(mock *minio.MockMinIOServer) {},
+			request: CreateServiceAccountRequest{
+				Name: "generated-service-account",
+			},
+			validateResult: func(t *testing.T, result *CreateServiceAccountResponse) {
+				if !strings.HasPrefix(result.AccessKey, "AKIA") || len(result.AccessKey) != 20 {
+					t.Errorf("Expected a 20 character AccessKey with prefix AKIA, got %q", result.AccessKey)
+				}
+				if len(result.SecretKey) != 40 {
+					t.Errorf("Expected a 40 character SecretKey, got %q", result.SecretKey)
+				}
+			},
+		},
+		{
+			name:      "weak secret key",
+			setupMock: func(mock *minio.MockMinIOServer) {},
+			request: CreateServiceAccountRequest{
+				Name:      "weak-service-account",
+				SecretKey: "password1234",
+			},
+			expectedError: "secret key is too weak",
+		},
 		{
 			name: "MinIO server error",
 			setupMock: func(mock *minio.MockMinIOServer) {
@@ -217,7 +241,7 @@ func TestAddServiceAccountService_Execute(t *testing.T) {
 			}
 
 			// Create service
-			service := NewAddServiceAccountService(minioClient)
+			service := NewAddServiceAccountService(minioClient, DefaultCredentialRules())
 
 			// Create context with logger
 			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
@@ -260,7 +284,7 @@ func TestAddServiceAccountService_NewAddServiceAccountService(t *testing.T) {
 		t.Fatalf("Failed to create MinIO client: %v", err)
 	}
 
-	service := NewAddServiceAccountService(minioClient)
+	service := NewAddServiceAccountService(minioClient, DefaultCredentialRules())
 	if service == nil {
 		t.Fatal("Expected service, got nil")
 	}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// errCodeServiceAccountNotFound is the MinIO error code of an unknown service account
+const errCodeServiceAccountNotFound = "XMinioAdminServiceAccountNotFound"
+
+type AdvanceAccessKeyRotationsService struct {
+	clusters        ClusterClients
+	serviceAccounts *ServiceAccountCaches
+	rotations       *store.Rotations
+	now             func() time.Time
+}
+
+// AdvanceAccessKeyRotationsResponse represents the rotations changed by a single check
+type AdvanceAccessKeyRotationsResponse struct {
+	Disabled  int `json:"disabled"`
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+}
+
+// NewAdvanceAccessKeyRotationsService creates the service moving the rotations of every cluster to their next state once due.
+// The cached details of the old access keys are invalidated in the cache of their cluster.
+func NewAdvanceAccessKeyRotationsService(clusters ClusterClients, serviceAccounts *ServiceAccountCaches, rotations *store.Rotations) *AdvanceAccessKeyRotationsService {
+	return &AdvanceAccessKeyRotationsService{
+		clusters:        clusters,
+		serviceAccounts: serviceAccounts,
+		rotations:       rotations,
+		now:             time.Now,
+	}
+}
+
+func (s *AdvanceAccessKeyRotationsService) Execute(ctx context.Context) (*AdvanceAccessKeyRotationsResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	rotations, err := s.rotations.List()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read rotations")
+		return nil, fmt.Errorf("failed to read rotations: %w", err)
+	}
+
+	now := s.now().UTC()
+	response := &AdvanceAccessKeyRotationsResponse{}
+
+	for _, rotation := range rotations {
+		if !rotationDue(rotation, now) {
+			continue
+		}
+
+		before := rotation.State
+		rotation = s.advance(ctx, rotation, now)
+		rotation.UpdatedAt = now
+		if err := s.rotations.Save(rotation); err != nil {
+			logger.Error().Err(err).Str("rotation", rotation.ID).Msg("Failed to save rotation")
+			return nil, fmt.Errorf("failed to save rotation: %w", err)
+		}
+
+		switch {
+		case rotation.Error != "":
+			response.Failed++
+		case rotation.State == store.RotationCompleted:
+			response.Completed++
+		case rotation.State != before:
+			response.Disabled++
+		}
+	}
+
+	if response.Disabled+response.Completed+response.Failed > 0 {
+		logger.Info().
+			Int("disabled", response.Disabled).
+			Int("completed", response.Completed).
+			Int("failed", response.Failed).
+			Msg("Access key rotations advanced")
+	}
+
+	return response, nil
+}
+
+// rotationDue reports whether the next step of the rotation should run at now
+func rotationDue(rotation store.Rotation, now time.Time) bool {
+	switch rotation.State {
+	case store.RotationOverlap:
+		return !now.Before(rotation.DisableAt)
+	case store.RotationDisabled:
+		return !now.Before(rotation.DeleteAt)
+	default:
+		return false
+	}
+}
+
+// advance runs every due step of the rotation, a failed step is kept in Error and retried on the next check
+func (s *AdvanceAccessKeyRotationsService) advance(ctx context.Context, rotation store.Rotation, now time.Time) store.Rotation {
+	logger := zerolog.Ctx(ctx).With().Str("rotation", rotation.ID).Str("accessKey", rotation.OldAccessKey).Logger()
+
+	client, err := s.clusters.Client(rotation.Cluster)
+	if err != nil {
+		logger.Error().Err(err).Str("cluster", rotation.Cluster).Msg("Failed to get MinIO client of rotation")
+		rotation.Error = err.Error()
+		return rotation
+	}
+	rotation.Error = ""
+
+	// Every step changes the old access key, the cached details are outdated afterwards
+	defer s.serviceAccounts.Cluster(rotation.Cluster).Invalidate(rotation.OldAccessKey)
+
+	if rotation.State == store.RotationOverlap {
+		err := client.UpdateServiceAccount(ctx, rotation.OldAccessKey, madmin.UpdateServiceAccountReq{NewStatus: string(madmin.AccountDisabled)})
+		switch {
+		case serviceAccountNotFound(err):
+			// Removed by someone else, there is nothing left to retire
+			logger.Warn().Msg("Rotated service account no longer exists")
+			rotation.State = store.RotationCompleted
+			return rotation
+		case err != nil:
+			logger.Error().Err(err).Msg("Failed to disable rotated service account")
+			rotation.Error = fmt.Sprintf("failed to disable old access key: %v", err)
+			return rotation
+		}
+
+		logger.Info().Msg("Rotated service account disabled")
+		rotation.State = store.RotationDisabled
+	}
+
+	if rotation.State == store.RotationDisabled && !now.Before(rotation.DeleteAt) {
+		err := client.DeleteServiceAccount(ctx, rotation.OldAccessKey)
+		if err != nil && !serviceAccountNotFound(err) {
+			logger.Error().Err(err).Msg("Failed to delete rotated service account")
+			rotation.Error = fmt.Sprintf("failed to delete old access key: %v", err)
+			return rotation
+		}
+
+		logger.Info().Msg("Rotated service account deleted")
+		rotation.State = store.RotationCompleted
+	}
+
+	return rotation
+}
+
+// serviceAccountNotFound reports whether err is MinIO rejecting an unknown service account
+func serviceAccountNotFound(err error) bool {
+	return err != nil && madmin.ToErrorResponse(err).Code == errCodeServiceAccountNotFound
+}
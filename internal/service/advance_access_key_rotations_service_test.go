@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestAdvanceAccessKeyRotationsService_Execute(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rotation := store.Rotation{
+		ID:           "rotation-1",
+		Cluster:      "default",
+		OldAccessKey: "BOBBACKUP00000000001",
+		NewAccessKey: "BOBBACKUP00000000002",
+		ParentUser:   "bob",
+		State:        store.RotationOverlap,
+		CreatedAt:    created,
+		DisableAt:    created.Add(72 * time.Hour),
+		DeleteAt:     created.Add(96 * time.Hour),
+	}
+
+	tests := []struct {
+		name             string
+		setupMock        func(*minio.MockMinIOServer)
+		rotation         store.Rotation
+		now              time.Time
+		expectedState    string
+		expectedResponse AdvanceAccessKeyRotationsResponse
+		expectedCached   bool // The cached details of the old access key are kept when no step ran
+		validateRotation func(t *testing.T, rotation store.Rotation, mock *minio.MockMinIOServer)
+	}{
+		{
+			name:           "overlap is not over yet",
+			rotation:       rotation,
+			now:            created.Add(71 * time.Hour),
+			expectedState:  store.RotationOverlap,
+			expectedCached: true,
+			validateRotation: func(t *testing.T, rotation store.Rotation, mock *minio.MockMinIOServer) {
+				if updates := mock.Requests("update-service-account"); len(updates) != 0 {
+					t.Errorf("Expected no updates, got %d", len(updates))
+				}
+			},
+		},
+		{
+			name:             "old access key is disabled after the overlap",
+			rotation:         rotation,
+			now:              created.Add(72 * time.Hour),
+			expectedState:    store.RotationDisabled,
+			expectedResponse: AdvanceAccessKeyRotationsResponse{Disabled: 1},
+			validateRotation: func(t *testing.T, rotation store.Rotation, mock *minio.MockMinIOServer) {
+				updates := mock.Requests("update-service-account")
+				if len(updates) != 1 || updates[0].Get("accessKey") != "BOBBACKUP00000000001" {
+					t.Errorf("Expected the old access key to be updated, got %v", updates)
+				}
+				if deletes := mock.Requests("delete-service-account"); len(deletes) != 0 {
+					t.Errorf("Expected no deletes during the grace, got %d", len(deletes))
+				}
+				if !rotation.UpdatedAt.Equal(created.Add(72 * time.Hour)) {
+					t.Errorf("Expected UpdatedAt %v, got %v", created.Add(72*time.Hour), rotation.UpdatedAt)
+				}
+			},
+		},
+		{
+			name:             "every due step runs after a long downtime",
+			rotation:         rotation,
+			now:              created.Add(30 * 24 * time.Hour),
+			expectedState:    store.RotationCompleted,
+			expectedResponse: AdvanceAccessKeyRotationsResponse{Completed: 1},
+			validateRotation: func(t *testing.T, rotation store.Rotation, mock *minio.MockMinIOServer) {
+				if updates := mock.Requests("update-service-account"); len(updates) != 1 {
+					t.Errorf("Expected %d update, got %d", 1, len(updates))
+				}
+				deletes := mock.Requests("delete-service-account")
+				if len(deletes) != 1 || deletes[0].Get("accessKey") != "BOBBACKUP00000000001" {
+					t.Errorf("Expected the old access key to be deleted, got %v", deletes)
+				}
+			},
+		},
+		{
+			name: "failed step is retried later",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceAccountError("BOBBACKUP00000000001", http.StatusBadRequest, "Access Denied")
+			},
+			rotation:         rotation,
+			now:              created.Add(72 * time.Hour),
+			expectedState:    store.RotationOverlap,
+			expectedResponse: AdvanceAccessKeyRotationsResponse{Failed: 1},
+			validateRotation: func(t *testing.T, rotation store.Rotation, mock *minio.MockMinIOServer) {
+				if rotation.Error == "" {
+					t.Error("Expected the failure to be recorded")
+				}
+			},
+		},
+		{
+			name: "removed old access key completes the rotation",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceAccountNotFound("BOBBACKUP00000000001")
+			},
+			rotation:         rotation,
+			now:              created.Add(72 * time.Hour),
+			expectedState:    store.RotationCompleted,
+			expectedResponse: AdvanceAccessKeyRotationsResponse{Completed: 1},
+			validateRotation: func(t *testing.T, rotation store.Rotation, mock *minio.MockMinIOServer) {
+				if deletes := mock.Requests("delete-service-account"); len(deletes) != 0 {
+					t.Errorf("Expected no deletes, got %d", len(deletes))
+				}
+			},
+		},
+		{
+			name: "rotation of an unknown cluster",
+			rotation: func() store.Rotation {
+				r := rotation
+				r.Cluster = "removed"
+				return r
+			}(),
+			now:              created.Add(72 * time.Hour),
+			expectedState:    store.RotationOverlap,
+			expectedResponse: AdvanceAccessKeyRotationsResponse{Failed: 1},
+			expectedCached:   true,
+			validateRotation: func(t *testing.T, rotation store.Rotation, mock *minio.MockMinIOServer) {
+				if rotation.Error == "" {
+					t.Error("Expected the unknown cluster to be recorded")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			mockServer.AddServiceAccountToStore("BOBBACKUP00000000001", "", "backup", "", "enabled", "bob", nil, nil)
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+			clients := &testClusterClients{
+				names:   []string{"default"},
+				clients: map[string]*madmin.AdminClient{"default": minioClient},
+			}
+
+			rotations := newTestRotations(t)
+			if err := rotations.Save(tt.rotation); err != nil {
+				t.Fatalf("Failed to seed rotation: %v", err)
+			}
+
+			// The old access key was listed before the check
+			caches := NewServiceAccountCaches(time.Hour)
+			caches.Cluster("default").Set("BOBBACKUP00000000001", madmin.InfoServiceAccountResp{AccountStatus: "on"}, 0)
+
+			service := NewAdvanceAccessKeyRotationsService(clients, caches, rotations)
+			service.now = func() time.Time { return tt.now }
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := service.Execute(ctx)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if *result != tt.expectedResponse {
+				t.Errorf("Expected response %+v, got %+v", tt.expectedResponse, *result)
+			}
+
+			stored, err := rotations.List()
+			if err != nil {
+				t.Fatalf("Failed to read rotations: %v", err)
+			}
+			if stored[0].State != tt.expectedState {
+				t.Errorf("Expected State %q, got %q", tt.expectedState, stored[0].State)
+			}
+
+			if _, cached := caches.Cluster("default").Get("BOBBACKUP00000000001"); cached != tt.expectedCached {
+				t.Errorf("Expected cached %v, got %v", tt.expectedCached, cached)
+			}
+
+			tt.validateRotation(t, stored[0], mockServer)
+		})
+	}
+}
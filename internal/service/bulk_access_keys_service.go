@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// Actions applied by bulk access key operations
+const (
+	BulkAccessKeyEnable        = "enable"
+	BulkAccessKeyDisable       = "disable"
+	BulkAccessKeyDelete        = "delete"
+	BulkAccessKeySetExpiration = "setExpiration"
+)
+
+// Status of an access key in a bulk operation
+const (
+	BulkAccessKeyPlanned   = "planned" // Selected by a dry run
+	BulkAccessKeySucceeded = "succeeded"
+	BulkAccessKeyFailed    = "failed"
+)
+
+var (
+	ErrInvalidBulkAccessKeyAction = errors.New("invalid bulk access key action")
+	ErrEmptyAccessKeySelector     = errors.New("at least one access key selector is required")
+	ErrMissingBulkExpiration      = errors.New("expiration is required to set the expiration")
+)
+
+type BulkAccessKeysService struct {
+	listAccessKeysService       *ListAccessKeysService
+	updateServiceAccountService *UpdateServiceAccountService
+	deleteServiceAccountService *DeleteServiceAccountService
+}
+
+// AccessKeySelector selects the service accounts of a bulk operation, every given selector must match
+type AccessKeySelector struct {
+	AccessKeys    []string `json:"accessKeys,omitempty"`
+	ParentUser    string   `json:"parentUser,omitempty"`
+	NamePrefix    string   `json:"namePrefix,omitempty"`
+	ExpiredBefore *int64   `json:"expiredBefore,omitempty"` // Unix timestamp in seconds
+}
+
+// BulkAccessKeysRequest represents an action applied to every selected service account
+type BulkAccessKeysRequest struct {
+	Action     string            `json:"action"`
+	Selector   AccessKeySelector `json:"selector"`
+	Expiration *int64            `json:"expiration,omitempty"` // Unix timestamp in seconds, required by setExpiration
+	DryRun     bool              `json:"dryRun"`
+}
+
+// BulkAccessKeyResult represents the outcome of the action for one service account
+type BulkAccessKeyResult struct {
+	AccessKey  string `json:"accessKey"`
+	ParentUser string `json:"parentUser,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+// BulkAccessKeysResponse represents the outcome of a bulk operation, a failed access key does not stop the others
+type BulkAccessKeysResponse struct {
+	Action    string                `json:"action"`
+	DryRun    bool                  `json:"dryRun"`
+	Results   []BulkAccessKeyResult `json:"results"`
+	Total     int                   `json:"total"`
+	Succeeded int                   `json:"succeeded"`
+	Failed    int                   `json:"failed"`
+}
+
+func NewBulkAccessKeysService(minioClient *madmin.AdminClient, serviceAccounts *ServiceAccountCache) *BulkAccessKeysService {
+	return &BulkAccessKeysService{
+		listAccessKeysService:       NewListAccessKeysService(minioClient, serviceAccounts),
+		updateServiceAccountService: NewUpdateServiceAccountService(minioClient, serviceAccounts),
+		deleteServiceAccountService: NewDeleteServiceAccountService(minioClient, serviceAccounts),
+	}
+}
+
+// ValidBulkAccessKeyAction reports whether action is a supported bulk action
+func ValidBulkAccessKeyAction(action string) bool {
+	switch action {
+	case BulkAccessKeyEnable, BulkAccessKeyDisable, BulkAccessKeyDelete, BulkAccessKeySetExpiration:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *BulkAccessKeysService) Execute(ctx context.Context, req BulkAccessKeysRequest) (*BulkAccessKeysResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	logger.Debug().Str("action", req.Action).Bool("dryRun", req.DryRun).Msg("Selecting access keys of bulk operation")
+
+	selected, missing, err := s.selectServiceAccounts(ctx, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkAccessKeysResponse{
+		Action:  req.Action,
+		DryRun:  req.DryRun,
+		Results: make([]BulkAccessKeyResult, 0, len(selected)+len(missing)),
+	}
+
+	for _, key := range selected {
+		result := BulkAccessKeyResult{
+			AccessKey:  key.AccessKey,
+			ParentUser: key.ParentUser,
+			Name:       key.Name,
+			Status:     BulkAccessKeyPlanned,
+		}
+
+		if !req.DryRun {
+			if err := s.apply(ctx, req, key.AccessKey); err != nil {
+				result.Status = BulkAccessKeyFailed
+				result.Error = err.Error()
+			} else {
+				result.Status = BulkAccessKeySucceeded
+			}
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	// Explicit access keys which are not service accounts cannot be changed
+	for _, accessKey := range missing {
+		response.Results = append(response.Results, BulkAccessKeyResult{
+			AccessKey: accessKey,
+			Status:    BulkAccessKeyFailed,
+			Error:     "service account not found",
+		})
+	}
+
+	for _, result := range response.Results {
+		switch result.Status {
+		case BulkAccessKeySucceeded:
+			response.Succeeded++
+		case BulkAccessKeyFailed:
+			response.Failed++
+		}
+	}
+	response.Total = len(response.Results)
+
+	logger.Info().
+		Str("action", req.Action).
+		Bool("dryRun", req.DryRun).
+		Int("total", response.Total).
+		Int("succeeded", response.Succeeded).
+		Int("failed", response.Failed).
+		Msg("Bulk access key operation completed")
+
+	return response, nil
+}
+
+// validate checks the action and its selector before any service account is listed
+func (req BulkAccessKeysRequest) validate() error {
+	if !ValidBulkAccessKeyAction(req.Action) {
+		return fmt.Errorf("%w: %q", ErrInvalidBulkAccessKeyAction, req.Action)
+	}
+
+	selector := req.Selector
+	if len(selector.AccessKeys) == 0 && selector.ParentUser == "" && selector.NamePrefix == "" && selector.ExpiredBefore == nil {
+		return ErrEmptyAccessKeySelector
+	}
+
+	if req.Action == BulkAccessKeySetExpiration && (req.Expiration == nil || *req.Expiration <= 0) {
+		return ErrMissingBulkExpiration
+	}
+
+	return nil
+}
+
+// selectServiceAccounts lists the service accounts matching the selector and the explicit access keys which were not found
+func (s *BulkAccessKeysService) selectServiceAccounts(ctx context.Context, selector AccessKeySelector) ([]AccessKeyInfo, []string, error) {
+	// Every provider is listed, the parent may be a built-in user, an LDAP DN or an OpenID subject
+	listed, err := s.listAccessKeysService.Execute(ctx, ListAccessKeysOptions{
+		Type: "serviceAccounts",
+		User: selector.ParentUser,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to select access keys: %w", err)
+	}
+
+	var expiredBefore time.Time
+	if selector.ExpiredBefore != nil {
+		expiredBefore = time.Unix(*selector.ExpiredBefore, 0)
+	}
+
+	var selected []AccessKeyInfo
+	found := make(map[string]bool)
+	for _, key := range listed.AccessKeys {
+		if key.Type != "serviceAccount" {
+			continue
+		}
+		found[key.AccessKey] = true
+
+		if len(selector.AccessKeys) > 0 && !slices.Contains(selector.AccessKeys, key.AccessKey) {
+			continue
+		}
+		if selector.ParentUser != "" && key.ParentUser != selector.ParentUser {
+			continue
+		}
+		if selector.NamePrefix != "" && !strings.HasPrefix(key.Name, selector.NamePrefix) {
+			continue
+		}
+		if selector.ExpiredBefore != nil && !expiresBefore(key, expiredBefore) {
+			continue
+		}
+
+		selected = append(selected, key)
+	}
+
+	var missing []string
+	for _, accessKey := range selector.AccessKeys {
+		if !found[accessKey] && !slices.Contains(missing, accessKey) {
+			missing = append(missing, accessKey)
+		}
+	}
+
+	return selected, missing, nil
+}
+
+// apply runs the action of the request on a single service account
+func (s *BulkAccessKeysService) apply(ctx context.Context, req BulkAccessKeysRequest, accessKey string) error {
+	var err error
+	switch req.Action {
+	case BulkAccessKeyEnable:
+		_, err = s.updateServiceAccountService.Execute(ctx, UpdateServiceAccountRequest{AccessKey: accessKey, NewStatus: string(madmin.AccountEnabled)})
+	case BulkAccessKeyDisable:
+		_, err = s.updateServiceAccountService.Execute(ctx, UpdateServiceAccountRequest{AccessKey: accessKey, NewStatus: string(madmin.AccountDisabled)})
+	case BulkAccessKeySetExpiration:
+		_, err = s.updateServiceAccountService.Execute(ctx, UpdateServiceAccountRequest{AccessKey: accessKey, NewExpiration: req.Expiration})
+	case BulkAccessKeyDelete:
+		_, err = s.deleteServiceAccountService.Execute(ctx, DeleteServiceAccountRequest{AccessKey: accessKey})
+	}
+	return err
+}
+
+// expiresBefore reports whether the access key has an expiration before t, access keys without expiration never match
+func expiresBefore(key AccessKeyInfo, t time.Time) bool {
+	if key.Expiration == nil {
+		return false
+	}
+
+	expiration, err := time.Parse(time.RFC3339, *key.Expiration)
+	if err != nil {
+		return false
+	}
+	return expiration.Before(t)
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestBulkAccessKeysService_Execute(t *testing.T) {
+	expiredBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	expiration := time.Now().Add(30 * 24 * time.Hour).Unix()
+
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		request        BulkAccessKeysRequest
+		expectedError  error
+		validateResult func(t *testing.T, result *BulkAccessKeysResponse, mock *minio.MockMinIOServer)
+	}{
+		{
+			name: "dry run previews the selected service accounts",
+			request: BulkAccessKeysRequest{
+				Action:   BulkAccessKeyDelete,
+				Selector: AccessKeySelector{ParentUser: "alice"},
+				DryRun:   true,
+			},
+			validateResult: func(t *testing.T, result *BulkAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Total != 2 {
+					t.Fatalf("Expected %d results, got %d", 2, result.Total)
+				}
+				for _, r := range result.Results {
+					if r.Status != BulkAccessKeyPlanned {
+						t.Errorf("Expected %q to be planned, got %q", r.AccessKey, r.Status)
+					}
+				}
+				if deletes := mock.Requests("delete-service-account"); len(deletes) != 0 {
+					t.Errorf("Expected no deletes in a dry run, got %d", len(deletes))
+				}
+			},
+		},
+		{
+			name: "selectors must all match",
+			request: BulkAccessKeysRequest{
+				Action:   BulkAccessKeyDisable,
+				Selector: AccessKeySelector{NamePrefix: "ci-", ExpiredBefore: &expiredBefore},
+			},
+			validateResult: func(t *testing.T, result *BulkAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Total != 1 || result.Results[0].AccessKey != "ALICECI0000000000001" {
+					t.Fatalf("Expected only the expired CI account, got %+v", result.Results)
+				}
+				if result.Succeeded != 1 {
+					t.Errorf("Expected %d succeeded, got %d", 1, result.Succeeded)
+				}
+
+				updates := mock.Requests("update-service-account")
+				if len(updates) != 1 || updates[0].Get("accessKey") != "ALICECI0000000000001" {
+					t.Errorf("Expected one update of the expired CI account, got %v", updates)
+				}
+			},
+		},
+		{
+			name: "a failed service account does not stop the others",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceAccountError("ALICECI0000000000002", http.StatusBadRequest, "Access Denied")
+			},
+			request: BulkAccessKeysRequest{
+				Action:   BulkAccessKeyDelete,
+				Selector: AccessKeySelector{AccessKeys: []string{"ALICECI0000000000002", "BOBBACKUP00000000001", "UNKNOWN000000000000"}},
+			},
+			validateResult: func(t *testing.T, result *BulkAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Total != 3 || result.Succeeded != 1 || result.Failed != 2 {
+					t.Fatalf("Expected 3 results with 1 succeeded and 2 failed, got %d, %d and %d", result.Total, result.Succeeded, result.Failed)
+				}
+
+				statuses := make(map[string]string)
+				for _, r := range result.Results {
+					statuses[r.AccessKey] = r.Status
+					if r.Status == BulkAccessKeyFailed && r.Error == "" {
+						t.Errorf("Expected failed %q to report its error", r.AccessKey)
+					}
+				}
+				if statuses["BOBBACKUP00000000001"] != BulkAccessKeySucceeded {
+					t.Errorf("Expected backup account to be deleted, got %q", statuses["BOBBACKUP00000000001"])
+				}
+				if statuses["ALICECI0000000000002"] != BulkAccessKeyFailed || statuses["UNKNOWN000000000000"] != BulkAccessKeyFailed {
+					t.Errorf("Expected rejected and unknown accounts to fail, got %v", statuses)
+				}
+			},
+		},
+		{
+			name: "select the service accounts of an LDAP parent",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPAccessKeysResponse(minio.TestScenarios{}.LDAPAccessKeys())
+				mock.AddServiceAccountToStore("LDAPALICESVC00000001", "", "alice-backup", "", "enabled", "uid=alice,ou=people,dc=example,dc=com", nil, nil)
+			},
+			request: BulkAccessKeysRequest{
+				Action:   BulkAccessKeyDisable,
+				Selector: AccessKeySelector{ParentUser: "uid=alice,ou=people,dc=example,dc=com"},
+			},
+			validateResult: func(t *testing.T, result *BulkAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Total != 1 || result.Results[0].AccessKey != "LDAPALICESVC00000001" {
+					t.Fatalf("Expected only the service account of the LDAP parent, got %+v", result.Results)
+				}
+				if result.Succeeded != 1 {
+					t.Errorf("Expected %d succeeded, got %d", 1, result.Succeeded)
+				}
+
+				updates := mock.Requests("update-service-account")
+				if len(updates) != 1 || updates[0].Get("accessKey") != "LDAPALICESVC00000001" {
+					t.Errorf("Expected one update of the LDAP service account, got %v", updates)
+				}
+			},
+		},
+		{
+			name: "set expiration of the selected service accounts",
+			request: BulkAccessKeysRequest{
+				Action:     BulkAccessKeySetExpiration,
+				Selector:   AccessKeySelector{NamePrefix: "ci-"},
+				Expiration: &expiration,
+			},
+			validateResult: func(t *testing.T, result *BulkAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Succeeded != 2 {
+					t.Errorf("Expected %d succeeded, got %d", 2, result.Succeeded)
+				}
+
+				updated := make([]string, 0, 2)
+				for _, values := range mock.Requests("update-service-account") {
+					updated = append(updated, values.Get("accessKey"))
+				}
+				slices.Sort(updated)
+				if !slices.Equal(updated, []string{"ALICECI0000000000001", "ALICECI0000000000002"}) {
+					t.Errorf("Expected both CI accounts updated, got %v", updated)
+				}
+			},
+		},
+		{
+			name: "invalid action",
+			request: BulkAccessKeysRequest{
+				Action:   "rotate",
+				Selector: AccessKeySelector{ParentUser: "alice"},
+			},
+			expectedError: ErrInvalidBulkAccessKeyAction,
+		},
+		{
+			name:          "empty selector",
+			request:       BulkAccessKeysRequest{Action: BulkAccessKeyDelete},
+			expectedError: ErrEmptyAccessKeySelector,
+		},
+		{
+			name: "set expiration without expiration",
+			request: BulkAccessKeysRequest{
+				Action:   BulkAccessKeySetExpiration,
+				Selector: AccessKeySelector{ParentUser: "alice"},
+			},
+			expectedError: ErrMissingBulkExpiration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			users, accessKeys, stored := scenarios.BulkServiceAccounts()
+			mockServer.SetUsersResponse(users)
+			mockServer.SetAccessKeysBulkResponse(accessKeys)
+			for _, info := range stored {
+				mockServer.AddServiceAccountToStore(info.AccessKey, "", info.Name, info.Description, info.Status, info.ParentUser, nil, info.Expiration)
+			}
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			service := NewBulkAccessKeysService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := service.Execute(ctx, tt.request)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			tt.validateResult(t, result, mockServer)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type CheckLDAPConfigService struct {
+	minioClient *madmin.AdminClient
+}
+
+// LDAPCheckResponse represents whether MinIO can bind to the LDAP server with the lookup bind credentials
+type LDAPCheckResponse struct {
+	OK     bool   `json:"ok"`
+	Status string `json:"status"` // none, disabled, connection or invalid
+	Error  string `json:"error,omitempty"`
+}
+
+func NewCheckLDAPConfigService(minioClient *madmin.AdminClient) *CheckLDAPConfigService {
+	return &CheckLDAPConfigService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute lets MinIO connect to the LDAP server and perform the lookup bind of a config
+func (s *CheckLDAPConfigService) Execute(ctx context.Context, name string) (*LDAPCheckResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Str("name", name).Msg("Checking MinIO LDAP config")
+
+	result, err := s.minioClient.CheckIDPConfig(ctx, madmin.LDAPIDPCfg, name)
+	if err != nil {
+		logger.Error().Err(err).Str("name", name).Msg("Failed to check MinIO LDAP config")
+		return nil, fmt.Errorf("failed to check LDAP config: %w", err)
+	}
+
+	response := &LDAPCheckResponse{
+		OK:     result.ErrType == madmin.IDPErrNone,
+		Status: result.ErrType,
+		Error:  result.ErrMsg,
+	}
+
+	logger.Info().
+		Str("name", name).
+		Str("status", response.Status).
+		Msg("Successfully checked MinIO LDAP config")
+
+	return response, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestCheckLDAPConfigService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *LDAPCheckResponse)
+	}{
+		{
+			name: "lookup bind succeeds",
+			validateResult: func(t *testing.T, result *LDAPCheckResponse) {
+				if !result.OK || result.Status != "none" || result.Error != "" {
+					t.Errorf("Expected successful check, got %+v", result)
+				}
+			},
+		},
+		{
+			name: "LDAP server unreachable",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPCheckResponse(madmin.CheckIDPConfigResp{
+					ErrType: madmin.IDPErrConnection,
+					ErrMsg:  "dial tcp 10.0.0.5:636: i/o timeout",
+				})
+			},
+			validateResult: func(t *testing.T, result *LDAPCheckResponse) {
+				if result.OK || result.Status != "connection" {
+					t.Errorf("Expected connection failure, got %+v", result)
+				}
+				if result.Error != "dial tcp 10.0.0.5:636: i/o timeout" {
+					t.Errorf("Expected connection error message, got %q", result.Error)
+				}
+			},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(403, "Access Denied")
+			},
+			expectedError: "failed to check LDAP config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewCheckLDAPConfigService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, "_")
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type CollectUsageSnapshotService struct {
+	minioClient          *madmin.AdminClient
+	getServerInfoService *GetServerInfoService
+	history              *store.UsageHistory
+	retention            time.Duration
+}
+
+// NewCollectUsageSnapshotService creates the service recording usage snapshots into history.
+// Snapshots older than retention are removed after each collection, zero keeps them forever.
+func NewCollectUsageSnapshotService(minioClient *madmin.AdminClient, history *store.UsageHistory, retention time.Duration) *CollectUsageSnapshotService {
+	return &CollectUsageSnapshotService{
+		minioClient:          minioClient,
+		getServerInfoService: NewGetServerInfoService(minioClient),
+		history:              history,
+		retention:            retention,
+	}
+}
+
+func (s *CollectUsageSnapshotService) Execute(ctx context.Context) (*store.UsageSnapshot, error) {
+	logger := zerolog.Ctx(ctx)
+	logger.Debug().Msg("Collecting MinIO usage snapshot")
+
+	serverInfo, err := s.getServerInfoService.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect usage snapshot: %w", err)
+	}
+
+	dataUsage, err := s.minioClient.DataUsageInfo(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch MinIO data usage info")
+		return nil, fmt.Errorf("failed to get data usage info: %w", err)
+	}
+
+	snapshot := store.UsageSnapshot{
+		Timestamp:     time.Now().UTC(),
+		TotalCapacity: serverInfo.DiskUsage.TotalCapacity,
+		UsedCapacity:  serverInfo.DiskUsage.TotalUsedCapacity,
+		FreeCapacity:  serverInfo.DiskUsage.TotalFreeCapacity,
+		ObjectsCount:  dataUsage.ObjectsTotalCount,
+		BucketsCount:  dataUsage.BucketsCount,
+		Buckets:       make(map[string]store.BucketUsage, len(dataUsage.BucketsUsage)),
+	}
+
+	// Drive details are missing when the server info omits them, use the scanner totals instead
+	if snapshot.TotalCapacity == 0 {
+		snapshot.TotalCapacity = dataUsage.TotalCapacity
+		snapshot.UsedCapacity = dataUsage.TotalUsedCapacity
+		snapshot.FreeCapacity = dataUsage.TotalFreeCapacity
+	}
+
+	for name, bucket := range dataUsage.BucketsUsage {
+		snapshot.Buckets[name] = store.BucketUsage{
+			Size:         bucket.Size,
+			ObjectsCount: bucket.ObjectsCount,
+		}
+	}
+
+	if err := s.history.Save(snapshot); err != nil {
+		logger.Error().Err(err).Msg("Failed to save usage snapshot")
+		return nil, fmt.Errorf("failed to save usage snapshot: %w", err)
+	}
+
+	if s.retention > 0 {
+		removed, err := s.history.Prune(snapshot.Timestamp.Add(-s.retention))
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to prune usage history")
+			return nil, err
+		}
+		if removed > 0 {
+			logger.Debug().Int("removed", removed).Msg("Pruned expired usage snapshots")
+		}
+	}
+
+	logger.Debug().
+		Uint64("usedCapacity", snapshot.UsedCapacity).
+		Int("buckets", len(snapshot.Buckets)).
+		Msg("Successfully collected MinIO usage snapshot")
+
+	return &snapshot, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestCollectUsageSnapshotService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		setupHistory   func(*testing.T, *store.UsageHistory)
+		expectedError  string
+		validateResult func(t *testing.T, snapshot *store.UsageSnapshot, history *store.UsageHistory)
+	}{
+		{
+			name: "successful snapshot is stored",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			validateResult: func(t *testing.T, snapshot *store.UsageSnapshot, history *store.UsageHistory) {
+				// The mock server info has no drives, so capacity comes from the data usage info
+				if snapshot.TotalCapacity != 1<<40 {
+					t.Errorf("Expected TotalCapacity %d, got %d", uint64(1<<40), snapshot.TotalCapacity)
+				}
+				if snapshot.UsedCapacity != 400<<30 {
+					t.Errorf("Expected UsedCapacity %d, got %d", uint64(400<<30), snapshot.UsedCapacity)
+				}
+				if snapshot.BucketsCount != 3 {
+					t.Errorf("Expected BucketsCount %d, got %d", 3, snapshot.BucketsCount)
+				}
+				if got := snapshot.Buckets["backups"].Size; got != 300<<30 {
+					t.Errorf("Expected backups size %d, got %d", uint64(300<<30), got)
+				}
+
+				stored, err := history.Range(snapshot.Timestamp, snapshot.Timestamp)
+				if err != nil {
+					t.Fatalf("Failed to read history: %v", err)
+				}
+				if len(stored) != 1 {
+					t.Fatalf("Expected %d stored snapshot, got %d", 1, len(stored))
+				}
+				if stored[0].ObjectsCount != 1600 {
+					t.Errorf("Expected stored ObjectsCount %d, got %d", 1600, stored[0].ObjectsCount)
+				}
+			},
+		},
+		{
+			name: "expired snapshots are pruned",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			setupHistory: func(t *testing.T, history *store.UsageHistory) {
+				if err := history.Save(store.UsageSnapshot{Timestamp: time.Now().Add(-48 * time.Hour)}); err != nil {
+					t.Fatalf("Failed to seed history: %v", err)
+				}
+			},
+			validateResult: func(t *testing.T, snapshot *store.UsageSnapshot, history *store.UsageHistory) {
+				stored, err := history.Range(time.Time{}, snapshot.Timestamp)
+				if err != nil {
+					t.Fatalf("Failed to read history: %v", err)
+				}
+				if len(stored) != 1 {
+					t.Errorf("Expected only the new snapshot to remain, got %d snapshots", len(stored))
+				}
+			},
+		},
+		{
+			name: "server info error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServerInfoNonRetryableError(400, "Bad Request")
+			},
+			expectedError: "failed to collect usage snapshot",
+		},
+		{
+			name: "data usage error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetDataUsageError(403, "Forbidden")
+			},
+			expectedError: "failed to get data usage info",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			history := newTestUsageHistory(t)
+			if tt.setupHistory != nil {
+				tt.setupHistory(t, history)
+			}
+
+			// Create service
+			service := NewCollectUsageSnapshotService(minioClient, history, 24*time.Hour)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if result != nil {
+					t.Errorf("Expected nil result when error occurs, got %+v", result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				if result == nil {
+					t.Fatal("Expected result, got nil")
+				}
+				if tt.validateResult != nil {
+					tt.validateResult(t, result, history)
+				}
+			}
+		})
+	}
+}
+
+// newTestUsageHistory creates a usage history backed by a temporary database
+func newTestUsageHistory(t *testing.T) *store.UsageHistory {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	history, err := store.NewUsageHistory(db)
+	if err != nil {
+		t.Fatalf("Failed to create usage history: %v", err)
+	}
+
+	return history
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// MaskedConfigValue replaces the value of sensitive config keys in responses
+const MaskedConfigValue = "********"
+
+// ErrInvalidConfig is returned when a config change does not match the subsystem help
+var ErrInvalidConfig = errors.New("invalid config")
+
+// sensitiveConfigKeySuffixes identifies config keys holding credentials, e.g. auth_token or client_secret
+var sensitiveConfigKeySuffixes = []string{
+	"password",
+	"secret",
+	"secret_key",
+	"token",
+	"api_key",
+	"connection_string",
+	"dsn_string",
+}
+
+// sensitiveConfigPattern matches the sensitive key=value pairs of raw config lines,
+// including environment variables such as MINIO_NOTIFY_WEBHOOK_AUTH_TOKEN reported as comments
+var sensitiveConfigPattern = regexp.MustCompile(`(?i)(\b[a-z_]*(?:` + strings.Join(sensitiveConfigKeySuffixes, "|") + `))=("[^"]*"|\S*)`)
+
+// configTargetPattern matches target names. MinIO reads the config command up to the next space and
+// one subsystem per line, so a target must not contain either.
+var configTargetPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,63}$`)
+
+// ValidConfigSubsystem reports whether name is a config subsystem known to MinIO
+func ValidConfigSubsystem(name string) bool {
+	return madmin.SubSystems.Contains(name)
+}
+
+// validateConfigTarget checks the target of a subsystem, empty is the default target
+func validateConfigTarget(target string) error {
+	if target != "" && !configTargetPattern.MatchString(target) {
+		return fmt.Errorf("%w: target must start with a letter or digit and only contain letters, digits, - and _", ErrInvalidConfig)
+	}
+	return nil
+}
+
+// IsSensitiveConfigKey reports whether the config key holds a credential which must not be shown
+func IsSensitiveConfigKey(key string) bool {
+	for _, suffix := range sensitiveConfigKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskConfigValue hides the value of a sensitive key, empty values are kept to show the key is unset
+func maskConfigValue(key, value string) string {
+	if value == "" || !IsSensitiveConfigKey(key) {
+		return value
+	}
+	return MaskedConfigValue
+}
+
+// maskConfigLines hides the sensitive values of raw config lines as used by config history
+func maskConfigLines(data string) string {
+	return sensitiveConfigPattern.ReplaceAllStringFunc(data, func(pair string) string {
+		key, value, _ := strings.Cut(pair, "=")
+		if value == "" || value == `""` {
+			return pair
+		}
+		return key + "=" + MaskedConfigValue
+	})
+}
+
+// configTargetName returns the subsystem name with the target suffix used by MinIO, e.g. "notify_webhook:primary"
+func configTargetName(subSystem, target string) string {
+	if target == "" {
+		return subSystem
+	}
+	return subSystem + ":" + target
+}
+
+// validateConfigValue checks a value against the type reported by the config help
+func validateConfigValue(key, valueType, value string) error {
+	if strings.Contains(value, `"`) {
+		return fmt.Errorf("%w: value of %s must not contain double quotes", ErrInvalidConfig, key)
+	}
+	// A line break would start the line of another subsystem
+	if strings.IndexFunc(value, unicode.IsControl) >= 0 {
+		return fmt.Errorf("%w: value of %s must not contain line breaks or control characters", ErrInvalidConfig, key)
+	}
+
+	// Empty values reset the key to its default
+	if value == "" {
+		return nil
+	}
+
+	switch valueType {
+	case "on|off":
+		if value != "on" && value != "off" {
+			return fmt.Errorf("%w: value of %s must be on or off", ErrInvalidConfig, key)
+		}
+	case "number":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%w: value of %s must be a number", ErrInvalidConfig, key)
+		}
+	case "duration":
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("%w: value of %s must be a duration, e.g. 30s or 5m", ErrInvalidConfig, key)
+		}
+	case "url":
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("%w: value of %s must be an absolute URL", ErrInvalidConfig, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMaskConfigLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "plain token is masked",
+			data:     "notify_webhook:primary endpoint=https://hooks.example.com auth_token=s3cr3t",
+			expected: "notify_webhook:primary endpoint=https://hooks.example.com auth_token=********",
+		},
+		{
+			name:     "quoted secret is masked",
+			data:     `identity_openid client_id=minio client_secret="with spaces" scopes=openid`,
+			expected: `identity_openid client_id=minio client_secret=******** scopes=openid`,
+		},
+		{
+			name:     "empty secret is kept",
+			data:     `notify_redis password= address=localhost:6379`,
+			expected: `notify_redis password= address=localhost:6379`,
+		},
+		{
+			name:     "config without secrets is unchanged",
+			data:     "api requests_max=1000",
+			expected: "api requests_max=1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maskConfigLines(tt.data); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateConfigValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		valueType string
+		value     string
+		valid     bool
+	}{
+		{name: "on", valueType: "on|off", value: "on", valid: true},
+		{name: "invalid on|off", valueType: "on|off", value: "yes"},
+		{name: "number", valueType: "number", value: "100", valid: true},
+		{name: "invalid number", valueType: "number", value: "many"},
+		{name: "duration", valueType: "duration", value: "30s", valid: true},
+		{name: "invalid duration", valueType: "duration", value: "soon"},
+		{name: "url", valueType: "url", value: "https://hooks.example.com/minio", valid: true},
+		{name: "relative url", valueType: "url", value: "/minio"},
+		{name: "empty value resets to default", valueType: "number", value: "", valid: true},
+		{name: "double quote", valueType: "sentence", value: `say "hi"`},
+		{name: "line break", valueType: "sentence", value: "on\nnotify_webhook:evil endpoint=http://evil"},
+		{name: "control character", valueType: "sentence", value: "on\x00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConfigValue("key", tt.valueType, tt.value)
+			if tt.valid && err != nil {
+				t.Errorf("Expected value to be valid, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("Expected %v, got %v", ErrInvalidConfig, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultConfirmationTTL is how long a confirmation token stays valid after it was issued
+const DefaultConfirmationTTL = 5 * time.Minute
+
+// ErrInvalidConfirmationToken is returned when a confirmation token is unknown, expired or issued for another operation
+var ErrInvalidConfirmationToken = errors.New("invalid or expired confirmation token")
+
+// ConfirmationTokens issues single-use tokens which must be sent back to run a disruptive operation.
+// Each token is bound to a subject describing the confirmed operation, e.g. "cluster:restart".
+type ConfirmationTokens struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]pendingConfirmation
+}
+
+type pendingConfirmation struct {
+	subject   string
+	expiresAt time.Time
+}
+
+func NewConfirmationTokens(ttl time.Duration) *ConfirmationTokens {
+	return &ConfirmationTokens{
+		ttl:    ttl,
+		now:    time.Now,
+		tokens: make(map[string]pendingConfirmation),
+	}
+}
+
+// Issue creates a token confirming the operation described by subject
+func (c *ConfirmationTokens) Issue(subject string) (string, time.Time, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate confirmation token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	// Drop expired tokens so abandoned confirmations do not pile up
+	for key, pending := range c.tokens {
+		if !now.Before(pending.expiresAt) {
+			delete(c.tokens, key)
+		}
+	}
+
+	expiresAt := now.Add(c.ttl)
+	c.tokens[token] = pendingConfirmation{subject: subject, expiresAt: expiresAt}
+
+	return token, expiresAt, nil
+}
+
+// Consume checks the token confirms subject and invalidates it
+func (c *ConfirmationTokens) Consume(subject, token string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pending, exists := c.tokens[token]
+	if !exists || pending.subject != subject {
+		return ErrInvalidConfirmationToken
+	}
+
+	delete(c.tokens, token)
+	if !c.now().Before(pending.expiresAt) {
+		return ErrInvalidConfirmationToken
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestConfirmationTokens_Consume(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		subject       string
+		token         func(issued string) string
+		elapsed       time.Duration
+		expectedError error
+	}{
+		{
+			name:    "token confirms its subject",
+			subject: "cluster:restart",
+			token:   func(issued string) string { return issued },
+		},
+		{
+			name:          "token issued for another subject",
+			subject:       "cluster:stop",
+			token:         func(issued string) string { return issued },
+			expectedError: ErrInvalidConfirmationToken,
+		},
+		{
+			name:          "unknown token",
+			subject:       "cluster:restart",
+			token:         func(string) string { return "unknown" },
+			expectedError: ErrInvalidConfirmationToken,
+		},
+		{
+			name:          "expired token",
+			subject:       "cluster:restart",
+			token:         func(issued string) string { return issued },
+			elapsed:       time.Minute,
+			expectedError: ErrInvalidConfirmationToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := NewConfirmationTokens(time.Minute)
+			tokens.now = func() time.Time { return now }
+
+			issued, expiresAt, err := tokens.Issue("cluster:restart")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !expiresAt.Equal(now.Add(time.Minute)) {
+				t.Errorf("Expected ExpiresAt %v, got %v", now.Add(time.Minute), expiresAt)
+			}
+
+			tokens.now = func() time.Time { return now.Add(tt.elapsed) }
+
+			err = tokens.Consume(tt.subject, tt.token(issued))
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+// TestConfirmationTokens_SingleUse tests that a token cannot be replayed
+func TestConfirmationTokens_SingleUse(t *testing.T) {
+	tokens := NewConfirmationTokens(time.Minute)
+
+	token, _, err := tokens.Issue("cluster:restart")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if err := tokens.Consume("cluster:restart", token); err != nil {
+		t.Fatalf("Expected first use to succeed, got %v", err)
+	}
+	if err := tokens.Consume("cluster:restart", token); !errors.Is(err, ErrInvalidConfirmationToken) {
+		t.Errorf("Expected replay to fail with %v, got %v", ErrInvalidConfirmationToken, err)
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type CreateKMSKeyService struct {
+	minioClient *madmin.AdminClient
+}
+
+// CreateKMSKeyRequest represents the request to create a key
+type CreateKMSKeyRequest struct {
+	KeyID string `json:"keyId"`
+}
+
+// CreateKMSKeyResponse represents a created key and whether MinIO can use it
+type CreateKMSKeyResponse struct {
+	KeyID string       `json:"keyId"`
+	Check *KMSKeyCheck `json:"check,omitempty"` // Omitted when the key could not be checked
+}
+
+func NewCreateKMSKeyService(minioClient *madmin.AdminClient) *CreateKMSKeyService {
+	return &CreateKMSKeyService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute creates a new master key in the KMS and verifies it with an encryption round-trip
+func (s *CreateKMSKeyService) Execute(ctx context.Context, req CreateKMSKeyRequest) (*CreateKMSKeyResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !ValidKMSKeyID(req.KeyID) {
+		return nil, fmt.Errorf("%w: %q. Must start with a letter or digit and contain at most 80 letters, digits, '.', '_' or '-'", ErrInvalidKMSKeyID, req.KeyID)
+	}
+
+	logger.Debug().Str("keyId", req.KeyID).Msg("Creating MinIO KMS key")
+
+	if err := s.minioClient.CreateKey(ctx, req.KeyID); err != nil {
+		logger.Error().Err(err).Str("keyId", req.KeyID).Msg("Failed to create MinIO KMS key")
+		return nil, fmt.Errorf("failed to create KMS key: %w", err)
+	}
+
+	response := &CreateKMSKeyResponse{KeyID: req.KeyID}
+
+	check, err := checkKMSKey(ctx, s.minioClient, req.KeyID)
+	if err != nil {
+		logger.Warn().Err(err).Str("keyId", req.KeyID).Msg("Failed to check created MinIO KMS key")
+	} else {
+		response.Check = check
+	}
+
+	logger.Info().Str("keyId", req.KeyID).Msg("Successfully created MinIO KMS key")
+
+	return response, nil
+}
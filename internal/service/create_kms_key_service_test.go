@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestCreateKMSKeyService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name            string
+		request         CreateKMSKeyRequest
+		setupMock       func(*minio.MockMinIOServer)
+		expectedError   string
+		expectedInvalid bool
+	}{
+		{
+			name:    "successful creation",
+			request: CreateKMSKeyRequest{KeyID: "tenant-b-key"},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+		},
+		{
+			name:    "existing key",
+			request: CreateKMSKeyRequest{KeyID: "tenant-a-key"},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedError: "failed to create KMS key",
+		},
+		{
+			name:            "empty key ID",
+			request:         CreateKMSKeyRequest{},
+			setupMock:       func(mock *minio.MockMinIOServer) {},
+			expectedError:   "invalid KMS key ID",
+			expectedInvalid: true,
+		},
+		{
+			name:            "key ID with glob characters",
+			request:         CreateKMSKeyRequest{KeyID: "tenant-*"},
+			setupMock:       func(mock *minio.MockMinIOServer) {},
+			expectedError:   "invalid KMS key ID",
+			expectedInvalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewCreateKMSKeyService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if got := errors.Is(err, ErrInvalidKMSKeyID); got != tt.expectedInvalid {
+					t.Errorf("Expected ErrInvalidKMSKeyID %v, got %v", tt.expectedInvalid, got)
+				}
+				if tt.expectedInvalid && len(mockServer.Requests("kms-key-create")) != 0 {
+					t.Error("Expected invalid key not to be sent to MinIO")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result.KeyID != tt.request.KeyID {
+				t.Errorf("Expected KeyID %q, got %q", tt.request.KeyID, result.KeyID)
+			}
+			if result.Check == nil || !result.Check.Usable {
+				t.Errorf("Expected usable key, got %+v", result.Check)
+			}
+		})
+	}
+}
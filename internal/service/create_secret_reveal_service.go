@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+// RevealLink represents a one-time link to view a secret
+type RevealLink struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CreateSecretRevealService encrypts secrets into one-time reveal links.
+// Every secret has its own key which is only part of the link fragment, the store keeps the ciphertext.
+type CreateSecretRevealService struct {
+	reveals *store.Reveals
+	ttl     time.Duration
+	baseURL string
+	now     func() time.Time
+}
+
+// NewCreateSecretRevealService creates reveal links below baseURL, a relative link is created when it is empty
+func NewCreateSecretRevealService(reveals *store.Reveals, ttl time.Duration, baseURL string) *CreateSecretRevealService {
+	return &CreateSecretRevealService{
+		reveals: reveals,
+		ttl:     ttl,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		now:     time.Now,
+	}
+}
+
+func (s *CreateSecretRevealService) Execute(ctx context.Context, secret string) (*RevealLink, error) {
+	logger := zerolog.Ctx(ctx)
+	now := s.now().UTC()
+
+	// Expired reveals are removed whenever a new one is created
+	if removed, err := s.reveals.Prune(now); err != nil {
+		logger.Warn().Err(err).Msg("Failed to prune expired reveals")
+	} else if removed > 0 {
+		logger.Debug().Int("removed", removed).Msg("Pruned expired reveals")
+	}
+
+	id, err := randomHex(16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate reveal ID: %w", err)
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate reveal key: %w", err)
+	}
+
+	ciphertext, err := sealSecret(key, id, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	reveal := store.Reveal{
+		ID:         id,
+		Ciphertext: ciphertext,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(s.ttl),
+	}
+	if err := s.reveals.Save(reveal); err != nil {
+		logger.Error().Err(err).Msg("Failed to save reveal")
+		return nil, fmt.Errorf("failed to save reveal: %w", err)
+	}
+
+	logger.Info().Str("reveal", id).Time("expiresAt", reveal.ExpiresAt).Msg("Reveal link created")
+
+	// The key is in the fragment, browsers do not send it to the server or link previews
+	return &RevealLink{
+		URL:       s.baseURL + "/reveal/" + id + "#" + base64.RawURLEncoding.EncodeToString(key),
+		ExpiresAt: reveal.ExpiresAt,
+	}, nil
+}
+
+// sealSecret encrypts the secret with AES-GCM bound to the reveal ID, the nonce is prepended to the ciphertext
+func sealSecret(key []byte, id string, secret string) ([]byte, error) {
+	gcm, err := newRevealCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, []byte(secret), []byte(id)), nil
+}
+
+// newRevealCipher creates the AES-GCM cipher of a reveal key
+func newRevealCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return gcm, nil
+}
+
+// randomHex generates n random bytes encoded as hex
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+func TestCreateSecretRevealService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		baseURL        string
+		expectedPrefix string
+	}{
+		{
+			name:           "relative link",
+			expectedPrefix: "/reveal/",
+		},
+		{
+			name:           "link below the base URL",
+			baseURL:        "https://admin.example.com/",
+			expectedPrefix: "https://admin.example.com/reveal/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reveals := newTestReveals(t)
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			// An expired reveal is pruned when a new one is created
+			if err := reveals.Save(store.Reveal{ID: "expired", ExpiresAt: now.Add(-time.Minute)}); err != nil {
+				t.Fatalf("Failed to seed reveal: %v", err)
+			}
+
+			service := NewCreateSecretRevealService(reveals, time.Hour, tt.baseURL)
+			service.now = func() time.Time { return now }
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			link, err := service.Execute(ctx, "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if !strings.HasPrefix(link.URL, tt.expectedPrefix) {
+				t.Errorf("Expected URL with prefix %q, got %q", tt.expectedPrefix, link.URL)
+			}
+			if strings.Contains(link.URL, "wJalrXUtnFEMI") {
+				t.Errorf("Expected URL without the secret, got %q", link.URL)
+			}
+			if !link.ExpiresAt.Equal(now.Add(time.Hour)) {
+				t.Errorf("Expected expiration %v, got %v", now.Add(time.Hour), link.ExpiresAt)
+			}
+
+			id, _, found := strings.Cut(strings.TrimPrefix(link.URL, tt.expectedPrefix), "#")
+			if !found {
+				t.Fatalf("Expected the key in the URL fragment, got %q", link.URL)
+			}
+			reveal, err := reveals.Take(id)
+			if err != nil || reveal == nil {
+				t.Fatalf("Expected stored reveal %q, got %v, %v", id, reveal, err)
+			}
+			if strings.Contains(string(reveal.Ciphertext), "wJalrXUtnFEMI") {
+				t.Error("Expected the stored secret to be encrypted")
+			}
+
+			if expired, _ := reveals.Take("expired"); expired != nil {
+				t.Error("Expected the expired reveal to be pruned")
+			}
+		})
+	}
+}
+
+func newTestReveals(t *testing.T) *store.Reveals {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	reveals, err := store.NewReveals(db)
+	if err != nil {
+		t.Fatalf("Failed to create reveals: %v", err)
+	}
+
+	return reveals
+}
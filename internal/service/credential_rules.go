@@ -0,0 +1,174 @@
+package service
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// Character sets of generated keys, following the AWS key format
+const (
+	DefaultAccessKeyCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	DefaultSecretKeyCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+)
+
+// Limits MinIO enforces on service account keys
+const (
+	minAccessKeyLength = 3
+	maxAccessKeyLength = 20
+	minSecretKeyLength = 8
+	maxSecretKeyLength = 40
+)
+
+var (
+	ErrInvalidCredentialRules = errors.New("invalid credential rules")
+	ErrWeakSecretKey          = errors.New("secret key is too weak")
+)
+
+// CredentialRules describes the keys generated for new service accounts and the strength required of supplied secret keys
+type CredentialRules struct {
+	AccessKeyPrefix  string
+	AccessKeyLength  int // Including the prefix
+	AccessKeyCharset string
+	SecretKeyLength  int
+	SecretKeyCharset string
+	MinSecretEntropy float64 // Estimated bits required of user-supplied secret keys, zero disables the check
+}
+
+// DefaultCredentialRules returns AWS style keys, an AKIA prefixed access key and a 40 character secret key
+func DefaultCredentialRules() CredentialRules {
+	return CredentialRules{
+		AccessKeyPrefix:  "AKIA",
+		AccessKeyLength:  20,
+		AccessKeyCharset: DefaultAccessKeyCharset,
+		SecretKeyLength:  40,
+		SecretKeyCharset: DefaultSecretKeyCharset,
+		MinSecretEntropy: 64,
+	}
+}
+
+// Validate checks the generated keys are accepted by MinIO and not trivially guessable
+func (r CredentialRules) Validate() error {
+	if r.AccessKeyLength < minAccessKeyLength || r.AccessKeyLength > maxAccessKeyLength {
+		return fmt.Errorf("%w: access key length must be between %d and %d", ErrInvalidCredentialRules, minAccessKeyLength, maxAccessKeyLength)
+	}
+	if len(r.AccessKeyPrefix) >= r.AccessKeyLength {
+		return fmt.Errorf("%w: access key prefix must be shorter than the access key length", ErrInvalidCredentialRules)
+	}
+	if r.SecretKeyLength < minSecretKeyLength || r.SecretKeyLength > maxSecretKeyLength {
+		return fmt.Errorf("%w: secret key length must be between %d and %d", ErrInvalidCredentialRules, minSecretKeyLength, maxSecretKeyLength)
+	}
+	if err := validateCharset("access key", r.AccessKeyCharset); err != nil {
+		return err
+	}
+	if err := validateCharset("secret key", r.SecretKeyCharset); err != nil {
+		return err
+	}
+	if r.MinSecretEntropy < 0 {
+		return fmt.Errorf("%w: minimum secret key entropy must not be negative", ErrInvalidCredentialRules)
+	}
+	return nil
+}
+
+// validateCharset requires at least two distinct printable ASCII characters
+func validateCharset(kind, charset string) error {
+	seen := make(map[rune]bool)
+	for _, c := range charset {
+		if c > unicode.MaxASCII || !unicode.IsPrint(c) || c == ' ' {
+			return fmt.Errorf("%w: %s charset must only contain printable ASCII characters", ErrInvalidCredentialRules, kind)
+		}
+		seen[c] = true
+	}
+	if len(seen) < 2 {
+		return fmt.Errorf("%w: %s charset needs at least two distinct characters", ErrInvalidCredentialRules, kind)
+	}
+	return nil
+}
+
+// GenerateAccessKey returns a random access key starting with the prefix
+func (r CredentialRules) GenerateAccessKey() (string, error) {
+	suffix, err := randomString(r.AccessKeyCharset, r.AccessKeyLength-len(r.AccessKeyPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate access key: %w", err)
+	}
+	return r.AccessKeyPrefix + suffix, nil
+}
+
+// GenerateSecretKey returns a random secret key
+func (r CredentialRules) GenerateSecretKey() (string, error) {
+	secretKey, err := randomString(r.SecretKeyCharset, r.SecretKeyLength)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate secret key: %w", err)
+	}
+	return secretKey, nil
+}
+
+// CheckSecretKey rejects a user-supplied secret key below the minimum entropy
+func (r CredentialRules) CheckSecretKey(secretKey string) error {
+	if r.MinSecretEntropy == 0 {
+		return nil
+	}
+	if bits := SecretKeyEntropy(secretKey); bits < r.MinSecretEntropy {
+		return fmt.Errorf("%w: estimated %.0f bits of entropy, at least %.0f required", ErrWeakSecretKey, bits, r.MinSecretEntropy)
+	}
+	return nil
+}
+
+// SecretKeyEntropy estimates the bits of entropy of a secret key.
+// Every distinct character counts as a pick from the character classes used, so repeated characters add nothing.
+func SecretKeyEntropy(secretKey string) float64 {
+	var lower, upper, digit, symbol bool
+	distinct := make(map[rune]bool)
+	for _, c := range secretKey {
+		distinct[c] = true
+		switch {
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c >= '0' && c <= '9':
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	pool := 0
+	if lower {
+		pool += 26
+	}
+	if upper {
+		pool += 26
+	}
+	if digit {
+		pool += 10
+	}
+	if symbol {
+		pool += 33 // Printable ASCII punctuation and space
+	}
+	if pool < 2 {
+		return 0
+	}
+
+	return float64(len(distinct)) * math.Log2(float64(pool))
+}
+
+// randomString picks n characters of charset uniformly with crypto/rand
+func randomString(charset string, n int) (string, error) {
+	max := big.NewInt(int64(len(charset)))
+
+	var b strings.Builder
+	b.Grow(n)
+	for range n {
+		i, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(charset[i.Int64()])
+	}
+	return b.String(), nil
+}
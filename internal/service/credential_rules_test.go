@@ -0,0 +1,130 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCredentialRules_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(*CredentialRules)
+		expectedError string
+	}{
+		{
+			name:   "default rules",
+			modify: func(r *CredentialRules) {},
+		},
+		{
+			name:   "without prefix",
+			modify: func(r *CredentialRules) { r.AccessKeyPrefix = "" },
+		},
+		{
+			name:          "access key longer than MinIO accepts",
+			modify:        func(r *CredentialRules) { r.AccessKeyLength = 21 },
+			expectedError: "access key length must be between 3 and 20",
+		},
+		{
+			name:          "prefix fills the access key",
+			modify:        func(r *CredentialRules) { r.AccessKeyPrefix = "AKIA"; r.AccessKeyLength = 4 },
+			expectedError: "access key prefix must be shorter than the access key length",
+		},
+		{
+			name:          "secret key shorter than MinIO accepts",
+			modify:        func(r *CredentialRules) { r.SecretKeyLength = 7 },
+			expectedError: "secret key length must be between 8 and 40",
+		},
+		{
+			name:          "single character charset",
+			modify:        func(r *CredentialRules) { r.SecretKeyCharset = "aaaa" },
+			expectedError: "secret key charset needs at least two distinct characters",
+		},
+		{
+			name:          "non printable charset",
+			modify:        func(r *CredentialRules) { r.AccessKeyCharset = "AB\n" },
+			expectedError: "access key charset must only contain printable ASCII characters",
+		},
+		{
+			name:          "negative entropy",
+			modify:        func(r *CredentialRules) { r.MinSecretEntropy = -1 },
+			expectedError: "minimum secret key entropy must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultCredentialRules()
+			tt.modify(&rules)
+
+			err := rules.Validate()
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidCredentialRules) {
+				t.Fatalf("Expected ErrInvalidCredentialRules, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestCredentialRules_Generate(t *testing.T) {
+	rules := CredentialRules{
+		AccessKeyPrefix:  "SVC",
+		AccessKeyLength:  12,
+		AccessKeyCharset: "XYZ",
+		SecretKeyLength:  16,
+		SecretKeyCharset: "01",
+	}
+
+	accessKey, err := rules.GenerateAccessKey()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(accessKey) != 12 || !strings.HasPrefix(accessKey, "SVC") || strings.Trim(accessKey[3:], "XYZ") != "" {
+		t.Errorf("Expected a 12 character access key of SVC and XYZ, got %q", accessKey)
+	}
+
+	secretKey, err := rules.GenerateSecretKey()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(secretKey) != 16 || strings.Trim(secretKey, "01") != "" {
+		t.Errorf("Expected a 16 character secret key of 0 and 1, got %q", secretKey)
+	}
+}
+
+func TestCredentialRules_CheckSecretKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		secretKey   string
+		minEntropy  float64
+		expectedErr bool
+	}{
+		{name: "generated style secret key", secretKey: "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", minEntropy: 64},
+		{name: "repeated characters", secretKey: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", minEntropy: 64, expectedErr: true},
+		{name: "short dictionary style", secretKey: "password1234", minEntropy: 64, expectedErr: true},
+		{name: "check disabled", secretKey: "aaaaaaaa", minEntropy: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultCredentialRules()
+			rules.MinSecretEntropy = tt.minEntropy
+
+			err := rules.CheckSecretKey(tt.secretKey)
+			if tt.expectedErr && !errors.Is(err, ErrWeakSecretKey) {
+				t.Errorf("Expected ErrWeakSecretKey, got %v", err)
+			}
+			if !tt.expectedErr && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type DeleteConfigSubsystemService struct {
+	minioClient *madmin.AdminClient
+}
+
+// DeleteConfigRequest represents the reset of a subsystem target to its defaults.
+// Only the given keys are reset when Keys is not empty.
+type DeleteConfigRequest struct {
+	SubSystem string
+	Target    string
+	Keys      []string
+}
+
+func NewDeleteConfigSubsystemService(minioClient *madmin.AdminClient) *DeleteConfigSubsystemService {
+	return &DeleteConfigSubsystemService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *DeleteConfigSubsystemService) Execute(ctx context.Context, req DeleteConfigRequest) (*ConfigChangeResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := validateConfigTarget(req.Target); err != nil {
+		return nil, err
+	}
+
+	help, err := s.minioClient.HelpConfigKV(ctx, req.SubSystem, "", false)
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", req.SubSystem).Msg("Failed to fetch MinIO config help")
+		return nil, fmt.Errorf("failed to get config help: %w", err)
+	}
+
+	if req.Target != "" && !help.MultipleTargets {
+		return nil, fmt.Errorf("%w: %s does not support targets", ErrInvalidConfig, req.SubSystem)
+	}
+
+	known := make(map[string]bool, len(help.KeysHelp))
+	for _, keyHelp := range help.KeysHelp {
+		known[keyHelp.Key] = true
+	}
+	for _, key := range req.Keys {
+		if !known[key] {
+			return nil, fmt.Errorf("%w: unknown key %s for %s", ErrInvalidConfig, key, req.SubSystem)
+		}
+	}
+
+	name := configTargetName(req.SubSystem, req.Target)
+	command := strings.TrimSpace(name + " " + strings.Join(req.Keys, " "))
+
+	logger.Debug().Str("config", name).Strs("keys", req.Keys).Msg("Resetting MinIO config")
+
+	restart, err := s.minioClient.DelConfigKV(ctx, command)
+	if err != nil {
+		logger.Error().Err(err).Str("config", name).Msg("Failed to reset MinIO config")
+		return nil, fmt.Errorf("failed to reset config: %w", err)
+	}
+
+	logger.Info().
+		Str("config", name).
+		Strs("keys", req.Keys).
+		Bool("restartRequired", restart).
+		Msg("Successfully reset MinIO config")
+
+	return &ConfigChangeResponse{
+		SubSystem:       req.SubSystem,
+		Target:          req.Target,
+		RestartRequired: restart,
+	}, nil
+}
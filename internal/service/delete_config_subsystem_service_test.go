@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestDeleteConfigSubsystemService_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       DeleteConfigRequest
+		setupMock     func(*minio.MockMinIOServer)
+		expectedError string
+		invalid       bool // Expect ErrInvalidConfig
+		expectedKV    string
+	}{
+		{
+			name:       "reset a named target",
+			request:    DeleteConfigRequest{SubSystem: "notify_webhook", Target: "primary"},
+			expectedKV: "notify_webhook:primary",
+		},
+		{
+			name:       "reset some keys",
+			request:    DeleteConfigRequest{SubSystem: "notify_webhook", Keys: []string{"queue_limit", "comment"}},
+			expectedKV: "notify_webhook queue_limit comment",
+		},
+		{
+			name:          "unknown key",
+			request:       DeleteConfigRequest{SubSystem: "notify_webhook", Keys: []string{"retries"}},
+			invalid:       true,
+			expectedError: "unknown key retries",
+		},
+		{
+			name:          "target with a line break",
+			request:       DeleteConfigRequest{SubSystem: "notify_webhook", Target: "primary\nidentity_openid"},
+			invalid:       true,
+			expectedError: "target must start with a letter or digit",
+		},
+		{
+			name:    "MinIO server error",
+			request: DeleteConfigRequest{SubSystem: "notify_webhook"},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(403, "Access Denied")
+			},
+			expectedError: "failed to get config help",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetConfigHelpResponse("notify_webhook", scenarios.NotifyWebhookConfigHelp())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewDeleteConfigSubsystemService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			_, err = service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if errors.Is(err, ErrInvalidConfig) != tt.invalid {
+					t.Errorf("Expected ErrInvalidConfig to be %v, got %v", tt.invalid, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			requests := mockServer.Requests("del-config-kv")
+			if len(requests) != 1 {
+				t.Fatalf("Expected %d delete config request, got %d", 1, len(requests))
+			}
+			if got := requests[0].Get("kv"); got != tt.expectedKV {
+				t.Errorf("Expected config %q, got %q", tt.expectedKV, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type DeleteIDPConfigService struct {
+	minioClient *madmin.AdminClient
+}
+
+func NewDeleteIDPConfigService(minioClient *madmin.AdminClient) *DeleteIDPConfigService {
+	return &DeleteIDPConfigService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *DeleteIDPConfigService) Execute(ctx context.Context, idpType, name string) (*IDPConfigChangeResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Str("type", idpType).Str("name", name).Msg("Deleting MinIO IDP config")
+
+	restart, err := s.minioClient.DeleteIDPConfig(ctx, idpType, name)
+	if err != nil {
+		logger.Error().Err(err).Str("type", idpType).Str("name", name).Msg("Failed to delete MinIO IDP config")
+		return nil, fmt.Errorf("failed to delete IDP config: %w", err)
+	}
+
+	logger.Info().
+		Str("type", idpType).
+		Str("name", name).
+		Bool("restartRequired", restart).
+		Msg("Successfully deleted MinIO IDP config")
+
+	return &IDPConfigChangeResponse{
+		Type:            idpType,
+		Name:            name,
+		RestartRequired: restart,
+	}, nil
+}
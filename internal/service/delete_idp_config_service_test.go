@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestDeleteIDPConfigService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		configName     string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *IDPConfigChangeResponse)
+	}{
+		{
+			name:       "delete OpenID config",
+			configName: "keycloak",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigRestartRequired(true)
+			},
+			validateResult: func(t *testing.T, result *IDPConfigChangeResponse) {
+				if result.Name != "keycloak" || !result.RestartRequired {
+					t.Errorf("Expected keycloak removal requiring a restart, got %+v", result)
+				}
+			},
+		},
+		{
+			name:          "config not found",
+			configName:    "okta",
+			expectedError: "failed to delete IDP config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			mockServer.SetIDPConfigResponse(minio.TestScenarios{}.OpenIDConfig())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewDeleteIDPConfigService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, "openid", tt.configName)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
@@ -9,7 +9,8 @@ import (
 )
 
 type DeleteServiceAccountService struct {
-	minioClient *madmin.AdminClient
+	minioClient     *madmin.AdminClient
+	serviceAccounts *ServiceAccountCache
 }
 
 // DeleteServiceAccountRequest represents the request to delete a service account
@@ -23,9 +24,10 @@ type DeleteServiceAccountResponse struct {
 	Message   string `json:"message"`
 }
 
-func NewDeleteServiceAccountService(minioClient *madmin.AdminClient) *DeleteServiceAccountService {
+func NewDeleteServiceAccountService(minioClient *madmin.AdminClient, serviceAccounts *ServiceAccountCache) *DeleteServiceAccountService {
 	return &DeleteServiceAccountService{
-		minioClient: minioClient,
+		minioClient:     minioClient,
+		serviceAccounts: serviceAccounts,
 	}
 }
 
@@ -37,6 +39,8 @@ func (s *DeleteServiceAccountService) Execute(ctx context.Context, req DeleteSer
 
 	// Delete the service account
 	err := s.minioClient.DeleteServiceAccount(ctx, req.AccessKey)
+	// A failed request may still have deleted the account
+	s.serviceAccounts.Invalidate(req.AccessKey)
 	if err != nil {
 		logger.Error().Err(err).Str("accessKey", req.AccessKey).Msg("Failed to delete service account")
 		return nil, fmt.Errorf("failed to delete service account: %w", err)
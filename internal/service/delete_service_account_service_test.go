@@ -107,7 +107,7 @@ func TestDeleteServiceAccountService_Execute(t *testing.T) {
 			}
 
 			// Create service
-			service := NewDeleteServiceAccountService(minioClient)
+			service := NewDeleteServiceAccountService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
 
 			// Create context with logger
 			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
@@ -154,7 +154,7 @@ func TestDeleteServiceAccountService_NewDeleteServiceAccountService(t *testing.T
 	}
 
 	// Test service creation
-	service := NewDeleteServiceAccountService(minioClient)
+	service := NewDeleteServiceAccountService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
 
 	if service == nil {
 		t.Fatal("Expected service to be created, got nil")
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type DescribeKMSKeyService struct {
+	minioClient *madmin.AdminClient
+}
+
+// KMSKeyResponse represents a key with its metadata and whether MinIO can use it
+type KMSKeyResponse struct {
+	KeyID     string      `json:"keyId"`
+	CreatedAt time.Time   `json:"createdAt"`
+	CreatedBy string      `json:"createdBy"`
+	Check     KMSKeyCheck `json:"check"`
+}
+
+func NewDescribeKMSKeyService(minioClient *madmin.AdminClient) *DescribeKMSKeyService {
+	return &DescribeKMSKeyService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute returns the metadata of a key and verifies it with an encryption round-trip
+func (s *DescribeKMSKeyService) Execute(ctx context.Context, keyID string) (*KMSKeyResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !ValidKMSKeyID(keyID) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidKMSKeyID, keyID)
+	}
+
+	logger.Debug().Str("keyId", keyID).Msg("Describing MinIO KMS key")
+
+	// A valid key ID contains no glob characters, so the pattern only matches the key itself
+	keys, err := s.minioClient.ListKeys(ctx, keyID)
+	if err != nil {
+		logger.Error().Err(err).Str("keyId", keyID).Msg("Failed to describe MinIO KMS key")
+		return nil, fmt.Errorf("failed to describe KMS key: %w", err)
+	}
+
+	var response *KMSKeyResponse
+	for _, key := range keys {
+		if key.Name == keyID {
+			response = &KMSKeyResponse{
+				KeyID:     key.Name,
+				CreatedAt: key.CreatedAt,
+				CreatedBy: key.CreatedBy,
+			}
+		}
+	}
+	if response == nil {
+		return nil, fmt.Errorf("%w: %s", ErrKMSKeyNotFound, keyID)
+	}
+
+	check, err := checkKMSKey(ctx, s.minioClient, keyID)
+	if err != nil {
+		logger.Error().Err(err).Str("keyId", keyID).Msg("Failed to check MinIO KMS key")
+		return nil, fmt.Errorf("failed to describe KMS key: %w", err)
+	}
+	response.Check = *check
+
+	logger.Info().
+		Str("keyId", keyID).
+		Bool("usable", check.Usable).
+		Msg("Successfully described MinIO KMS key")
+
+	return response, nil
+}
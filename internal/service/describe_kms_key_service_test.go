@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestDescribeKMSKeyService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name           string
+		keyID          string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  error
+		expectedString string
+		validateResult func(t *testing.T, result *KMSKeyResponse)
+	}{
+		{
+			name:  "usable key",
+			keyID: "tenant-a-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			validateResult: func(t *testing.T, result *KMSKeyResponse) {
+				if result.CreatedBy != "minioadmin" {
+					t.Errorf("Expected CreatedBy %q, got %q", "minioadmin", result.CreatedBy)
+				}
+				if result.CreatedAt.IsZero() {
+					t.Error("Expected CreatedAt to be set")
+				}
+				if !result.Check.Usable {
+					t.Errorf("Expected usable key, got %+v", result.Check)
+				}
+			},
+		},
+		{
+			name:  "key failing encryption",
+			keyID: "tenant-a-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+				mock.SetKMSKeyStatusResponse(madmin.KMSKeyStatus{
+					KeyID:         "tenant-a-key",
+					EncryptionErr: "permission denied",
+				})
+			},
+			validateResult: func(t *testing.T, result *KMSKeyResponse) {
+				if result.Check.Usable {
+					t.Error("Expected key not to be usable")
+				}
+				if result.Check.EncryptionError != "permission denied" {
+					t.Errorf("Expected EncryptionError %q, got %q", "permission denied", result.Check.EncryptionError)
+				}
+			},
+		},
+		{
+			name:  "unknown key",
+			keyID: "tenant-z-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedError: ErrKMSKeyNotFound,
+		},
+		{
+			name:          "invalid key ID",
+			keyID:         "../default",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			expectedError: ErrInvalidKMSKeyID,
+		},
+		{
+			name:  "MinIO server error",
+			keyID: "tenant-a-key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSError(403, "Access Denied")
+			},
+			expectedString: "failed to describe KMS key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewDescribeKMSKeyService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.keyID)
+
+			// Validate results
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if tt.expectedString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedString) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedString, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result.KeyID != tt.keyID {
+				t.Errorf("Expected KeyID %q, got %q", tt.keyID, result.KeyID)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type ExportBucketMetadataService struct {
+	minioClient *madmin.AdminClient
+}
+
+func NewExportBucketMetadataService(minioClient *madmin.AdminClient) *ExportBucketMetadataService {
+	return &ExportBucketMetadataService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute returns a zip archive with the metadata of a bucket: policy, lifecycle, notification, quota,
+// versioning, object lock, replication, tags and encryption. An empty bucket exports every bucket.
+// The caller must close the returned reader.
+func (s *ExportBucketMetadataService) Execute(ctx context.Context, bucket string) (io.ReadCloser, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Str("bucket", bucket).Msg("Exporting MinIO bucket metadata")
+
+	archive, err := s.minioClient.ExportBucketMetadata(ctx, bucket)
+	if err != nil {
+		logger.Error().Err(err).Str("bucket", bucket).Msg("Failed to export MinIO bucket metadata")
+		return nil, fmt.Errorf("failed to export bucket metadata: %w", err)
+	}
+
+	return archive, nil
+}
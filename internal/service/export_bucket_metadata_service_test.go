@@ -0,0 +1,102 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestExportBucketMetadataService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name           string
+		bucket         string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		expectedBucket string
+	}{
+		{
+			name: "export every bucket",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataExportResponse(scenarios.BucketMetadataArchive())
+			},
+		},
+		{
+			name:   "export a single bucket",
+			bucket: "reports",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataExportResponse(scenarios.BucketMetadataArchive())
+			},
+			expectedBucket: "reports",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataError(403, "Access Denied")
+			},
+			expectedError: "failed to export bucket metadata",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewExportBucketMetadataService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.bucket)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			defer result.Close()
+
+			archive, err := io.ReadAll(result)
+			if err != nil {
+				t.Fatalf("Failed to read archive: %v", err)
+			}
+			if !bytes.Equal(archive, scenarios.BucketMetadataArchive()) {
+				t.Errorf("Expected the exported archive, got %d bytes", len(archive))
+			}
+
+			requests := mockServer.Requests("export-bucket-metadata")
+			if len(requests) != 1 {
+				t.Fatalf("Expected 1 export request, got %d", len(requests))
+			}
+			if got := requests[0].Get("bucket"); got != tt.expectedBucket {
+				t.Errorf("Expected bucket %q, got %q", tt.expectedBucket, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type ExportConfigService struct {
+	minioClient *madmin.AdminClient
+}
+
+func NewExportConfigService(minioClient *madmin.AdminClient) *ExportConfigService {
+	return &ExportConfigService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute returns the whole server config as config lines.
+// Sensitive values are masked unless includeSecrets is set, a masked export can still be
+// imported into the same cluster as masked values keep the current value.
+func (s *ExportConfigService) Execute(ctx context.Context, includeSecrets bool) ([]byte, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Bool("includeSecrets", includeSecrets).Msg("Exporting MinIO server config")
+
+	config, err := s.minioClient.GetConfig(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to export MinIO server config")
+		return nil, fmt.Errorf("failed to export config: %w", err)
+	}
+
+	if !includeSecrets {
+		config = []byte(maskConfigLines(string(config)))
+	}
+
+	logger.Info().
+		Bool("includeSecrets", includeSecrets).
+		Int("size", len(config)).
+		Msg("Successfully exported MinIO server config")
+
+	return config, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestExportConfigService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		includeSecrets bool
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		expected       []string
+		unexpected     []string
+	}{
+		{
+			name: "secrets are masked by default",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerConfigResponse(scenarios.ServerConfig())
+			},
+			expected:   []string{"api requests_max=1000", "auth_token=" + MaskedConfigValue},
+			unexpected: []string{"s3cr3t-hook-token"},
+		},
+		{
+			name:           "secrets are included on request",
+			includeSecrets: true,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerConfigResponse(scenarios.ServerConfig())
+			},
+			expected: []string{"auth_token=s3cr3t-hook-token"},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(403, "Access Denied")
+			},
+			expectedError: "failed to export config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewExportConfigService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.includeSecrets)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			config := string(result)
+			for _, expected := range tt.expected {
+				if !strings.Contains(config, expected) {
+					t.Errorf("Expected config containing %q, got %q", expected, config)
+				}
+			}
+			for _, unexpected := range tt.unexpected {
+				if strings.Contains(config, unexpected) {
+					t.Errorf("Expected config not to contain %q, got %q", unexpected, config)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type ExportIAMService struct {
+	minioClient *madmin.AdminClient
+}
+
+func NewExportIAMService(minioClient *madmin.AdminClient) *ExportIAMService {
+	return &ExportIAMService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute returns a zip bundle of every IAM entity: users, groups, policies, their mappings and
+// service accounts with their policies. The caller must close the returned reader.
+func (s *ExportIAMService) Execute(ctx context.Context) (io.ReadCloser, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Msg("Exporting MinIO IAM")
+
+	bundle, err := s.minioClient.ExportIAM(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to export MinIO IAM")
+		return nil, fmt.Errorf("failed to export IAM: %w", err)
+	}
+
+	return bundle, nil
+}
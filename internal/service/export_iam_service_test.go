@@ -0,0 +1,86 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestExportIAMService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name          string
+		setupMock     func(*minio.MockMinIOServer)
+		expectedError string
+		expected      []byte
+	}{
+		{
+			name: "successful export",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMExportResponse(scenarios.IAMExportBundle())
+			},
+			expected: scenarios.IAMExportBundle(),
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMError(403, "Access Denied")
+			},
+			expectedError: "failed to export IAM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewExportIAMService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			defer result.Close()
+
+			bundle, err := io.ReadAll(result)
+			if err != nil {
+				t.Fatalf("Failed to read bundle: %v", err)
+			}
+			if !bytes.Equal(bundle, tt.expected) {
+				t.Errorf("Expected bundle of %d bytes, got %d bytes", len(tt.expected), len(bundle))
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type GetBackgroundHealStatusService struct {
+	minioClient *madmin.AdminClient
+}
+
+// BackgroundHealStatus represents the state of the continuous background healing
+type BackgroundHealStatus struct {
+	ScannedItemsCount int64           `json:"scannedItemsCount"`
+	HealingDrives     []string        `json:"healingDrives"`
+	OfflineEndpoints  []string        `json:"offlineEndpoints"`
+	Sets              []HealSetStatus `json:"sets"`
+	Parity            map[string]int  `json:"parity"` // Parity per storage class
+}
+
+// HealSetStatus represents the heal state of an erasure set
+type HealSetStatus struct {
+	PoolIndex    int    `json:"poolIndex"`
+	SetIndex     int    `json:"setIndex"`
+	HealStatus   string `json:"healStatus"`
+	HealPriority string `json:"healPriority"`
+	TotalObjects int    `json:"totalObjects"`
+}
+
+func NewGetBackgroundHealStatusService(minioClient *madmin.AdminClient) *GetBackgroundHealStatusService {
+	return &GetBackgroundHealStatusService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *GetBackgroundHealStatusService) Execute(ctx context.Context) (*BackgroundHealStatus, error) {
+	logger := zerolog.Ctx(ctx)
+	logger.Debug().Msg("Fetching background heal status")
+
+	state, err := s.minioClient.BackgroundHealStatus(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch background heal status")
+		return nil, fmt.Errorf("failed to get background heal status: %w", err)
+	}
+
+	result := &BackgroundHealStatus{
+		ScannedItemsCount: state.ScannedItemsCount,
+		HealingDrives:     append([]string{}, state.HealDisks...),
+		OfflineEndpoints:  append([]string{}, state.OfflineEndpoints...),
+		Sets:              make([]HealSetStatus, 0, len(state.Sets)),
+		Parity:            state.SCParity,
+	}
+	if result.Parity == nil {
+		result.Parity = map[string]int{}
+	}
+
+	for _, set := range state.Sets {
+		result.Sets = append(result.Sets, HealSetStatus{
+			PoolIndex:    set.PoolIndex,
+			SetIndex:     set.SetIndex,
+			HealStatus:   set.HealStatus,
+			HealPriority: set.HealPriority,
+			TotalObjects: set.TotalObjects,
+		})
+	}
+
+	sort.Slice(result.Sets, func(i, j int) bool {
+		if result.Sets[i].PoolIndex != result.Sets[j].PoolIndex {
+			return result.Sets[i].PoolIndex < result.Sets[j].PoolIndex
+		}
+		return result.Sets[i].SetIndex < result.Sets[j].SetIndex
+	})
+
+	return result, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestGetBackgroundHealStatusService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *BackgroundHealStatus)
+	}{
+		{
+			name: "successful background heal status",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetBackgroundHealStatusResponse(scenarios.SuccessfulBackgroundHealStatus())
+			},
+			validateResult: func(t *testing.T, result *BackgroundHealStatus) {
+				if result.ScannedItemsCount != 4200 {
+					t.Errorf("Expected ScannedItemsCount %d, got %d", 4200, result.ScannedItemsCount)
+				}
+				if len(result.HealingDrives) != 1 {
+					t.Errorf("Expected %d healing drive, got %d", 1, len(result.HealingDrives))
+				}
+				if len(result.Sets) != 2 {
+					t.Fatalf("Expected %d sets, got %d", 2, len(result.Sets))
+				}
+				// Sets are sorted by pool and set index
+				if result.Sets[1].HealStatus != "healing" {
+					t.Errorf("Expected second set to be healing, got %q", result.Sets[1].HealStatus)
+				}
+				if result.Parity["STANDARD"] != 2 {
+					t.Errorf("Expected STANDARD parity %d, got %d", 2, result.Parity["STANDARD"])
+				}
+			},
+		},
+		{
+			name:      "empty background heal status",
+			setupMock: func(mock *minio.MockMinIOServer) {},
+			validateResult: func(t *testing.T, result *BackgroundHealStatus) {
+				if result.HealingDrives == nil || result.Sets == nil || result.Parity == nil {
+					t.Errorf("Expected empty collections instead of nil, got %+v", result)
+				}
+			},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBackgroundHealStatusError(403, "Forbidden")
+			},
+			expectedError: "failed to get background heal status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetBackgroundHealStatusService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
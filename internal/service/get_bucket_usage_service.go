@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// bucketUsageSorters compares two buckets by the named field in ascending order
+var bucketUsageSorters = map[string]func(a, b BucketUsage) bool{
+	"name":          func(a, b BucketUsage) bool { return a.Name < b.Name },
+	"size":          func(a, b BucketUsage) bool { return a.Size < b.Size },
+	"objects":       func(a, b BucketUsage) bool { return a.ObjectsCount < b.ObjectsCount },
+	"versions":      func(a, b BucketUsage) bool { return a.VersionsCount < b.VersionsCount },
+	"deleteMarkers": func(a, b BucketUsage) bool { return a.DeleteMarkersCount < b.DeleteMarkersCount },
+}
+
+type GetBucketUsageService struct {
+	minioClient *madmin.AdminClient
+}
+
+// BucketUsageOptions represents options for listing bucket usage
+type BucketUsageOptions struct {
+	SortBy string // One of name, size, objects, versions, deleteMarkers. Defaults to size
+	Order  string // asc or desc. Defaults to desc
+	Top    int    // Limit the result to the first N buckets, 0 means all
+}
+
+// BucketUsage represents the usage of a single bucket
+type BucketUsage struct {
+	Name                 string            `json:"name"`
+	Size                 uint64            `json:"size"`
+	SizePercentage       float64           `json:"sizePercentage"` // Share of the total object size of all buckets
+	ObjectsCount         uint64            `json:"objectsCount"`
+	VersionsCount        uint64            `json:"versionsCount"`
+	DeleteMarkersCount   uint64            `json:"deleteMarkersCount"`
+	ObjectSizesHistogram map[string]uint64 `json:"objectSizesHistogram"`
+}
+
+// BucketUsageResponse represents the per-bucket usage breakdown
+type BucketUsageResponse struct {
+	LastUpdate   time.Time     `json:"lastUpdate"`
+	BucketsCount uint64        `json:"bucketsCount"`
+	TotalSize    uint64        `json:"totalSize"`
+	ObjectsCount uint64        `json:"objectsCount"`
+	Buckets      []BucketUsage `json:"buckets"`
+}
+
+func NewGetBucketUsageService(minioClient *madmin.AdminClient) *GetBucketUsageService {
+	return &GetBucketUsageService{
+		minioClient: minioClient,
+	}
+}
+
+// ValidBucketUsageSort reports whether field is a supported sort field
+func ValidBucketUsageSort(field string) bool {
+	_, ok := bucketUsageSorters[field]
+	return ok
+}
+
+func (s *GetBucketUsageService) Execute(ctx context.Context, opts BucketUsageOptions) (*BucketUsageResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if opts.SortBy == "" {
+		opts.SortBy = "size"
+	}
+	if opts.Order == "" {
+		opts.Order = "desc"
+	}
+
+	less, ok := bucketUsageSorters[opts.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort field: %s", opts.SortBy)
+	}
+	if opts.Order != "asc" && opts.Order != "desc" {
+		return nil, fmt.Errorf("invalid sort order: %s", opts.Order)
+	}
+	if opts.Top < 0 {
+		return nil, fmt.Errorf("invalid top: %d", opts.Top)
+	}
+
+	logger.Debug().Msg("Fetching MinIO data usage info")
+
+	info, err := s.minioClient.DataUsageInfo(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch MinIO data usage info")
+		return nil, fmt.Errorf("failed to get data usage info: %w", err)
+	}
+
+	logger.Debug().Int("buckets", len(info.BucketsUsage)).Msg("Successfully fetched MinIO data usage info")
+
+	var totalSize uint64
+	for _, usage := range info.BucketsUsage {
+		totalSize += usage.Size
+	}
+
+	buckets := make([]BucketUsage, 0, len(info.BucketsUsage))
+	for name, usage := range info.BucketsUsage {
+		bucket := BucketUsage{
+			Name:                 name,
+			Size:                 usage.Size,
+			ObjectsCount:         usage.ObjectsCount,
+			VersionsCount:        usage.VersionsCount,
+			DeleteMarkersCount:   usage.DeleteMarkersCount,
+			ObjectSizesHistogram: usage.ObjectSizesHistogram,
+		}
+		if bucket.ObjectSizesHistogram == nil {
+			bucket.ObjectSizesHistogram = map[string]uint64{}
+		}
+		if totalSize > 0 {
+			bucket.SizePercentage = float64(usage.Size) / float64(totalSize) * 100
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	// Ties are broken by name so the order is stable between requests
+	sort.Slice(buckets, func(i, j int) bool {
+		a, b := buckets[i], buckets[j]
+		if opts.Order == "desc" {
+			a, b = b, a
+		}
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return buckets[i].Name < buckets[j].Name
+	})
+
+	if opts.Top > 0 && len(buckets) > opts.Top {
+		buckets = buckets[:opts.Top]
+	}
+
+	return &BucketUsageResponse{
+		LastUpdate:   info.LastUpdate,
+		BucketsCount: info.BucketsCount,
+		TotalSize:    totalSize,
+		ObjectsCount: info.ObjectsTotalCount,
+		Buckets:      buckets,
+	}, nil
+}
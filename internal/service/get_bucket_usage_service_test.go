@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestGetBucketUsageService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		options        BucketUsageOptions
+		expectedError  string
+		expectedOrder  []string
+		validateResult func(t *testing.T, result *BucketUsageResponse)
+	}{
+		{
+			name: "default sort by size descending",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			options:       BucketUsageOptions{},
+			expectedOrder: []string{"backups", "media", "logs"},
+			validateResult: func(t *testing.T, result *BucketUsageResponse) {
+				if result.BucketsCount != 3 {
+					t.Errorf("Expected BucketsCount %d, got %d", 3, result.BucketsCount)
+				}
+				if result.TotalSize != 350<<30+1<<20 {
+					t.Errorf("Expected TotalSize %d, got %d", uint64(350<<30+1<<20), result.TotalSize)
+				}
+
+				backups := result.Buckets[0]
+				if backups.VersionsCount != 250 {
+					t.Errorf("Expected VersionsCount %d, got %d", 250, backups.VersionsCount)
+				}
+				if backups.DeleteMarkersCount != 10 {
+					t.Errorf("Expected DeleteMarkersCount %d, got %d", 10, backups.DeleteMarkersCount)
+				}
+				if backups.ObjectSizesHistogram["GREATER_THAN_512_MB"] != 60 {
+					t.Errorf("Expected histogram count %d, got %d", 60, backups.ObjectSizesHistogram["GREATER_THAN_512_MB"])
+				}
+				if backups.SizePercentage < 85 || backups.SizePercentage > 86 {
+					t.Errorf("Expected SizePercentage around 85.7, got %v", backups.SizePercentage)
+				}
+			},
+		},
+		{
+			name: "sort by objects ascending",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			options:       BucketUsageOptions{SortBy: "objects", Order: "asc"},
+			expectedOrder: []string{"backups", "media", "logs"},
+		},
+		{
+			name: "sort by delete markers with top-N",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			options:       BucketUsageOptions{SortBy: "deleteMarkers", Top: 2},
+			expectedOrder: []string{"backups", "logs"},
+		},
+		{
+			name: "sort by name",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetDataUsageResponse(scenarios.SuccessfulDataUsage())
+			},
+			options:       BucketUsageOptions{SortBy: "name", Order: "asc"},
+			expectedOrder: []string{"backups", "logs", "media"},
+		},
+		{
+			name:          "empty cluster",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       BucketUsageOptions{},
+			expectedOrder: []string{},
+		},
+		{
+			name:          "invalid sort field",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       BucketUsageOptions{SortBy: "owner"},
+			expectedError: "invalid sort field",
+		},
+		{
+			name:          "invalid sort order",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       BucketUsageOptions{Order: "random"},
+			expectedError: "invalid sort order",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetDataUsageError(403, "Forbidden")
+			},
+			options:       BucketUsageOptions{},
+			expectedError: "failed to get data usage info",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetBucketUsageService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.options)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if result != nil {
+					t.Errorf("Expected nil result when error occurs, got %+v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			names := make([]string, len(result.Buckets))
+			for i, bucket := range result.Buckets {
+				names[i] = bucket.Name
+			}
+			if strings.Join(names, ",") != strings.Join(tt.expectedOrder, ",") {
+				t.Errorf("Expected buckets %v, got %v", tt.expectedOrder, names)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
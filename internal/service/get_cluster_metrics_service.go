@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// DefaultMetricsInterval is the sampling interval used when none is given
+	DefaultMetricsInterval = time.Second
+	// DefaultMetricsSamples is the number of points returned when none is given
+	DefaultMetricsSamples = 5
+	// MaxMetricsSamples bounds the number of points of a single request
+	MaxMetricsSamples = 60
+	// MaxMetricsDuration bounds how long a single request may keep sampling
+	MaxMetricsDuration = 2 * time.Minute
+
+	// diskSectorSize is the size in bytes of the sectors reported in disk I/O statistics
+	diskSectorSize = 512
+)
+
+// metricTypes maps the supported metric type names to the madmin metric types
+var metricTypes = map[string]madmin.MetricType{
+	"scanner":     madmin.MetricsScanner,
+	"disk":        madmin.MetricsDisk,
+	"os":          madmin.MetricsOS,
+	"net":         madmin.MetricNet,
+	"replication": madmin.MetricsSiteResync,
+	"mem":         madmin.MetricsMem,
+	"cpu":         madmin.MetricsCPU,
+}
+
+type GetClusterMetricsService struct {
+	minioClient *madmin.AdminClient
+}
+
+// ClusterMetricsOptions represents options for sampling cluster metrics
+type ClusterMetricsOptions struct {
+	Types    []string      // Metric types to sample, empty means all supported types
+	Interval time.Duration // Interval between samples, rounded up to 1s by MinIO
+	Samples  int           // Number of points to return per metric type
+	Hosts    []string      // Hosts to sample, empty means all hosts
+}
+
+// MetricPoint represents the values of one metric type at a point in time.
+// Cumulative counters are converted to per-second rates between two samples.
+type MetricPoint struct {
+	Timestamp time.Time          `json:"timestamp"`
+	Values    map[string]float64 `json:"values"`
+}
+
+// ClusterMetricsResponse represents sampled cluster metrics as time series per metric type
+type ClusterMetricsResponse struct {
+	Interval string                   `json:"interval"`
+	Hosts    []string                 `json:"hosts"`
+	Errors   []string                 `json:"errors,omitempty"`
+	Series   map[string][]MetricPoint `json:"series"`
+}
+
+// receivedMetrics is a raw sample with the time it was received, used when MinIO omits the collection time
+type receivedMetrics struct {
+	madmin.RealtimeMetrics
+	receivedAt time.Time
+}
+
+// metricSample holds the values extracted from one metric type of a single sample
+type metricSample struct {
+	collectedAt time.Time
+	gauges      map[string]float64 // Reported as-is
+	counters    map[string]float64 // Cumulative, reported as rate against the previous sample
+}
+
+func NewGetClusterMetricsService(minioClient *madmin.AdminClient) *GetClusterMetricsService {
+	return &GetClusterMetricsService{
+		minioClient: minioClient,
+	}
+}
+
+// ValidMetricType reports whether name is a supported metric type
+func ValidMetricType(name string) bool {
+	_, ok := metricTypes[name]
+	return ok
+}
+
+// MetricTypeNames returns the supported metric type names in stable order
+func MetricTypeNames() []string {
+	names := make([]string, 0, len(metricTypes))
+	for name := range metricTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *GetClusterMetricsService) Execute(ctx context.Context, opts ClusterMetricsOptions) (*ClusterMetricsResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if len(opts.Types) == 0 {
+		opts.Types = MetricTypeNames()
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultMetricsInterval
+	}
+	if opts.Samples <= 0 {
+		opts.Samples = DefaultMetricsSamples
+	}
+
+	var metricType madmin.MetricType
+	for _, name := range opts.Types {
+		t, ok := metricTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid metric type: %s", name)
+		}
+		metricType |= t
+	}
+
+	if opts.Samples > MaxMetricsSamples {
+		return nil, fmt.Errorf("too many samples: %d. Must be at most %d", opts.Samples, MaxMetricsSamples)
+	}
+	if time.Duration(opts.Samples)*opts.Interval > MaxMetricsDuration {
+		return nil, fmt.Errorf("sampling window too long: %d samples of %s exceeds %s", opts.Samples, opts.Interval, MaxMetricsDuration)
+	}
+
+	logger.Debug().
+		Strs("types", opts.Types).
+		Dur("interval", opts.Interval).
+		Int("samples", opts.Samples).
+		Msg("Sampling MinIO cluster metrics")
+
+	// One extra sample is needed as the baseline of the first rate
+	var samples []receivedMetrics
+	err := s.minioClient.Metrics(ctx, madmin.MetricsOptions{
+		Type:     metricType,
+		N:        opts.Samples + 1,
+		Interval: opts.Interval,
+		Hosts:    opts.Hosts,
+	}, func(m madmin.RealtimeMetrics) {
+		samples = append(samples, receivedMetrics{RealtimeMetrics: m, receivedAt: time.Now()})
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch MinIO cluster metrics")
+		return nil, fmt.Errorf("failed to get cluster metrics: %w", err)
+	}
+
+	logger.Debug().Int("samples", len(samples)).Msg("Successfully fetched MinIO cluster metrics")
+
+	response := &ClusterMetricsResponse{
+		Interval: opts.Interval.String(),
+		Hosts:    []string{},
+		Series:   make(map[string][]MetricPoint, len(opts.Types)),
+	}
+
+	for _, name := range opts.Types {
+		response.Series[name] = buildMetricSeries(name, samples, opts.Interval)
+	}
+
+	if len(samples) > 0 {
+		last := samples[len(samples)-1]
+		if last.Hosts != nil {
+			response.Hosts = last.Hosts
+		}
+		for _, sample := range samples {
+			response.Errors = append(response.Errors, sample.Errors...)
+		}
+	}
+
+	return response, nil
+}
+
+// buildMetricSeries converts the raw samples of one metric type into time series points
+func buildMetricSeries(name string, samples []receivedMetrics, interval time.Duration) []MetricPoint {
+	points := []MetricPoint{}
+
+	var previous *metricSample
+	for _, raw := range samples {
+		current, ok := extractMetricSample(name, raw.Aggregated)
+		if !ok {
+			continue
+		}
+		if current.collectedAt.IsZero() {
+			current.collectedAt = raw.receivedAt
+		}
+
+		if previous != nil {
+			elapsed := current.collectedAt.Sub(previous.collectedAt).Seconds()
+			if elapsed <= 0 {
+				elapsed = interval.Seconds()
+			}
+
+			values := make(map[string]float64, len(current.gauges)+len(current.counters))
+			for key, value := range current.gauges {
+				values[key] = value
+			}
+			for key, value := range current.counters {
+				delta := value - previous.counters[key]
+				// Counters reset when a server restarts
+				if delta < 0 {
+					delta = 0
+				}
+				values[key] = delta / elapsed
+			}
+
+			points = append(points, MetricPoint{
+				Timestamp: current.collectedAt,
+				Values:    values,
+			})
+		}
+
+		previous = current
+	}
+
+	return points
+}
+
+// extractMetricSample picks the values of one metric type from an aggregated sample
+func extractMetricSample(name string, m madmin.Metrics) (*metricSample, bool) {
+	sample := &metricSample{
+		gauges:   map[string]float64{},
+		counters: map[string]float64{},
+	}
+
+	switch name {
+	case "scanner":
+		if m.Scanner == nil {
+			return nil, false
+		}
+		sample.collectedAt = m.Scanner.CollectedAt
+		sample.gauges["ongoingBuckets"] = float64(m.Scanner.OngoingBuckets)
+		sample.counters["objectsScannedPerSec"] = float64(m.Scanner.LifeTimeOps["ScanObject"])
+		sample.counters["opsPerSec"] = sumOps(m.Scanner.LifeTimeOps)
+	case "disk":
+		if m.Disk == nil {
+			return nil, false
+		}
+		sample.collectedAt = m.Disk.CollectedAt
+		sample.gauges["drives"] = float64(m.Disk.NDisks)
+		sample.gauges["offline"] = float64(m.Disk.Offline)
+		sample.gauges["healing"] = float64(m.Disk.Healing)
+		sample.counters["readIops"] = float64(m.Disk.IOStats.ReadIOs)
+		sample.counters["writeIops"] = float64(m.Disk.IOStats.WriteIOs)
+		sample.counters["readBytesPerSec"] = float64(m.Disk.IOStats.ReadSectors * diskSectorSize)
+		sample.counters["writeBytesPerSec"] = float64(m.Disk.IOStats.WriteSectors * diskSectorSize)
+		sample.counters["opsPerSec"] = sumOps(m.Disk.LifeTimeOps)
+	case "os":
+		if m.OS == nil {
+			return nil, false
+		}
+		sample.collectedAt = m.OS.CollectedAt
+		sample.counters["opsPerSec"] = sumOps(m.OS.LifeTimeOps)
+	case "net":
+		if m.Net == nil {
+			return nil, false
+		}
+		sample.collectedAt = m.Net.CollectedAt
+		sample.gauges["rxErrors"] = float64(m.Net.NetStats.RxErrors)
+		sample.gauges["txErrors"] = float64(m.Net.NetStats.TxErrors)
+		sample.counters["rxBytesPerSec"] = float64(m.Net.NetStats.RxBytes)
+		sample.counters["txBytesPerSec"] = float64(m.Net.NetStats.TxBytes)
+		sample.counters["rxPacketsPerSec"] = float64(m.Net.NetStats.RxPackets)
+		sample.counters["txPacketsPerSec"] = float64(m.Net.NetStats.TxPackets)
+	case "replication":
+		if m.SiteResync == nil {
+			return nil, false
+		}
+		sample.collectedAt = m.SiteResync.CollectedAt
+		sample.gauges["replicatedBytes"] = float64(m.SiteResync.ReplicatedSize)
+		sample.gauges["replicatedObjects"] = float64(m.SiteResync.ReplicatedCount)
+		sample.gauges["failedBytes"] = float64(m.SiteResync.FailedSize)
+		sample.gauges["failedObjects"] = float64(m.SiteResync.FailedCount)
+		sample.counters["replicatedBytesPerSec"] = float64(m.SiteResync.ReplicatedSize)
+	case "mem":
+		if m.Mem == nil {
+			return nil, false
+		}
+		sample.collectedAt = m.Mem.CollectedAt
+		sample.gauges["total"] = float64(m.Mem.Info.Total)
+		sample.gauges["used"] = float64(m.Mem.Info.Used)
+		sample.gauges["available"] = float64(m.Mem.Info.Available)
+	case "cpu":
+		if m.CPU == nil {
+			return nil, false
+		}
+		sample.collectedAt = m.CPU.CollectedAt
+		sample.gauges["cpuCount"] = float64(m.CPU.CPUCount)
+		if m.CPU.LoadStat != nil {
+			sample.gauges["load1"] = m.CPU.LoadStat.Load1
+			sample.gauges["load5"] = m.CPU.LoadStat.Load5
+			sample.gauges["load15"] = m.CPU.LoadStat.Load15
+		}
+	default:
+		return nil, false
+	}
+
+	return sample, true
+}
+
+// sumOps returns the total of all operation counters
+func sumOps(ops map[string]uint64) float64 {
+	var total uint64
+	for _, count := range ops {
+		total += count
+	}
+	return float64(total)
+}
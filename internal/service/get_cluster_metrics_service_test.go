@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestGetClusterMetricsService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		options        ClusterMetricsOptions
+		expectedError  string
+		validateResult func(t *testing.T, result *ClusterMetricsResponse)
+	}{
+		{
+			name: "successful disk and network metrics as rates",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetMetricsResponse(scenarios.SuccessfulMetrics())
+			},
+			options: ClusterMetricsOptions{Types: []string{"disk", "net"}, Samples: 2},
+			validateResult: func(t *testing.T, result *ClusterMetricsResponse) {
+				if len(result.Hosts) != 2 {
+					t.Errorf("Expected %d hosts, got %d", 2, len(result.Hosts))
+				}
+
+				disk := result.Series["disk"]
+				if len(disk) != 2 { // 3 samples give 2 rate points
+					t.Fatalf("Expected %d disk points, got %d", 2, len(disk))
+				}
+				if got := disk[0].Values["readIops"]; got != 100 {
+					t.Errorf("Expected readIops %v, got %v", 100, got)
+				}
+				if got := disk[0].Values["writeIops"]; got != 50 {
+					t.Errorf("Expected writeIops %v, got %v", 50, got)
+				}
+				if got := disk[0].Values["readBytesPerSec"]; got != 2048*512 {
+					t.Errorf("Expected readBytesPerSec %v, got %v", 2048*512, got)
+				}
+				if got := disk[1].Values["offline"]; got != 1 {
+					t.Errorf("Expected offline %v, got %v", 1, got)
+				}
+
+				net := result.Series["net"]
+				if len(net) != 2 {
+					t.Fatalf("Expected %d net points, got %d", 2, len(net))
+				}
+				if got := net[1].Values["rxBytesPerSec"]; got != 1024*1024 {
+					t.Errorf("Expected rxBytesPerSec %v, got %v", 1024*1024, got)
+				}
+				if !net[1].Timestamp.After(net[0].Timestamp) {
+					t.Errorf("Expected increasing timestamps, got %v and %v", net[0].Timestamp, net[1].Timestamp)
+				}
+			},
+		},
+		{
+			name: "metric type without samples returns empty series",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetMetricsResponse(scenarios.SuccessfulMetrics())
+			},
+			options: ClusterMetricsOptions{Types: []string{"scanner"}},
+			validateResult: func(t *testing.T, result *ClusterMetricsResponse) {
+				series, ok := result.Series["scanner"]
+				if !ok {
+					t.Fatal("Expected scanner series to be present")
+				}
+				if len(series) != 0 {
+					t.Errorf("Expected empty scanner series, got %d points", len(series))
+				}
+			},
+		},
+		{
+			name:          "invalid metric type",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       ClusterMetricsOptions{Types: []string{"gpu"}},
+			expectedError: "invalid metric type",
+		},
+		{
+			name:          "too many samples",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       ClusterMetricsOptions{Samples: MaxMetricsSamples + 1},
+			expectedError: "too many samples",
+		},
+		{
+			name:          "sampling window too long",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       ClusterMetricsOptions{Samples: 10, Interval: time.Minute},
+			expectedError: "sampling window too long",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetMetricsError(403, "Forbidden")
+			},
+			options:       ClusterMetricsOptions{Types: []string{"disk"}},
+			expectedError: "failed to get cluster metrics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetClusterMetricsService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.options)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if result != nil {
+					t.Errorf("Expected nil result when error occurs, got %+v", result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				if result == nil {
+					t.Fatal("Expected result, got nil")
+				}
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+		})
+	}
+}
+
+// TestGetClusterMetricsService_RequestOptions tests that the sampling options are passed to MinIO
+func TestGetClusterMetricsService_RequestOptions(t *testing.T) {
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	scenarios := minio.TestScenarios{}
+	mockServer.SetMetricsResponse(scenarios.SuccessfulMetrics())
+
+	minioClient, err := mockServer.CreateMinIOClient()
+	if err != nil {
+		t.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+	_, err = NewGetClusterMetricsService(minioClient).Execute(ctx, ClusterMetricsOptions{
+		Types:    []string{"disk", "net"},
+		Interval: 5 * time.Second,
+		Samples:  3,
+		Hosts:    []string{"node1:9000"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	requests := mockServer.Requests("metrics")
+	if len(requests) != 1 {
+		t.Fatalf("Expected %d metrics request, got %d", 1, len(requests))
+	}
+
+	query := requests[0]
+	expectedTypes := uint64(madmin.MetricsDisk | madmin.MetricNet)
+	if got := query.Get("types"); got != strconv.FormatUint(expectedTypes, 10) {
+		t.Errorf("Expected types %d, got %q", expectedTypes, got)
+	}
+	if got := query.Get("n"); got != "4" { // One extra sample as rate baseline
+		t.Errorf("Expected n %q, got %q", "4", got)
+	}
+	if got := query.Get("interval"); got != "5s" {
+		t.Errorf("Expected interval %q, got %q", "5s", got)
+	}
+	if got := query.Get("hosts"); got != "node1:9000" {
+		t.Errorf("Expected hosts %q, got %q", "node1:9000", got)
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// ClusterSummaryTimeout limits how long a single cluster may take to respond to the summary
+const ClusterSummaryTimeout = 10 * time.Second
+
+// ClusterClients provides the admin clients of the managed clusters
+type ClusterClients interface {
+	Names() []string
+	Client(name string) (*madmin.AdminClient, error)
+}
+
+type GetClustersSummaryService struct {
+	clients ClusterClients
+}
+
+// ClusterSummary represents the health and usage of a single cluster
+type ClusterSummary struct {
+	Name              string  `json:"name"`
+	Default           bool    `json:"default"`
+	Online            bool    `json:"online"`
+	Error             string  `json:"error,omitempty"`
+	Mode              string  `json:"mode"`
+	Region            string  `json:"region"`
+	DeploymentID      string  `json:"deploymentId"`
+	Servers           int     `json:"servers"`
+	OnlineDisks       int     `json:"onlineDisks"`
+	OfflineDisks      int     `json:"offlineDisks"`
+	HealingDisks      int     `json:"healingDisks"`
+	TotalCapacity     uint64  `json:"totalCapacity"`
+	TotalUsedCapacity uint64  `json:"totalUsedCapacity"`
+	UsagePercentage   float64 `json:"usagePercentage"`
+	BucketsCount      uint64  `json:"bucketsCount"`
+	ObjectsCount      uint64  `json:"objectsCount"`
+}
+
+// ClustersSummaryResponse represents the summary of every managed cluster with their totals
+type ClustersSummaryResponse struct {
+	Clusters          []ClusterSummary `json:"clusters"`
+	Total             int              `json:"total"`
+	Online            int              `json:"online"`
+	TotalCapacity     uint64           `json:"totalCapacity"`
+	TotalUsedCapacity uint64           `json:"totalUsedCapacity"`
+	BucketsCount      uint64           `json:"bucketsCount"`
+	ObjectsCount      uint64           `json:"objectsCount"`
+}
+
+func NewGetClustersSummaryService(clients ClusterClients) *GetClustersSummaryService {
+	return &GetClustersSummaryService{
+		clients: clients,
+	}
+}
+
+// Execute queries every cluster concurrently, an unreachable cluster is reported offline instead of failing the summary
+func (s *GetClustersSummaryService) Execute(ctx context.Context) (*ClustersSummaryResponse, error) {
+	logger := zerolog.Ctx(ctx)
+	names := s.clients.Names()
+	logger.Debug().Int("clusters", len(names)).Msg("Fetching clusters summary")
+
+	summaries := make([]ClusterSummary, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			summaries[i] = s.summarize(ctx, name)
+			summaries[i].Default = i == 0
+		}()
+	}
+	wg.Wait()
+
+	response := &ClustersSummaryResponse{
+		Clusters: summaries,
+		Total:    len(summaries),
+	}
+	for _, summary := range summaries {
+		if !summary.Online {
+			continue
+		}
+
+		response.Online++
+		response.TotalCapacity += summary.TotalCapacity
+		response.TotalUsedCapacity += summary.TotalUsedCapacity
+		response.BucketsCount += summary.BucketsCount
+		response.ObjectsCount += summary.ObjectsCount
+	}
+
+	logger.Info().Int("total", response.Total).Int("online", response.Online).Msg("Successfully fetched clusters summary")
+	return response, nil
+}
+
+// summarize fetches the server info of a cluster within ClusterSummaryTimeout
+func (s *GetClustersSummaryService) summarize(ctx context.Context, name string) ClusterSummary {
+	logger := zerolog.Ctx(ctx).With().Str("cluster", name).Logger()
+	summary := ClusterSummary{Name: name}
+
+	client, err := s.clients.Client(name)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to create MinIO client of cluster")
+		summary.Error = err.Error()
+		return summary
+	}
+
+	ctx, cancel := context.WithTimeout(logger.WithContext(ctx), ClusterSummaryTimeout)
+	defer cancel()
+
+	info, err := NewGetServerInfoService(client).Execute(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Cluster is unreachable")
+		summary.Error = err.Error()
+		return summary
+	}
+
+	summary.Online = true
+	summary.Mode = info.ServerInfo.Mode
+	summary.Region = info.ServerInfo.Region
+	summary.DeploymentID = info.ServerInfo.DeploymentID
+	if info.Topology != nil {
+		summary.Servers = len(info.Topology.Servers)
+	}
+	summary.OnlineDisks = info.DiskUsage.OnlineDisks
+	summary.OfflineDisks = info.DiskUsage.OfflineDisks
+	summary.HealingDisks = info.DiskUsage.HealingDisks
+	summary.TotalCapacity = info.DiskUsage.TotalCapacity
+	summary.TotalUsedCapacity = info.DiskUsage.TotalUsedCapacity
+	summary.UsagePercentage = info.DiskUsage.UsagePercentage
+	summary.BucketsCount = info.DiskUsage.BucketsCount
+	summary.ObjectsCount = info.DiskUsage.ObjectsCount
+
+	return summary
+}
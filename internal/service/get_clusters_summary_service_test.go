@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// testClusterClients serves the clients of mock servers by name in the given order
+type testClusterClients struct {
+	names   []string
+	clients map[string]*madmin.AdminClient
+}
+
+func (c *testClusterClients) Names() []string {
+	return c.names
+}
+
+func (c *testClusterClients) Client(name string) (*madmin.AdminClient, error) {
+	client, ok := c.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown cluster %q", name)
+	}
+	return client, nil
+}
+
+func TestGetClustersSummaryService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMocks     map[string]func(*minio.MockMinIOServer)
+		clusters       []string
+		validateResult func(t *testing.T, result *ClustersSummaryResponse)
+	}{
+		{
+			name: "summarizes every cluster with totals of online clusters",
+			setupMocks: map[string]func(*minio.MockMinIOServer){
+				"primary": func(mock *minio.MockMinIOServer) {
+					scenarios := minio.TestScenarios{}
+					mock.SetServerInfoResponse(scenarios.TopologyServerInfo())
+				},
+				"secondary": func(mock *minio.MockMinIOServer) {
+					scenarios := minio.TestScenarios{}
+					mock.SetServerInfoResponse(scenarios.SuccessfulServerInfo())
+				},
+			},
+			clusters: []string{"primary", "secondary"},
+			validateResult: func(t *testing.T, result *ClustersSummaryResponse) {
+				if result.Total != 2 || result.Online != 2 {
+					t.Fatalf("Expected %d clusters with %d online, got %d with %d online", 2, 2, result.Total, result.Online)
+				}
+
+				primary := result.Clusters[0]
+				if primary.Name != "primary" || !primary.Default {
+					t.Errorf("Expected first cluster to be the default primary, got %q (%v)", primary.Name, primary.Default)
+				}
+				if primary.Mode != "distributed" {
+					t.Errorf("Expected Mode %q, got %q", "distributed", primary.Mode)
+				}
+				if primary.Servers != 2 {
+					t.Errorf("Expected %d servers, got %d", 2, primary.Servers)
+				}
+
+				secondary := result.Clusters[1]
+				if secondary.Name != "secondary" || secondary.Default {
+					t.Errorf("Expected second cluster to be the non-default secondary, got %q (%v)", secondary.Name, secondary.Default)
+				}
+				if secondary.Region != "us-east-1" {
+					t.Errorf("Expected Region %q, got %q", "us-east-1", secondary.Region)
+				}
+
+				expectedCapacity := primary.TotalCapacity + secondary.TotalCapacity
+				if result.TotalCapacity != expectedCapacity {
+					t.Errorf("Expected TotalCapacity %d, got %d", expectedCapacity, result.TotalCapacity)
+				}
+			},
+		},
+		{
+			name: "unreachable cluster is reported offline",
+			setupMocks: map[string]func(*minio.MockMinIOServer){
+				"primary": func(mock *minio.MockMinIOServer) {
+					scenarios := minio.TestScenarios{}
+					mock.SetServerInfoResponse(scenarios.SuccessfulServerInfo())
+				},
+				"broken": func(mock *minio.MockMinIOServer) {
+					mock.SetServerInfoNonRetryableError(http.StatusForbidden, "Access Denied")
+				},
+			},
+			clusters: []string{"primary", "broken"},
+			validateResult: func(t *testing.T, result *ClustersSummaryResponse) {
+				if result.Total != 2 || result.Online != 1 {
+					t.Fatalf("Expected %d clusters with %d online, got %d with %d online", 2, 1, result.Total, result.Online)
+				}
+
+				broken := result.Clusters[1]
+				if broken.Online {
+					t.Error("Expected broken cluster to be offline")
+				}
+				if broken.Error == "" {
+					t.Error("Expected broken cluster to report its error")
+				}
+			},
+		},
+		{
+			name: "cluster without client is reported offline",
+			setupMocks: map[string]func(*minio.MockMinIOServer){
+				"primary": func(mock *minio.MockMinIOServer) {
+					scenarios := minio.TestScenarios{}
+					mock.SetServerInfoResponse(scenarios.SuccessfulServerInfo())
+				},
+			},
+			clusters: []string{"primary", "missing"},
+			validateResult: func(t *testing.T, result *ClustersSummaryResponse) {
+				if result.Online != 1 {
+					t.Errorf("Expected %d online cluster, got %d", 1, result.Online)
+				}
+
+				missing := result.Clusters[1]
+				if missing.Online || missing.Error == "" {
+					t.Errorf("Expected missing cluster to be offline with an error, got %v and %q", missing.Online, missing.Error)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients := &testClusterClients{
+				names:   tt.clusters,
+				clients: make(map[string]*madmin.AdminClient),
+			}
+
+			for name, setupMock := range tt.setupMocks {
+				mockServer := minio.NewMockMinIOServer()
+				defer mockServer.Close()
+				setupMock(mockServer)
+
+				client, err := mockServer.CreateMinIOClient()
+				if err != nil {
+					t.Fatalf("Failed to create MinIO client: %v", err)
+				}
+				clients.clients[name] = client
+			}
+
+			svc := NewGetClustersSummaryService(clients)
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := svc.Execute(ctx)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type GetConfigSubsystemService struct {
+	minioClient *madmin.AdminClient
+}
+
+// ConfigValue represents a config key of a subsystem target with its help text.
+// MinIO reports the default value for keys which were never set.
+type ConfigValue struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Optional    bool   `json:"optional"`
+	Sensitive   bool   `json:"sensitive"`             // Value is masked
+	EnvOverride string `json:"envOverride,omitempty"` // Environment variable taking precedence over the value
+}
+
+// ConfigTarget represents the config of the default or a named target of a subsystem
+type ConfigTarget struct {
+	Target string        `json:"target"` // Empty for the default target
+	Values []ConfigValue `json:"values"`
+}
+
+// ConfigSubsystemResponse represents the config of every target of a subsystem
+type ConfigSubsystemResponse struct {
+	SubSystem       string         `json:"subSystem"`
+	Description     string         `json:"description"`
+	MultipleTargets bool           `json:"multipleTargets"`
+	Targets         []ConfigTarget `json:"targets"`
+}
+
+func NewGetConfigSubsystemService(minioClient *madmin.AdminClient) *GetConfigSubsystemService {
+	return &GetConfigSubsystemService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *GetConfigSubsystemService) Execute(ctx context.Context, subSystem string) (*ConfigSubsystemResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Str("subSystem", subSystem).Msg("Fetching MinIO config subsystem")
+
+	help, err := s.minioClient.HelpConfigKV(ctx, subSystem, "", false)
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", subSystem).Msg("Failed to fetch MinIO config help")
+		return nil, fmt.Errorf("failed to get config help: %w", err)
+	}
+
+	raw, err := s.minioClient.GetConfigKV(ctx, subSystem)
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", subSystem).Msg("Failed to fetch MinIO config")
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	configs, err := madmin.ParseServerConfigOutput(string(raw))
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", subSystem).Msg("Failed to parse MinIO config")
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	response := &ConfigSubsystemResponse{
+		SubSystem:       subSystem,
+		Description:     help.Description,
+		MultipleTargets: help.MultipleTargets,
+		Targets:         make([]ConfigTarget, 0, len(configs)),
+	}
+
+	for _, config := range configs {
+		if config.SubSystem != subSystem {
+			continue
+		}
+		response.Targets = append(response.Targets, ConfigTarget{
+			Target: config.Target,
+			Values: buildConfigValues(help, config),
+		})
+	}
+
+	logger.Debug().
+		Str("subSystem", subSystem).
+		Int("targets", len(response.Targets)).
+		Msg("Successfully fetched MinIO config subsystem")
+
+	return response, nil
+}
+
+// buildConfigValues lists the keys in help order, keys missing from the help are appended as reported
+func buildConfigValues(help madmin.Help, config madmin.SubsysConfig) []ConfigValue {
+	reported := make(map[string]madmin.ConfigKV, len(config.KV))
+	for _, kv := range config.KV {
+		reported[kv.Key] = kv
+	}
+
+	values := make([]ConfigValue, 0, len(help.KeysHelp))
+	for _, keyHelp := range help.KeysHelp {
+		value := ConfigValue{
+			Key:         keyHelp.Key,
+			Description: keyHelp.Description,
+			Type:        keyHelp.Type,
+			Optional:    keyHelp.Optional,
+			Sensitive:   IsSensitiveConfigKey(keyHelp.Key),
+		}
+		if kv, ok := reported[keyHelp.Key]; ok {
+			value.Value = maskConfigValue(kv.Key, kv.Value)
+			if kv.EnvOverride != nil {
+				value.EnvOverride = kv.EnvOverride.Name
+			}
+			delete(reported, keyHelp.Key)
+		}
+		values = append(values, value)
+	}
+
+	for _, kv := range config.KV {
+		if _, ok := reported[kv.Key]; !ok {
+			continue
+		}
+		value := ConfigValue{
+			Key:       kv.Key,
+			Value:     maskConfigValue(kv.Key, kv.Value),
+			Sensitive: IsSensitiveConfigKey(kv.Key),
+		}
+		if kv.EnvOverride != nil {
+			value.EnvOverride = kv.EnvOverride.Name
+		}
+		values = append(values, value)
+	}
+
+	return values
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestGetConfigSubsystemService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *ConfigSubsystemResponse)
+	}{
+		{
+			name: "targets with help text and masked secrets",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigHelpResponse("notify_webhook", scenarios.NotifyWebhookConfigHelp())
+				mock.SetConfigKVResponse("notify_webhook", scenarios.NotifyWebhookConfig())
+			},
+			validateResult: func(t *testing.T, result *ConfigSubsystemResponse) {
+				if !result.MultipleTargets {
+					t.Error("Expected MultipleTargets to be true")
+				}
+				if len(result.Targets) != 2 {
+					t.Fatalf("Expected %d targets, got %d", 2, len(result.Targets))
+				}
+
+				primary := result.Targets[1]
+				if primary.Target != "primary" {
+					t.Errorf("Expected Target %q, got %q", "primary", primary.Target)
+				}
+
+				values := make(map[string]ConfigValue)
+				for _, value := range primary.Values {
+					values[value.Key] = value
+				}
+
+				if got := values["auth_token"]; got.Value != MaskedConfigValue || !got.Sensitive {
+					t.Errorf("Expected auth_token to be masked, got %+v", got)
+				}
+				if got := values["endpoint"]; got.Value != "https://hooks.example.com/minio" || got.Type != "url" {
+					t.Errorf("Expected endpoint with url type, got %+v", got)
+				}
+				if got := values["comment"]; got.Value != "Audit events" {
+					t.Errorf("Expected comment %q, got %q", "Audit events", got.Value)
+				}
+				if got := values["queue_limit"]; got.Description == "" {
+					t.Error("Expected queue_limit to have help text")
+				}
+
+				// Unset secrets stay empty to show they are not configured
+				for _, value := range result.Targets[0].Values {
+					if value.Key == "auth_token" && value.Value != "" {
+						t.Errorf("Expected empty auth_token, got %q", value.Value)
+					}
+					if value.Key == "queue_limit" && value.EnvOverride != "MINIO_NOTIFY_WEBHOOK_QUEUE_LIMIT" {
+						t.Errorf("Expected queue_limit to be overridden by environment, got %+v", value)
+					}
+				}
+			},
+		},
+		{
+			name: "unknown subsystem",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigKVResponse("notify_webhook", scenarios.NotifyWebhookConfig())
+			},
+			expectedError: "failed to get config help",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(403, "Access Denied")
+			},
+			expectedError: "failed to get config help",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetConfigSubsystemService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, "notify_webhook")
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
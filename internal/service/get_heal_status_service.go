@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// Heal sequence summaries reported by MinIO
+const (
+	HealStatusNotStarted = "not started"
+	HealStatusRunning    = "running"
+	HealStatusStopped    = "stopped"
+	HealStatusFinished   = "finished"
+)
+
+type GetHealStatusService struct {
+	minioClient *madmin.AdminClient
+}
+
+// GetHealStatusRequest identifies the heal sequence to poll.
+// Bucket and prefix must match the ones the sequence was started with.
+type GetHealStatusRequest struct {
+	ClientToken string
+	Bucket      string
+	Prefix      string
+}
+
+// HealStatus represents the progress of a heal sequence.
+// Items only contain the results produced since the previous poll.
+type HealStatus struct {
+	Summary       string     `json:"summary"`
+	FailureDetail string     `json:"failureDetail,omitempty"`
+	StartTime     time.Time  `json:"startTime"`
+	DryRun        bool       `json:"dryRun"`
+	DeepScan      bool       `json:"deepScan"`
+	Remove        bool       `json:"remove"`
+	Done          bool       `json:"done"`
+	Items         []HealItem `json:"items"`
+}
+
+// HealItem represents the heal result of a single bucket or object
+type HealItem struct {
+	Type          string `json:"type"`
+	Bucket        string `json:"bucket"`
+	Object        string `json:"object,omitempty"`
+	VersionID     string `json:"versionId,omitempty"`
+	Detail        string `json:"detail,omitempty"`
+	ObjectSize    int64  `json:"objectSize"`
+	MissingBefore int    `json:"missingBefore"`
+	MissingAfter  int    `json:"missingAfter"`
+	OfflineBefore int    `json:"offlineBefore"`
+	OfflineAfter  int    `json:"offlineAfter"`
+}
+
+func NewGetHealStatusService(minioClient *madmin.AdminClient) *GetHealStatusService {
+	return &GetHealStatusService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *GetHealStatusService) Execute(ctx context.Context, req GetHealStatusRequest) (*HealStatus, error) {
+	logger := zerolog.Ctx(ctx)
+
+	req.ClientToken = strings.TrimSpace(req.ClientToken)
+	if req.ClientToken == "" {
+		return nil, fmt.Errorf("client token is required")
+	}
+
+	logger.Debug().Str("clientToken", req.ClientToken).Msg("Fetching heal status")
+
+	_, status, err := s.minioClient.Heal(ctx, req.Bucket, req.Prefix, madmin.HealOpts{}, req.ClientToken, false, false)
+	if err != nil {
+		logger.Error().Err(err).Str("clientToken", req.ClientToken).Msg("Failed to fetch heal status")
+		return nil, fmt.Errorf("failed to get heal status: %w", err)
+	}
+
+	result := &HealStatus{
+		Summary:       status.Summary,
+		FailureDetail: status.FailureDetail,
+		StartTime:     status.StartTime,
+		DryRun:        status.HealSettings.DryRun,
+		DeepScan:      status.HealSettings.ScanMode == madmin.HealDeepScan,
+		Remove:        status.HealSettings.Remove,
+		Done:          status.Summary == HealStatusFinished || status.Summary == HealStatusStopped,
+		Items:         make([]HealItem, 0, len(status.Items)),
+	}
+
+	for _, item := range status.Items {
+		missingBefore, missingAfter := item.GetMissingCounts()
+		offlineBefore, offlineAfter := item.GetOfflineCounts()
+		result.Items = append(result.Items, HealItem{
+			Type:          string(item.Type),
+			Bucket:        item.Bucket,
+			Object:        item.Object,
+			VersionID:     item.VersionID,
+			Detail:        item.Detail,
+			ObjectSize:    item.ObjectSize,
+			MissingBefore: missingBefore,
+			MissingAfter:  missingAfter,
+			OfflineBefore: offlineBefore,
+			OfflineAfter:  offlineAfter,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestGetHealStatusService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		request        GetHealStatusRequest
+		polls          int
+		expectedError  string
+		validateResult func(t *testing.T, result *HealStatus)
+	}{
+		{
+			name: "running sequence with healed object",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStatusResponses(scenarios.RunningThenFinishedHealStatus()...)
+			},
+			request: GetHealStatusRequest{ClientToken: "3f1c1b8e-heal-token", Bucket: "media"},
+			polls:   1,
+			validateResult: func(t *testing.T, result *HealStatus) {
+				if result.Summary != HealStatusRunning {
+					t.Errorf("Expected Summary %q, got %q", HealStatusRunning, result.Summary)
+				}
+				if result.Done {
+					t.Error("Expected running sequence not to be done")
+				}
+				if !result.DeepScan {
+					t.Error("Expected DeepScan to be reported")
+				}
+				if len(result.Items) != 1 {
+					t.Fatalf("Expected %d item, got %d", 1, len(result.Items))
+				}
+				item := result.Items[0]
+				if item.Object != "videos/intro.mp4" {
+					t.Errorf("Expected Object %q, got %q", "videos/intro.mp4", item.Object)
+				}
+				if item.MissingBefore != 1 || item.MissingAfter != 0 {
+					t.Errorf("Expected missing drives 1 -> 0, got %d -> %d", item.MissingBefore, item.MissingAfter)
+				}
+			},
+		},
+		{
+			name: "finished sequence is done",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStatusResponses(scenarios.RunningThenFinishedHealStatus()...)
+			},
+			request: GetHealStatusRequest{ClientToken: "3f1c1b8e-heal-token", Bucket: "media"},
+			polls:   2,
+			validateResult: func(t *testing.T, result *HealStatus) {
+				if !result.Done {
+					t.Error("Expected finished sequence to be done")
+				}
+				if len(result.Items) != 0 {
+					t.Errorf("Expected no new items, got %d", len(result.Items))
+				}
+			},
+		},
+		{
+			name:          "missing client token",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			request:       GetHealStatusRequest{Bucket: "media"},
+			polls:         1,
+			expectedError: "client token is required",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetHealError(400, "Invalid client token")
+			},
+			request:       GetHealStatusRequest{ClientToken: "unknown"},
+			polls:         1,
+			expectedError: "failed to get heal status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetHealStatusService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test, only the last poll is validated
+			var result *HealStatus
+			for range tt.polls {
+				result, err = service.Execute(ctx, tt.request)
+			}
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
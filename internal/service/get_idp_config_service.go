@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type GetIDPConfigService struct {
+	minioClient *madmin.AdminClient
+}
+
+// IDPConfigValue represents a key of an identity provider config
+type IDPConfigValue struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Sensitive   bool   `json:"sensitive"`
+	EnvOverride bool   `json:"envOverride"` // Set by an environment variable, changes have no effect
+}
+
+// IDPConfigResponse represents an identity provider config, sensitive values are masked
+type IDPConfigResponse struct {
+	Type   string            `json:"type"`
+	Name   string            `json:"name"`
+	Values []IDPConfigValue  `json:"values"`
+	Info   map[string]string `json:"info"` // Values derived by MinIO, e.g. the role ARN
+}
+
+func NewGetIDPConfigService(minioClient *madmin.AdminClient) *GetIDPConfigService {
+	return &GetIDPConfigService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *GetIDPConfigService) Execute(ctx context.Context, idpType, name string) (*IDPConfigResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Str("type", idpType).Str("name", name).Msg("Fetching MinIO IDP config")
+
+	config, err := s.minioClient.GetIDPConfig(ctx, idpType, name)
+	if err != nil {
+		logger.Error().Err(err).Str("type", idpType).Str("name", name).Msg("Failed to fetch MinIO IDP config")
+		return nil, fmt.Errorf("failed to get IDP config: %w", err)
+	}
+
+	response := &IDPConfigResponse{
+		Type:   idpType,
+		Name:   name,
+		Values: []IDPConfigValue{},
+		Info:   map[string]string{},
+	}
+
+	for _, info := range config.Info {
+		if !info.IsCfg {
+			response.Info[info.Key] = info.Value
+			continue
+		}
+
+		response.Values = append(response.Values, IDPConfigValue{
+			Key:         info.Key,
+			Value:       maskConfigValue(info.Key, info.Value),
+			Sensitive:   IsSensitiveConfigKey(info.Key),
+			EnvOverride: info.IsEnv,
+		})
+	}
+
+	logger.Debug().Int("values", len(response.Values)).Msg("Successfully fetched MinIO IDP config")
+
+	return response, nil
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestGetIDPConfigService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		idpType        string
+		configName     string
+		expectedError  string
+		validateResult func(t *testing.T, result *IDPConfigResponse)
+	}{
+		{
+			name:       "OpenID config with role ARN",
+			idpType:    "openid",
+			configName: "keycloak",
+			validateResult: func(t *testing.T, result *IDPConfigResponse) {
+				if len(result.Values) != 5 {
+					t.Fatalf("Expected %d values, got %d", 5, len(result.Values))
+				}
+
+				secret := result.Values[3]
+				if secret.Key != "client_secret" || secret.Value != MaskedConfigValue || !secret.Sensitive {
+					t.Errorf("Expected masked client_secret, got %+v", secret)
+				}
+				if result.Values[2].Value != "minio" || result.Values[2].Sensitive {
+					t.Errorf("Expected plain client_id, got %+v", result.Values[2])
+				}
+				if got := result.Info["roleARN"]; got != "arn:minio:iam:::role/dfOSBR8BdCPeCRy1" {
+					t.Errorf("Expected role ARN in info, got %q", got)
+				}
+			},
+		},
+		{
+			name:       "LDAP config with environment override",
+			idpType:    "ldap",
+			configName: "_",
+			validateResult: func(t *testing.T, result *IDPConfigResponse) {
+				for _, value := range result.Values {
+					if value.Key != "lookup_bind_password" {
+						continue
+					}
+					if !value.EnvOverride || value.Value != MaskedConfigValue {
+						t.Errorf("Expected masked environment override, got %+v", value)
+					}
+					return
+				}
+				t.Error("Expected lookup_bind_password in values")
+			},
+		},
+		{
+			name:          "config not found",
+			idpType:       "openid",
+			configName:    "okta",
+			expectedError: "failed to get IDP config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetIDPConfigResponse(scenarios.LDAPConfig())
+			mockServer.SetIDPConfigResponse(scenarios.OpenIDConfig())
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetIDPConfigService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.idpType, tt.configName)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
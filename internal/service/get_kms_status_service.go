@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type GetKMSStatusService struct {
+	minioClient *madmin.AdminClient
+}
+
+// KMSEndpoint represents a KMS endpoint and whether MinIO can reach it
+type KMSEndpoint struct {
+	Endpoint string `json:"endpoint"`
+	State    string `json:"state"` // online or offline
+}
+
+// KMSState represents the state reported by the KMS server
+type KMSState struct {
+	Version           string `json:"version,omitempty"`
+	KeyStoreReachable bool   `json:"keyStoreReachable"`
+	KeyStoreAvailable bool   `json:"keyStoreAvailable"`
+	KeyStoreLatency   string `json:"keyStoreLatency"`
+	UpTime            string `json:"upTime"`
+}
+
+// KMSMetrics represents the request and event counters of the KMS server
+type KMSMetrics struct {
+	RequestsOK     int64 `json:"requestsOk"`
+	RequestsError  int64 `json:"requestsError"`  // Requests rejected by the KMS, e.g. unauthorized
+	RequestsFailed int64 `json:"requestsFailed"` // Requests the KMS failed to serve
+	RequestsActive int64 `json:"requestsActive"`
+	AuditEvents    int64 `json:"auditEvents"`
+	ErrorEvents    int64 `json:"errorEvents"`
+}
+
+// KMSStatusResponse represents the KMS connected to MinIO and whether its default key is usable
+type KMSStatusResponse struct {
+	Name         string        `json:"name"`
+	DefaultKeyID string        `json:"defaultKeyId"`
+	Endpoints    []KMSEndpoint `json:"endpoints"`
+	State        KMSState      `json:"state"`
+	DefaultKey   *KMSKeyCheck  `json:"defaultKey,omitempty"` // Omitted when the default key could not be checked
+	Metrics      *KMSMetrics   `json:"metrics,omitempty"`    // Omitted when the KMS does not report metrics
+	Errors       []string      `json:"errors,omitempty"`
+}
+
+func NewGetKMSStatusService(minioClient *madmin.AdminClient) *GetKMSStatusService {
+	return &GetKMSStatusService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute returns the KMS status and verifies the default key with an encryption round-trip.
+// Only the status is required, failing to get metrics or to check the default key is reported in Errors.
+func (s *GetKMSStatusService) Execute(ctx context.Context) (*KMSStatusResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Msg("Fetching MinIO KMS status")
+
+	status, err := s.minioClient.KMSStatus(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch MinIO KMS status")
+		return nil, fmt.Errorf("failed to get KMS status: %w", err)
+	}
+
+	response := &KMSStatusResponse{
+		Name:         status.Name,
+		DefaultKeyID: status.DefaultKeyID,
+		Endpoints:    make([]KMSEndpoint, 0, len(status.Endpoints)),
+		State: KMSState{
+			Version:           status.State.Version,
+			KeyStoreReachable: status.State.KeyStoreReachable,
+			KeyStoreAvailable: status.State.KeystoreAvailable,
+			KeyStoreLatency:   status.State.KeyStoreLatency.String(),
+			UpTime:            status.State.UpTime.String(),
+		},
+	}
+
+	for _, endpoint := range sortedKeys(status.Endpoints) {
+		response.Endpoints = append(response.Endpoints, KMSEndpoint{
+			Endpoint: endpoint,
+			State:    string(status.Endpoints[endpoint]),
+		})
+	}
+
+	if status.DefaultKeyID != "" {
+		check, err := checkKMSKey(ctx, s.minioClient, status.DefaultKeyID)
+		if err != nil {
+			logger.Warn().Err(err).Str("keyId", status.DefaultKeyID).Msg("Failed to check MinIO KMS default key")
+			response.Errors = append(response.Errors, fmt.Sprintf("failed to check default key: %v", err))
+		} else {
+			response.DefaultKey = check
+		}
+	}
+
+	metrics, err := s.minioClient.KMSMetrics(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to fetch MinIO KMS metrics")
+		response.Errors = append(response.Errors, fmt.Sprintf("failed to get KMS metrics: %v", err))
+	} else {
+		response.Metrics = &KMSMetrics{
+			RequestsOK:     metrics.RequestOK,
+			RequestsError:  metrics.RequestErr,
+			RequestsFailed: metrics.RequestFail,
+			RequestsActive: metrics.RequestActive,
+			AuditEvents:    metrics.AuditEvents,
+			ErrorEvents:    metrics.ErrorEvents,
+		}
+	}
+
+	logger.Info().
+		Str("name", response.Name).
+		Int("endpoints", len(response.Endpoints)).
+		Bool("defaultKeyUsable", response.DefaultKey != nil && response.DefaultKey.Usable).
+		Msg("Successfully fetched MinIO KMS status")
+
+	return response, nil
+}
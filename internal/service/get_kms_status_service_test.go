@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestGetKMSStatusService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *KMSStatusResponse)
+	}{
+		{
+			name: "KES with usable default key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSStatusResponse(scenarios.KESStatus())
+				mock.SetKMSMetricsResponse(scenarios.KESMetrics())
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			validateResult: func(t *testing.T, result *KMSStatusResponse) {
+				if result.Name != "KES" {
+					t.Errorf("Expected Name %q, got %q", "KES", result.Name)
+				}
+				if len(result.Endpoints) != 2 {
+					t.Fatalf("Expected 2 endpoints, got %d", len(result.Endpoints))
+				}
+				if result.Endpoints[1].Endpoint != "https://kes-2.example.com:7373" || result.Endpoints[1].State != "offline" {
+					t.Errorf("Expected kes-2 offline, got %+v", result.Endpoints[1])
+				}
+				if result.State.KeyStoreLatency != "3ms" {
+					t.Errorf("Expected KeyStoreLatency %q, got %q", "3ms", result.State.KeyStoreLatency)
+				}
+				if result.DefaultKey == nil || !result.DefaultKey.Usable {
+					t.Errorf("Expected usable default key, got %+v", result.DefaultKey)
+				}
+				if result.Metrics == nil || result.Metrics.RequestsOK != 15230 {
+					t.Errorf("Expected RequestsOK %d, got %+v", 15230, result.Metrics)
+				}
+				if len(result.Errors) != 0 {
+					t.Errorf("Expected no errors, got %v", result.Errors)
+				}
+			},
+		},
+		{
+			name: "default key cannot decrypt",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSStatusResponse(scenarios.KESStatus())
+				mock.SetKMSMetricsResponse(scenarios.KESMetrics())
+				mock.SetKMSKeyStatusResponse(madmin.KMSKeyStatus{
+					KeyID:         "minio-default-key",
+					DecryptionErr: "key is disabled",
+				})
+			},
+			validateResult: func(t *testing.T, result *KMSStatusResponse) {
+				if result.DefaultKey == nil {
+					t.Fatal("Expected default key check")
+				}
+				if result.DefaultKey.Usable {
+					t.Error("Expected default key not to be usable")
+				}
+				if result.DefaultKey.DecryptionError != "key is disabled" {
+					t.Errorf("Expected DecryptionError %q, got %q", "key is disabled", result.DefaultKey.DecryptionError)
+				}
+			},
+		},
+		{
+			name: "missing default key and metrics are reported",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSStatusResponse(scenarios.KESStatus())
+				mock.SetKMSMetricsError(404, "Not Implemented")
+			},
+			validateResult: func(t *testing.T, result *KMSStatusResponse) {
+				if result.DefaultKey != nil {
+					t.Errorf("Expected no default key check, got %+v", result.DefaultKey)
+				}
+				if result.Metrics != nil {
+					t.Errorf("Expected no metrics, got %+v", result.Metrics)
+				}
+				if len(result.Errors) != 2 {
+					t.Errorf("Expected 2 errors, got %v", result.Errors)
+				}
+			},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSError(403, "Access Denied")
+			},
+			expectedError: "failed to get KMS status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetKMSStatusService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
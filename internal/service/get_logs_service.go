@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// DefaultLogsBufferSize is the number of log entries buffered for a slow consumer before entries are dropped
+const DefaultLogsBufferSize = 256
+
+type GetLogsService struct {
+	minioClient *madmin.AdminClient
+	bufferSize  int
+}
+
+// GetLogsOptions represents options for filtering the server log stream
+type GetLogsOptions struct {
+	Node  string // Node to stream from, empty means all nodes
+	Kind  string // "minio", "application" or "all"
+	Limit int    // Number of recent entries to replay before streaming (last-N)
+}
+
+// LogEntry represents a single MinIO server console log entry
+type LogEntry struct {
+	Node       string   `json:"node"`
+	Time       string   `json:"time"`
+	Level      string   `json:"level"`
+	Kind       string   `json:"kind"`
+	Message    string   `json:"message,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	Source     []string `json:"source,omitempty"`
+	API        string   `json:"api,omitempty"`
+	Bucket     string   `json:"bucket,omitempty"`
+	Object     string   `json:"object,omitempty"`
+	RemoteHost string   `json:"remoteHost,omitempty"`
+	RequestID  string   `json:"requestId,omitempty"`
+	UserAgent  string   `json:"userAgent,omitempty"`
+	Dropped    int      `json:"dropped,omitempty"` // Entries discarded before this one because the consumer was too slow
+}
+
+// LogMessage is an item of the log stream, carrying either an entry or the error which ended the stream
+type LogMessage struct {
+	Entry *LogEntry
+	Err   error
+}
+
+func NewGetLogsService(minioClient *madmin.AdminClient) *GetLogsService {
+	return &GetLogsService{
+		minioClient: minioClient,
+		bufferSize:  DefaultLogsBufferSize,
+	}
+}
+
+// ValidLogKind reports whether kind is accepted by the MinIO log API
+func ValidLogKind(kind string) bool {
+	switch kind {
+	case "minio", "application", "all":
+		return true
+	}
+	return false
+}
+
+// Execute starts streaming server logs until ctx is cancelled.
+//
+// Entries are handed over through a bounded buffer: when the consumer falls behind and the
+// buffer is full, new entries are dropped and the count is reported on the next delivered entry,
+// so a slow client can never make the server buffer logs without limit.
+func (s *GetLogsService) Execute(ctx context.Context, opts GetLogsOptions) (<-chan LogMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if opts.Kind == "" {
+		opts.Kind = "all"
+	}
+	if !ValidLogKind(opts.Kind) {
+		return nil, fmt.Errorf("invalid log kind: %s. Must be 'minio', 'application' or 'all'", opts.Kind)
+	}
+	if opts.Limit < 0 {
+		return nil, fmt.Errorf("invalid log limit: %d", opts.Limit)
+	}
+
+	logger.Debug().
+		Str("node", opts.Node).
+		Str("kind", opts.Kind).
+		Int("limit", opts.Limit).
+		Msg("Starting MinIO log stream")
+
+	upstream := s.minioClient.GetLogs(ctx, opts.Node, opts.Limit, opts.Kind)
+	out := make(chan LogMessage, s.bufferSize)
+
+	go func() {
+		defer close(out)
+
+		dropped := 0
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case info, ok := <-upstream:
+				if !ok {
+					return
+				}
+
+				if info.Err != nil {
+					logger.Error().Err(info.Err).Msg("MinIO log stream failed")
+					// Deliver the error even if the buffer is full, the stream ends here
+					select {
+					case out <- LogMessage{Err: fmt.Errorf("failed to stream logs: %w", info.Err)}:
+					case <-ctx.Done():
+					}
+					return
+				}
+
+				entry := toLogEntry(info)
+				entry.Dropped = dropped
+
+				select {
+				case out <- LogMessage{Entry: entry}:
+					dropped = 0
+				default:
+					dropped++
+					if dropped == 1 {
+						logger.Warn().Msg("Log consumer is too slow, dropping entries")
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// toLogEntry converts a madmin log message into the API representation
+func toLogEntry(info madmin.LogInfo) *LogEntry {
+	entry := &LogEntry{
+		Node:       info.NodeName,
+		Time:       info.Time,
+		Level:      info.Level,
+		Kind:       string(info.LogKind),
+		Message:    info.Message,
+		RemoteHost: info.RemoteHost,
+		RequestID:  info.RequestID,
+		UserAgent:  info.UserAgent,
+	}
+
+	if info.API != nil {
+		entry.API = info.API.Name
+		if info.API.Args != nil {
+			entry.Bucket = info.API.Args.Bucket
+			entry.Object = info.API.Args.Object
+		}
+	}
+
+	if info.Trace != nil {
+		entry.Error = info.Trace.Message
+		entry.Source = info.Trace.Source
+	}
+
+	return entry
+}
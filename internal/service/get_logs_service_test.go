@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestGetLogsService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		options        GetLogsOptions
+		expectedError  string
+		expectedStream string
+		expectedCount  int
+		validateResult func(t *testing.T, entries []LogEntry)
+	}{
+		{
+			name: "successful stream of all logs",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetLogsResponse(scenarios.SuccessfulLogs())
+			},
+			options:       GetLogsOptions{},
+			expectedCount: 2,
+			validateResult: func(t *testing.T, entries []LogEntry) {
+				if len(entries) != 2 {
+					t.Fatalf("Expected %d entries, got %d", 2, len(entries))
+				}
+				if entries[0].Node != "node1:9000" {
+					t.Errorf("Expected Node %q, got %q", "node1:9000", entries[0].Node)
+				}
+				if entries[0].Message != "Server started" {
+					t.Errorf("Expected Message %q, got %q", "Server started", entries[0].Message)
+				}
+				if entries[1].Kind != "ERROR" {
+					t.Errorf("Expected Kind %q, got %q", "ERROR", entries[1].Kind)
+				}
+			},
+		},
+		{
+			name: "successful stream with filters",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetLogsResponse(scenarios.SuccessfulLogs()[:1])
+			},
+			options:       GetLogsOptions{Node: "node1:9000", Kind: "minio", Limit: 10},
+			expectedCount: 1,
+			validateResult: func(t *testing.T, entries []LogEntry) {
+				if len(entries) != 1 {
+					t.Fatalf("Expected %d entries, got %d", 1, len(entries))
+				}
+			},
+		},
+		{
+			name:          "invalid log kind",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       GetLogsOptions{Kind: "debug"},
+			expectedError: "invalid log kind",
+		},
+		{
+			name:          "invalid log limit",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       GetLogsOptions{Limit: -1},
+			expectedError: "invalid log limit",
+		},
+		{
+			name: "MinIO server error ends the stream",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLogsError(403, "Forbidden")
+			},
+			options:        GetLogsOptions{Kind: "all"},
+			expectedStream: "failed to stream logs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetLogsService(minioClient)
+
+			// Create context with logger, cancelled before the mock server is closed
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+			// Execute test
+			logs, err := service.Execute(ctx, tt.options)
+
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.expectedStream != "" {
+				msg, ok := <-logs
+				if !ok {
+					t.Fatal("Expected error message, stream closed")
+				}
+				if msg.Err == nil || !strings.Contains(msg.Err.Error(), tt.expectedStream) {
+					t.Errorf("Expected stream error containing %q, got %v", tt.expectedStream, msg.Err)
+				}
+				return
+			}
+
+			// The mock keeps the connection open, so read until the expected entries arrived
+			var entries []LogEntry
+			for len(entries) < tt.expectedCount {
+				select {
+				case msg, ok := <-logs:
+					if !ok {
+						t.Fatalf("Stream closed after %d entries", len(entries))
+					}
+					if msg.Err != nil {
+						t.Fatalf("Unexpected stream error: %v", msg.Err)
+					}
+					entries = append(entries, *msg.Entry)
+				case <-ctx.Done():
+					t.Fatalf("Timed out after %d entries", len(entries))
+				}
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, entries)
+			}
+		})
+	}
+}
+
+// TestGetLogsService_ForwardsFilters tests that the filters are passed to the MinIO log API
+func TestGetLogsService_ForwardsFilters(t *testing.T) {
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	scenarios := minio.TestScenarios{}
+	mockServer.SetLogsResponse(scenarios.SuccessfulLogs()[:1])
+
+	minioClient, err := mockServer.CreateMinIOClient()
+	if err != nil {
+		t.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+	logs, err := NewGetLogsService(minioClient).Execute(ctx, GetLogsOptions{Node: "node1:9000", Kind: "application", Limit: 50})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Wait for the first entry so the request has been received
+	if msg := <-logs; msg.Err != nil {
+		t.Fatalf("Unexpected stream error: %v", msg.Err)
+	}
+
+	requests := mockServer.Requests("logs")
+	if len(requests) == 0 {
+		t.Fatal("Expected log request to be recorded")
+	}
+
+	query := requests[0]
+	if got := query.Get("node"); got != "node1:9000" {
+		t.Errorf("Expected node %q, got %q", "node1:9000", got)
+	}
+	if got := query.Get("logType"); got != "application" {
+		t.Errorf("Expected logType %q, got %q", "application", got)
+	}
+	if got := query.Get("limit"); got != "50" {
+		t.Errorf("Expected limit %q, got %q", "50", got)
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/minio/madmin-go/v4"
 	"github.com/rs/zerolog"
@@ -45,10 +46,64 @@ type DiskInfo struct {
 	Healing        bool    `json:"healing"`
 }
 
-// CombinedServerInfo contains both server info and disk usage
+// Topology represents how the cluster is organized as servers and pools of erasure sets
+type Topology struct {
+	Parity  int            `json:"parity"` // Parity drives per set of the standard storage class
+	Servers []ServerNode   `json:"servers"`
+	Pools   []PoolTopology `json:"pools"`
+}
+
+// ServerNode represents a single MinIO server of the cluster
+type ServerNode struct {
+	Endpoint      string            `json:"endpoint"`
+	State         string            `json:"state"`
+	Version       string            `json:"version"`
+	CommitID      string            `json:"commitId"`
+	Uptime        int64             `json:"uptime"`  // Seconds
+	Network       map[string]string `json:"network"` // Peer endpoint to its state as seen by this server
+	PoolNumbers   []int             `json:"poolNumbers"`
+	OnlineDrives  int               `json:"onlineDrives"`
+	OfflineDrives int               `json:"offlineDrives"`
+}
+
+// PoolTopology represents a server pool and its erasure sets
+type PoolTopology struct {
+	Index int                  `json:"index"`
+	Sets  []ErasureSetTopology `json:"sets"`
+}
+
+// ErasureSetTopology represents the drives of an erasure set and how many more it can lose
+type ErasureSetTopology struct {
+	PoolIndex     int             `json:"poolIndex"`
+	SetIndex      int             `json:"setIndex"`
+	DrivesCount   int             `json:"drivesCount"`
+	OnlineDrives  int             `json:"onlineDrives"`
+	OfflineDrives int             `json:"offlineDrives"` // Includes drives the set expects but no server reported
+	HealingDrives int             `json:"healingDrives"`
+	Drives        []TopologyDrive `json:"drives"`
+	// RemainingTolerance is how many more drives can fail before the set loses write quorum
+	RemainingTolerance   int  `json:"remainingTolerance"`
+	CanTolerateDriveLoss bool `json:"canTolerateDriveLoss"`
+}
+
+// TopologyDrive represents a drive and its position in the cluster
+type TopologyDrive struct {
+	Endpoint       string  `json:"endpoint"`
+	Server         string  `json:"server"`
+	DiskIndex      int     `json:"diskIndex"`
+	State          string  `json:"state"`
+	Healing        bool    `json:"healing"`
+	TotalSpace     uint64  `json:"totalSpace"`
+	UsedSpace      uint64  `json:"usedSpace"`
+	AvailableSpace uint64  `json:"availableSpace"`
+	Utilization    float64 `json:"utilization"`
+}
+
+// CombinedServerInfo contains server info, disk usage and topology
 type CombinedServerInfo struct {
 	ServerInfo *ServerInfo `json:"serverInfo"`
 	DiskUsage  *DiskUsage  `json:"diskUsage"`
+	Topology   *Topology   `json:"topology"`
 }
 
 func NewGetServerInfoService(minioClient *madmin.AdminClient) *GetServerInfoService {
@@ -82,6 +137,7 @@ func (s *GetServerInfoService) Execute(ctx context.Context) (*CombinedServerInfo
 	return &CombinedServerInfo{
 		ServerInfo: serverInfo,
 		DiskUsage:  diskUsage,
+		Topology:   s.extractTopology(info),
 	}, nil
 }
 
@@ -149,3 +205,126 @@ func (s *GetServerInfoService) extractDiskUsage(info madmin.InfoMessage) *DiskUs
 		DiskDetails:       diskDetails,
 	}
 }
+
+// extractTopology groups the drives reported by every server into pools and erasure sets
+func (s *GetServerInfoService) extractTopology(info madmin.InfoMessage) *Topology {
+	topology := &Topology{
+		Parity:  info.Backend.StandardSCParity,
+		Servers: make([]ServerNode, 0, len(info.Servers)),
+		Pools:   []PoolTopology{},
+	}
+
+	type setKey struct{ pool, set int }
+	sets := map[setKey]*ErasureSetTopology{}
+	setFor := func(pool, set int) *ErasureSetTopology {
+		key := setKey{pool, set}
+		if _, exists := sets[key]; !exists {
+			sets[key] = &ErasureSetTopology{PoolIndex: pool, SetIndex: set, Drives: []TopologyDrive{}}
+		}
+		return sets[key]
+	}
+
+	// Every set of a pool is listed even when none of its drives were reported
+	for pool, setsCount := range info.Backend.TotalSets {
+		for set := range setsCount {
+			setFor(pool, set)
+		}
+	}
+
+	for _, server := range info.Servers {
+		node := ServerNode{
+			Endpoint:    server.Endpoint,
+			State:       server.State,
+			Version:     server.Version,
+			CommitID:    server.CommitID,
+			Uptime:      server.Uptime,
+			Network:     server.Network,
+			PoolNumbers: server.PoolNumbers,
+		}
+		if node.Network == nil {
+			node.Network = map[string]string{}
+		}
+		if len(node.PoolNumbers) == 0 {
+			node.PoolNumbers = []int{server.PoolNumber}
+		}
+
+		for _, disk := range server.Disks {
+			if disk.State == madmin.DriveStateOk {
+				node.OnlineDrives++
+			} else {
+				node.OfflineDrives++
+			}
+
+			// Drives not assigned to a set yet are reported with negative indexes
+			if disk.PoolIndex < 0 || disk.SetIndex < 0 {
+				continue
+			}
+
+			set := setFor(disk.PoolIndex, disk.SetIndex)
+			set.Drives = append(set.Drives, TopologyDrive{
+				Endpoint:       disk.Endpoint,
+				Server:         server.Endpoint,
+				DiskIndex:      disk.DiskIndex,
+				State:          disk.State,
+				Healing:        disk.Healing,
+				TotalSpace:     disk.TotalSpace,
+				UsedSpace:      disk.UsedSpace,
+				AvailableSpace: disk.AvailableSpace,
+				Utilization:    disk.Utilization,
+			})
+		}
+
+		topology.Servers = append(topology.Servers, node)
+	}
+
+	pools := map[int]*PoolTopology{}
+	for _, set := range sets {
+		drivesPerSet := len(set.Drives)
+		if set.PoolIndex < len(info.Backend.DrivesPerSet) {
+			drivesPerSet = max(drivesPerSet, info.Backend.DrivesPerSet[set.PoolIndex])
+		}
+		summarizeErasureSet(set, drivesPerSet, topology.Parity)
+
+		if _, exists := pools[set.PoolIndex]; !exists {
+			pools[set.PoolIndex] = &PoolTopology{Index: set.PoolIndex, Sets: []ErasureSetTopology{}}
+		}
+		pools[set.PoolIndex].Sets = append(pools[set.PoolIndex].Sets, *set)
+	}
+
+	for _, pool := range pools {
+		sort.Slice(pool.Sets, func(i, j int) bool { return pool.Sets[i].SetIndex < pool.Sets[j].SetIndex })
+		topology.Pools = append(topology.Pools, *pool)
+	}
+	sort.Slice(topology.Pools, func(i, j int) bool { return topology.Pools[i].Index < topology.Pools[j].Index })
+
+	return topology
+}
+
+// summarizeErasureSet counts the drive states of a set and how many more drives it can lose.
+// A set keeps write quorum while at least its data drives are available, plus one when
+// data and parity drives are equal. Healing drives are not counted as available.
+func summarizeErasureSet(set *ErasureSetTopology, drivesCount, parity int) {
+	sort.Slice(set.Drives, func(i, j int) bool { return set.Drives[i].DiskIndex < set.Drives[j].DiskIndex })
+
+	set.DrivesCount = drivesCount
+	for _, drive := range set.Drives {
+		switch {
+		case drive.State != madmin.DriveStateOk:
+			set.OfflineDrives++
+		case drive.Healing:
+			set.HealingDrives++
+		default:
+			set.OnlineDrives++
+		}
+	}
+	// Drives the set expects but no server reported are unreachable
+	set.OfflineDrives += drivesCount - len(set.Drives)
+
+	writeQuorum := drivesCount - parity
+	if writeQuorum == parity {
+		writeQuorum++
+	}
+
+	set.RemainingTolerance = max(set.OnlineDrives-writeQuorum, 0)
+	set.CanTolerateDriveLoss = parity > 0 && set.RemainingTolerance > 0
+}
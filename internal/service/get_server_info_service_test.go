@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestGetServerInfoService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *CombinedServerInfo)
+	}{
+		{
+			name: "topology groups drives into sets",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerInfoResponse(scenarios.TopologyServerInfo())
+			},
+			validateResult: func(t *testing.T, result *CombinedServerInfo) {
+				topology := result.Topology
+				if topology.Parity != 2 {
+					t.Errorf("Expected Parity %d, got %d", 2, topology.Parity)
+				}
+				if len(topology.Pools) != 1 {
+					t.Fatalf("Expected %d pool, got %d", 1, len(topology.Pools))
+				}
+
+				sets := topology.Pools[0].Sets
+				if len(sets) != 2 {
+					t.Fatalf("Expected %d sets, got %d", 2, len(sets))
+				}
+				for i, set := range sets {
+					if set.SetIndex != i {
+						t.Errorf("Expected set %d to have SetIndex %d, got %d", i, i, set.SetIndex)
+					}
+					if len(set.Drives) != 6 {
+						t.Errorf("Expected set %d to have %d drives, got %d", i, 6, len(set.Drives))
+					}
+				}
+
+				// One offline drive leaves one more tolerated loss before write quorum (4 of 6) is lost
+				if sets[0].OnlineDrives != 5 || sets[0].OfflineDrives != 1 {
+					t.Errorf("Expected set 0 with 5 online and 1 offline drives, got %d and %d", sets[0].OnlineDrives, sets[0].OfflineDrives)
+				}
+				if sets[0].RemainingTolerance != 1 || !sets[0].CanTolerateDriveLoss {
+					t.Errorf("Expected set 0 to tolerate %d more loss, got %d (%v)", 1, sets[0].RemainingTolerance, sets[0].CanTolerateDriveLoss)
+				}
+
+				// A healing drive does not count towards quorum
+				if sets[1].HealingDrives != 1 {
+					t.Errorf("Expected set 1 with %d healing drive, got %d", 1, sets[1].HealingDrives)
+				}
+				if sets[1].RemainingTolerance != 0 || sets[1].CanTolerateDriveLoss {
+					t.Errorf("Expected set 1 to tolerate no more loss, got %d (%v)", sets[1].RemainingTolerance, sets[1].CanTolerateDriveLoss)
+				}
+
+				if len(topology.Servers) != 2 {
+					t.Fatalf("Expected %d servers, got %d", 2, len(topology.Servers))
+				}
+				node2 := topology.Servers[1]
+				if node2.Endpoint != "node2:9000" {
+					t.Errorf("Expected Endpoint %q, got %q", "node2:9000", node2.Endpoint)
+				}
+				if node2.OnlineDrives != 4 || node2.OfflineDrives != 2 {
+					t.Errorf("Expected node2 with 4 online and 2 offline drives, got %d and %d", node2.OnlineDrives, node2.OfflineDrives)
+				}
+				if node2.Uptime != 3600 {
+					t.Errorf("Expected Uptime %d, got %d", 3600, node2.Uptime)
+				}
+				if node2.Network["node1:9000"] != "online" {
+					t.Errorf("Expected node1 to be online from node2, got %q", node2.Network["node1:9000"])
+				}
+			},
+		},
+		{
+			name: "sets without reported drives are offline",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				info := scenarios.TopologyServerInfo()
+				info.Backend.TotalSets = []int{3}
+				mock.SetServerInfoResponse(info)
+			},
+			validateResult: func(t *testing.T, result *CombinedServerInfo) {
+				sets := result.Topology.Pools[0].Sets
+				if len(sets) != 3 {
+					t.Fatalf("Expected %d sets, got %d", 3, len(sets))
+				}
+				if sets[2].OfflineDrives != 6 {
+					t.Errorf("Expected set 2 with %d offline drives, got %d", 6, sets[2].OfflineDrives)
+				}
+				if sets[2].CanTolerateDriveLoss {
+					t.Error("Expected set without drives not to tolerate drive loss")
+				}
+			},
+		},
+		{
+			name: "standalone server has empty topology",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerInfoResponse(scenarios.SuccessfulServerInfo())
+			},
+			validateResult: func(t *testing.T, result *CombinedServerInfo) {
+				if len(result.Topology.Servers) != 1 {
+					t.Errorf("Expected %d server, got %d", 1, len(result.Topology.Servers))
+				}
+				if len(result.Topology.Pools) != 0 {
+					t.Errorf("Expected no pools, got %d", len(result.Topology.Pools))
+				}
+			},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServerInfoNonRetryableError(400, "Bad Request")
+			},
+			expectedError: "failed to get server info",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewGetServerInfoService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// MaxUsageHistoryPoints bounds the number of points of a single history request
+	MaxUsageHistoryPoints = 2000
+
+	// maxForecastHorizon is how far ahead an exhaustion date is still reported
+	maxForecastHorizon = 100 * 365 * 24 * time.Hour
+)
+
+type GetUsageHistoryService struct {
+	history *store.UsageHistory
+}
+
+// UsageHistoryOptions represents the time range of a usage history query
+type UsageHistoryOptions struct {
+	From time.Time
+	To   time.Time
+	Step time.Duration // Keep the latest snapshot of every step, zero returns every snapshot
+}
+
+// UsageForecast represents the projected capacity exhaustion based on the growth in the range
+type UsageForecast struct {
+	GrowthBytesPerDay float64    `json:"growthBytesPerDay"`
+	EstimatedFullAt   *time.Time `json:"estimatedFullAt,omitempty"` // Nil when usage is not growing or too slowly
+	DaysUntilFull     *float64   `json:"daysUntilFull,omitempty"`
+}
+
+// UsageHistoryResponse represents the usage history within a time range
+type UsageHistoryResponse struct {
+	From         time.Time             `json:"from"`
+	To           time.Time             `json:"to"`
+	Step         string                `json:"step,omitempty"`
+	Points       []store.UsageSnapshot `json:"points"`
+	BucketGrowth map[string]int64      `json:"bucketGrowth"`       // Size change in bytes between the first and last snapshot
+	Forecast     *UsageForecast        `json:"forecast,omitempty"` // Nil when fewer than two snapshots exist
+}
+
+func NewGetUsageHistoryService(history *store.UsageHistory) *GetUsageHistoryService {
+	return &GetUsageHistoryService{
+		history: history,
+	}
+}
+
+func (s *GetUsageHistoryService) Execute(ctx context.Context, opts UsageHistoryOptions) (*UsageHistoryResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !opts.To.After(opts.From) {
+		return nil, fmt.Errorf("invalid time range: from %s must be before to %s", opts.From.Format(time.RFC3339), opts.To.Format(time.RFC3339))
+	}
+	if opts.Step < 0 {
+		return nil, fmt.Errorf("invalid step: %s", opts.Step)
+	}
+	if opts.Step > 0 && opts.To.Sub(opts.From)/opts.Step > MaxUsageHistoryPoints {
+		return nil, fmt.Errorf("step too small: more than %d points in range", MaxUsageHistoryPoints)
+	}
+
+	logger.Debug().
+		Time("from", opts.From).
+		Time("to", opts.To).
+		Dur("step", opts.Step).
+		Msg("Querying usage history")
+
+	snapshots, err := s.history.Range(opts.From, opts.To)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read usage history")
+		return nil, fmt.Errorf("failed to get usage history: %w", err)
+	}
+
+	response := &UsageHistoryResponse{
+		From:         opts.From,
+		To:           opts.To,
+		Points:       downsampleSnapshots(snapshots, opts.From, opts.Step),
+		BucketGrowth: bucketGrowth(snapshots),
+		Forecast:     forecastUsage(snapshots),
+	}
+	if opts.Step > 0 {
+		response.Step = opts.Step.String()
+	}
+
+	return response, nil
+}
+
+// downsampleSnapshots keeps the latest snapshot of every step starting at from
+func downsampleSnapshots(snapshots []store.UsageSnapshot, from time.Time, step time.Duration) []store.UsageSnapshot {
+	if step == 0 {
+		return snapshots
+	}
+
+	points := []store.UsageSnapshot{}
+	lastSlot := int64(-1)
+	for _, snapshot := range snapshots {
+		slot := int64(snapshot.Timestamp.Sub(from) / step)
+		if slot == lastSlot {
+			points[len(points)-1] = snapshot
+			continue
+		}
+		points = append(points, snapshot)
+		lastSlot = slot
+	}
+
+	return points
+}
+
+// bucketGrowth returns the size change of every bucket between the first and last snapshot
+func bucketGrowth(snapshots []store.UsageSnapshot) map[string]int64 {
+	growth := map[string]int64{}
+	if len(snapshots) == 0 {
+		return growth
+	}
+
+	first, last := snapshots[0], snapshots[len(snapshots)-1]
+	for name, bucket := range last.Buckets {
+		growth[name] = int64(bucket.Size) - int64(first.Buckets[name].Size)
+	}
+	// Buckets deleted within the range shrink to zero
+	for name, bucket := range first.Buckets {
+		if _, exists := last.Buckets[name]; !exists {
+			growth[name] = -int64(bucket.Size)
+		}
+	}
+
+	return growth
+}
+
+// forecastUsage fits a least squares line through the used capacity to project when the free capacity runs out
+func forecastUsage(snapshots []store.UsageSnapshot) *UsageForecast {
+	if len(snapshots) < 2 {
+		return nil
+	}
+
+	origin := snapshots[0].Timestamp
+	n := float64(len(snapshots))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, snapshot := range snapshots {
+		x := snapshot.Timestamp.Sub(origin).Seconds()
+		y := float64(snapshot.UsedCapacity)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return nil
+	}
+
+	// Slope in bytes per second
+	slope := (n*sumXY - sumX*sumY) / denominator
+	forecast := &UsageForecast{
+		GrowthBytesPerDay: slope * (24 * time.Hour).Seconds(),
+	}
+
+	last := snapshots[len(snapshots)-1]
+	if slope <= 0 {
+		return forecast
+	}
+
+	secondsUntilFull := float64(last.FreeCapacity) / slope
+	if secondsUntilFull > maxForecastHorizon.Seconds() {
+		return forecast
+	}
+
+	days := secondsUntilFull / (24 * time.Hour).Seconds()
+	fullAt := last.Timestamp.Add(time.Duration(secondsUntilFull * float64(time.Second)))
+	forecast.DaysUntilFull = &days
+	forecast.EstimatedFullAt = &fullAt
+
+	return forecast
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+func TestGetUsageHistoryService_Execute(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	const gib = 1 << 30
+
+	// Four daily snapshots growing by 10 GiB per day on a 100 GiB cluster, plus one intraday snapshot
+	seed := func(t *testing.T, history *store.UsageHistory) {
+		for i, used := range []uint64{10, 20, 30, 40} {
+			snapshot := store.UsageSnapshot{
+				Timestamp:     start.Add(time.Duration(i) * 24 * time.Hour),
+				TotalCapacity: 100 * gib,
+				UsedCapacity:  used * gib,
+				FreeCapacity:  (100 - used) * gib,
+				Buckets: map[string]store.BucketUsage{
+					"media": {Size: used * gib},
+				},
+			}
+			if i == 0 {
+				snapshot.Buckets["old"] = store.BucketUsage{Size: gib}
+			}
+			if err := history.Save(snapshot); err != nil {
+				t.Fatalf("Failed to seed history: %v", err)
+			}
+		}
+		err := history.Save(store.UsageSnapshot{
+			Timestamp:     start.Add(12 * time.Hour),
+			TotalCapacity: 100 * gib,
+			UsedCapacity:  15 * gib,
+			FreeCapacity:  85 * gib,
+		})
+		if err != nil {
+			t.Fatalf("Failed to seed history: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		options        UsageHistoryOptions
+		expectedError  string
+		validateResult func(t *testing.T, result *UsageHistoryResponse)
+	}{
+		{
+			name:    "all snapshots with forecast",
+			options: UsageHistoryOptions{From: start, To: start.Add(7 * 24 * time.Hour)},
+			validateResult: func(t *testing.T, result *UsageHistoryResponse) {
+				if len(result.Points) != 5 {
+					t.Errorf("Expected %d points, got %d", 5, len(result.Points))
+				}
+				if result.Forecast == nil {
+					t.Fatal("Expected forecast, got nil")
+				}
+				if got := result.Forecast.GrowthBytesPerDay / gib; got < 9.99 || got > 10.01 {
+					t.Errorf("Expected growth of %v GiB per day, got %v", 10, got)
+				}
+				if result.Forecast.DaysUntilFull == nil {
+					t.Fatal("Expected days until full, got nil")
+				}
+				if got := *result.Forecast.DaysUntilFull; got < 5.99 || got > 6.01 {
+					t.Errorf("Expected %v days until full, got %v", 6, got)
+				}
+				if result.Forecast.EstimatedFullAt == nil {
+					t.Error("Expected estimated full date, got nil")
+				}
+			},
+		},
+		{
+			name:    "daily step keeps the latest snapshot of each day",
+			options: UsageHistoryOptions{From: start, To: start.Add(7 * 24 * time.Hour), Step: 24 * time.Hour},
+			validateResult: func(t *testing.T, result *UsageHistoryResponse) {
+				if len(result.Points) != 4 {
+					t.Fatalf("Expected %d points, got %d", 4, len(result.Points))
+				}
+				if got := result.Points[0].UsedCapacity; got != 15*gib {
+					t.Errorf("Expected first day to end at %d bytes, got %d", uint64(15*gib), got)
+				}
+				if result.Step != "24h0m0s" {
+					t.Errorf("Expected step %q, got %q", "24h0m0s", result.Step)
+				}
+			},
+		},
+		{
+			name:    "bucket growth includes removed buckets",
+			options: UsageHistoryOptions{From: start, To: start.Add(7 * 24 * time.Hour)},
+			validateResult: func(t *testing.T, result *UsageHistoryResponse) {
+				if got := result.BucketGrowth["media"]; got != 30*gib {
+					t.Errorf("Expected media growth %d, got %d", 30*gib, got)
+				}
+				if got := result.BucketGrowth["old"]; got != -gib {
+					t.Errorf("Expected old growth %d, got %d", -gib, got)
+				}
+			},
+		},
+		{
+			name:    "single snapshot has no forecast",
+			options: UsageHistoryOptions{From: start.Add(-time.Hour), To: start.Add(time.Hour)},
+			validateResult: func(t *testing.T, result *UsageHistoryResponse) {
+				if len(result.Points) != 1 {
+					t.Errorf("Expected %d point, got %d", 1, len(result.Points))
+				}
+				if result.Forecast != nil {
+					t.Errorf("Expected no forecast, got %+v", result.Forecast)
+				}
+			},
+		},
+		{
+			name:          "invalid time range",
+			options:       UsageHistoryOptions{From: start, To: start},
+			expectedError: "invalid time range",
+		},
+		{
+			name:          "step too small",
+			options:       UsageHistoryOptions{From: start, To: start.Add(365 * 24 * time.Hour), Step: time.Minute},
+			expectedError: "step too small",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := newTestUsageHistory(t)
+			seed(t, history)
+
+			// Create service
+			service := NewGetUsageHistoryService(history)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.options)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
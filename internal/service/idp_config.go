@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// idpConfigSubsystems maps the IDP config types to the config subsystem describing their keys
+var idpConfigSubsystems = map[string]string{
+	madmin.LDAPIDPCfg:   madmin.IdentityLDAPSubSys,
+	madmin.OpenidIDPCfg: madmin.IdentityOpenIDSubSys,
+}
+
+// idpConfigNamePattern matches the names of IDP configs, "_" is the default config
+var idpConfigNamePattern = regexp.MustCompile(`^(_|[A-Za-z0-9][A-Za-z0-9_-]{0,63})$`)
+
+// ValidIDPType reports whether idpType is a supported identity provider type: ldap or openid
+func ValidIDPType(idpType string) bool {
+	_, ok := idpConfigSubsystems[idpType]
+	return ok
+}
+
+// ValidIDPConfigName reports whether name is a valid IDP config name
+func ValidIDPConfigName(name string) bool {
+	return idpConfigNamePattern.MatchString(name)
+}
+
+// idpConfigData validates the values of an IDP config against the subsystem help and
+// returns them as key="value" pairs. Values equal to MaskedConfigValue are left unchanged.
+func idpConfigData(ctx context.Context, client *madmin.AdminClient, idpType string, values map[string]string) (string, []string, error) {
+	subSystem := idpConfigSubsystems[idpType]
+
+	help, err := client.HelpConfigKV(ctx, subSystem, "", false)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get config help: %w", err)
+	}
+
+	types := make(map[string]string, len(help.KeysHelp))
+	for _, keyHelp := range help.KeysHelp {
+		types[keyHelp.Key] = keyHelp.Type
+	}
+
+	keys := make([]string, 0, len(values))
+	for key, value := range values {
+		// Masked values were returned by the viewer and never edited
+		if value == MaskedConfigValue {
+			continue
+		}
+
+		valueType, known := types[key]
+		if !known {
+			return "", nil, fmt.Errorf("%w: unknown key %s for %s", ErrInvalidConfig, key, idpType)
+		}
+		if err := validateConfigValue(key, valueType, value); err != nil {
+			return "", nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return "", nil, fmt.Errorf("%w: no values to change", ErrInvalidConfig)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, key, values[key]))
+	}
+
+	return strings.Join(pairs, " "), keys, nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// MaxBucketMetadataSize bounds the size of an imported bucket metadata archive
+const MaxBucketMetadataSize = 32 * 1024 * 1024
+
+// ErrInvalidBucketMetadata is returned when an imported archive is not a bucket metadata export
+var ErrInvalidBucketMetadata = errors.New("invalid bucket metadata archive")
+
+type ImportBucketMetadataService struct {
+	minioClient *madmin.AdminClient
+}
+
+// BucketMetadataSetting represents the import status of one setting of a bucket
+type BucketMetadataSetting struct {
+	Setting string `json:"setting"`
+	Applied bool   `json:"applied"`
+	Error   string `json:"error,omitempty"`
+}
+
+// BucketMetadataImportResult represents the import status of a bucket
+type BucketMetadataImportResult struct {
+	Bucket   string                  `json:"bucket"`
+	Error    string                  `json:"error,omitempty"` // Set when the bucket could not be imported at all
+	Settings []BucketMetadataSetting `json:"settings"`
+}
+
+// ImportBucketMetadataResponse represents the outcome of a bucket metadata import
+type ImportBucketMetadataResponse struct {
+	Buckets []BucketMetadataImportResult `json:"buckets"`
+	Total   int                          `json:"total"`
+	Failed  int                          `json:"failed"` // Buckets with at least one error
+}
+
+func NewImportBucketMetadataService(minioClient *madmin.AdminClient) *ImportBucketMetadataService {
+	return &ImportBucketMetadataService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute imports an archive created by ExportBucketMetadataService. An empty bucket imports every
+// bucket of the archive, MinIO creates missing buckets. Settings which cannot be applied are
+// reported per bucket instead of failing the whole import.
+func (s *ImportBucketMetadataService) Execute(ctx context.Context, bucket string, archive []byte) (*ImportBucketMetadataResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := validateBucketMetadata(archive); err != nil {
+		return nil, err
+	}
+
+	logger.Debug().Str("bucket", bucket).Int("size", len(archive)).Msg("Importing MinIO bucket metadata")
+
+	result, err := s.minioClient.ImportBucketMetadata(ctx, bucket, io.NopCloser(bytes.NewReader(archive)))
+	if err != nil {
+		logger.Error().Err(err).Str("bucket", bucket).Msg("Failed to import MinIO bucket metadata")
+		return nil, fmt.Errorf("failed to import bucket metadata: %w", err)
+	}
+
+	response := &ImportBucketMetadataResponse{
+		Buckets: make([]BucketMetadataImportResult, 0, len(result.Buckets)),
+	}
+
+	for _, name := range sortedKeys(result.Buckets) {
+		bucketResult := newBucketMetadataImportResult(name, result.Buckets[name])
+		if bucketResult.failed() {
+			response.Failed++
+		}
+		response.Buckets = append(response.Buckets, bucketResult)
+	}
+	response.Total = len(response.Buckets)
+
+	logger.Info().
+		Int("buckets", response.Total).
+		Int("failed", response.Failed).
+		Msg("Successfully imported MinIO bucket metadata")
+
+	return response, nil
+}
+
+// validateBucketMetadata checks that archive is a non-empty zip archive
+func validateBucketMetadata(archive []byte) error {
+	if len(archive) > MaxBucketMetadataSize {
+		return fmt.Errorf("%w: archive is larger than %d bytes", ErrInvalidBucketMetadata, MaxBucketMetadataSize)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return fmt.Errorf("%w: not a zip archive", ErrInvalidBucketMetadata)
+	}
+	if len(reader.File) == 0 {
+		return fmt.Errorf("%w: archive is empty", ErrInvalidBucketMetadata)
+	}
+
+	return nil
+}
+
+// newBucketMetadataImportResult lists the settings of a bucket in a stable order
+func newBucketMetadataImportResult(bucket string, status madmin.BucketStatus) BucketMetadataImportResult {
+	settings := []struct {
+		name   string
+		status madmin.MetaStatus
+	}{
+		{"policy", status.Policy},
+		{"lifecycle", status.Lifecycle},
+		{"notification", status.Notification},
+		{"quota", status.Quota},
+		{"versioning", status.Versioning},
+		{"objectLock", status.ObjectLock},
+		{"tagging", status.Tagging},
+		{"encryption", status.SSEConfig},
+		{"cors", status.Cors},
+	}
+
+	result := BucketMetadataImportResult{
+		Bucket:   bucket,
+		Error:    status.Err,
+		Settings: make([]BucketMetadataSetting, 0, len(settings)),
+	}
+
+	for _, setting := range settings {
+		result.Settings = append(result.Settings, BucketMetadataSetting{
+			Setting: setting.name,
+			Applied: setting.status.IsSet,
+			Error:   setting.status.Err,
+		})
+	}
+
+	return result
+}
+
+// failed reports whether the bucket or any of its settings failed to import
+func (r BucketMetadataImportResult) failed() bool {
+	if r.Error != "" {
+		return true
+	}
+	for _, setting := range r.Settings {
+		if setting.Error != "" {
+			return true
+		}
+	}
+	return false
+}
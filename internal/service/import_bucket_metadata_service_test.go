@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestImportBucketMetadataService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name            string
+		bucket          string
+		archive         []byte
+		setupMock       func(*minio.MockMinIOServer)
+		expectedError   string
+		expectedInvalid bool
+		validateResult  func(t *testing.T, result *ImportBucketMetadataResponse)
+	}{
+		{
+			name:    "per bucket and per setting report",
+			archive: scenarios.BucketMetadataArchive(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataImportResponse(scenarios.PartialBucketMetadataImport())
+			},
+			validateResult: func(t *testing.T, result *ImportBucketMetadataResponse) {
+				if result.Total != 2 {
+					t.Errorf("Expected Total %d, got %d", 2, result.Total)
+				}
+				if result.Failed != 1 {
+					t.Errorf("Expected Failed %d, got %d", 1, result.Failed)
+				}
+				if result.Buckets[0].Bucket != "logs" || result.Buckets[1].Bucket != "reports" {
+					t.Fatalf("Expected buckets sorted by name, got %s, %s", result.Buckets[0].Bucket, result.Buckets[1].Bucket)
+				}
+
+				settings := map[string]BucketMetadataSetting{}
+				for _, setting := range result.Buckets[1].Settings {
+					settings[setting.Setting] = setting
+				}
+				if len(settings) != 9 {
+					t.Errorf("Expected 9 settings, got %d", len(settings))
+				}
+				if !settings["policy"].Applied {
+					t.Error("Expected policy to be applied")
+				}
+				if settings["quota"].Applied || settings["quota"].Error == "" {
+					t.Errorf("Expected quota to fail, got %+v", settings["quota"])
+				}
+				if settings["versioning"].Applied {
+					t.Error("Expected versioning not to be applied")
+				}
+			},
+		},
+		{
+			name:    "missing bucket is reported",
+			bucket:  "reports",
+			archive: scenarios.BucketMetadataArchive(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataImportResponse(madmin.BucketMetaImportErrs{
+					Buckets: map[string]madmin.BucketStatus{
+						"reports": {Err: "Bucket name contains invalid characters"},
+					},
+				})
+			},
+			validateResult: func(t *testing.T, result *ImportBucketMetadataResponse) {
+				if result.Failed != 1 {
+					t.Errorf("Expected Failed %d, got %d", 1, result.Failed)
+				}
+				if result.Buckets[0].Error == "" {
+					t.Error("Expected bucket error")
+				}
+			},
+		},
+		{
+			name:            "not a zip archive",
+			archive:         []byte("policy"),
+			setupMock:       func(mock *minio.MockMinIOServer) {},
+			expectedError:   "not a zip archive",
+			expectedInvalid: true,
+		},
+		{
+			name:            "empty archive",
+			archive:         emptyZip(t),
+			setupMock:       func(mock *minio.MockMinIOServer) {},
+			expectedError:   "archive is empty",
+			expectedInvalid: true,
+		},
+		{
+			name:    "MinIO server error",
+			archive: scenarios.BucketMetadataArchive(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetBucketMetadataError(403, "Access Denied")
+			},
+			expectedError: "failed to import bucket metadata",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewImportBucketMetadataService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.bucket, tt.archive)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if got := errors.Is(err, ErrInvalidBucketMetadata); got != tt.expectedInvalid {
+					t.Errorf("Expected ErrInvalidBucketMetadata %v, got %v", tt.expectedInvalid, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			requests := mockServer.Requests("import-bucket-metadata")
+			if len(requests) != 1 {
+				t.Fatalf("Expected 1 import request, got %d", len(requests))
+			}
+			if got := requests[0].Get("bucket"); got != tt.bucket {
+				t.Errorf("Expected bucket %q, got %q", tt.bucket, got)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
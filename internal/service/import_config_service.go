@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type ImportConfigService struct {
+	minioClient *madmin.AdminClient
+	tokens      *ConfirmationTokens
+}
+
+// ConfigImportResult represents an applied config import
+type ConfigImportResult struct {
+	Changes         int  `json:"changes"`
+	RestartRequired bool `json:"restartRequired"` // MinIO applies an imported config after a restart
+}
+
+func NewImportConfigService(minioClient *madmin.AdminClient, tokens *ConfirmationTokens) *ImportConfigService {
+	return &ImportConfigService{
+		minioClient: minioClient,
+		tokens:      tokens,
+	}
+}
+
+// Execute applies the imported config once the confirmation token issued by PrepareConfigImportService is verified.
+// The token is only valid while the import and the current config are unchanged since the preview.
+func (s *ImportConfigService) Execute(ctx context.Context, imported []byte, confirmationToken string) (*ConfigImportResult, error) {
+	logger := zerolog.Ctx(ctx)
+
+	resolved, err := resolveConfigImport(ctx, s.minioClient, imported)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tokens.Consume(configImportSubject(resolved), confirmationToken); err != nil {
+		logger.Warn().Msg("Rejected MinIO config import without valid confirmation")
+		return nil, err
+	}
+
+	logger.Info().Int("changes", len(resolved.changes)).Msg("Importing MinIO server config")
+
+	if err := s.minioClient.SetConfig(ctx, strings.NewReader(resolved.config)); err != nil {
+		logger.Error().Err(err).Msg("Failed to import MinIO server config")
+		return nil, fmt.Errorf("failed to import config: %w", err)
+	}
+
+	logger.Info().Int("changes", len(resolved.changes)).Msg("Successfully imported MinIO server config")
+
+	return &ConfigImportResult{
+		Changes:         len(resolved.changes),
+		RestartRequired: true,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestImportConfigService_Execute(t *testing.T) {
+	const imported = `api requests_max=2000
+notify_webhook:primary endpoint=https://hooks.example.com/minio auth_token=******** queue_limit=10000
+`
+
+	tests := []struct {
+		name           string
+		confirmed      string // Config the confirmation token is issued for
+		changeCurrent  string // Replaces the current config after the confirmation
+		rejected       bool   // Expect ErrInvalidConfirmationToken
+		expectedConfig string
+	}{
+		{
+			name:      "confirmed import keeps masked secrets",
+			confirmed: imported,
+			expectedConfig: `api requests_max="2000"
+notify_webhook:primary endpoint="https://hooks.example.com/minio" auth_token="s3cr3t-hook-token" queue_limit="10000"
+`,
+		},
+		{
+			name:      "token confirmed for another config",
+			confirmed: "api requests_max=3000",
+			rejected:  true,
+		},
+		{
+			name:          "current config changed since the confirmation",
+			confirmed:     imported,
+			changeCurrent: "notify_webhook:primary endpoint=https://hooks.example.com/minio auth_token=rotated-token",
+			rejected:      true,
+		},
+		{
+			name:          "target outside the import added since the confirmation",
+			confirmed:     imported,
+			changeCurrent: minio.TestScenarios{}.ServerConfig() + "notify_webhook:secondary endpoint=https://other.example.com/minio\n",
+			rejected:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetServerConfigResponse(scenarios.ServerConfig())
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			tokens := NewConfirmationTokens(time.Minute)
+			preview, err := NewPrepareConfigImportService(minioClient, tokens).Execute(ctx, []byte(tt.confirmed))
+			if err != nil {
+				t.Fatalf("Failed to prepare config import: %v", err)
+			}
+			if tt.changeCurrent != "" {
+				mockServer.SetServerConfigResponse(tt.changeCurrent)
+			}
+
+			// Create service
+			service := NewImportConfigService(minioClient, tokens)
+
+			// Execute test
+			result, err := service.Execute(ctx, []byte(imported), preview.ConfirmationToken)
+
+			// Validate results
+			if tt.rejected {
+				if !errors.Is(err, ErrInvalidConfirmationToken) {
+					t.Errorf("Expected %v, got %v", ErrInvalidConfirmationToken, err)
+				}
+				if len(mockServer.Requests("set-config")) != 0 {
+					t.Error("Expected config not to be imported")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !result.RestartRequired {
+				t.Error("Expected import to require a restart")
+			}
+
+			requests := mockServer.Requests("set-config")
+			if len(requests) != 1 {
+				t.Fatalf("Expected %d import request, got %d", 1, len(requests))
+			}
+			if got := requests[0].Get("config"); got != tt.expectedConfig {
+				t.Errorf("Expected config %q, got %q", tt.expectedConfig, got)
+			}
+
+			// The token is single-use
+			if _, err := service.Execute(ctx, []byte(imported), preview.ConfirmationToken); !errors.Is(err, ErrInvalidConfirmationToken) {
+				t.Errorf("Expected replayed token to fail with %v, got %v", ErrInvalidConfirmationToken, err)
+			}
+		})
+	}
+}
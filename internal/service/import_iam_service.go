@@ -0,0 +1,200 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// MaxIAMBundleSize bounds the size of an imported IAM bundle
+const MaxIAMBundleSize = 32 * 1024 * 1024
+
+// iamAssetsDir is the directory holding the IAM entities in an exported bundle
+const iamAssetsDir = "iam-assets/"
+
+// ErrInvalidIAMBundle is returned when an imported IAM bundle is not a valid export
+var ErrInvalidIAMBundle = errors.New("invalid IAM bundle")
+
+type ImportIAMService struct {
+	minioClient *madmin.AdminClient
+}
+
+// IAMEntities represents the IAM entities affected by an import
+type IAMEntities struct {
+	Policies        []string            `json:"policies"`
+	Users           []string            `json:"users"`
+	Groups          []string            `json:"groups"`
+	ServiceAccounts []string            `json:"serviceAccounts"`
+	UserPolicies    map[string][]string `json:"userPolicies"`  // Policies mapped to each user
+	GroupPolicies   map[string][]string `json:"groupPolicies"` // Policies mapped to each group
+	STSPolicies     map[string][]string `json:"stsPolicies"`   // Policies mapped to each STS user
+}
+
+// IAMImportFailure represents an IAM entity which could not be imported
+type IAMImportFailure struct {
+	Entity   string   `json:"entity"` // policy, user, group, serviceAccount, userPolicy, groupPolicy or stsPolicy
+	Name     string   `json:"name"`
+	Policies []string `json:"policies,omitempty"` // Policies of a failed mapping
+	Error    string   `json:"error,omitempty"`
+}
+
+// ImportIAMResponse represents the outcome of an IAM import
+type ImportIAMResponse struct {
+	Added   IAMEntities        `json:"added"`
+	Skipped IAMEntities        `json:"skipped"` // Entities referring to missing groups, policies, etc.
+	Removed IAMEntities        `json:"removed"` // Invalid entities, e.g. empty policies
+	Failed  []IAMImportFailure `json:"failed"`
+}
+
+func NewImportIAMService(minioClient *madmin.AdminClient) *ImportIAMService {
+	return &ImportIAMService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute imports an IAM bundle created by ExportIAMService. MinIO does not fail the whole import
+// on invalid entities, so the response reports the skipped, removed and failed ones.
+func (s *ImportIAMService) Execute(ctx context.Context, bundle []byte) (*ImportIAMResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := validateIAMBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	logger.Debug().Int("size", len(bundle)).Msg("Importing MinIO IAM")
+
+	result, err := s.minioClient.ImportIAMV2(ctx, io.NopCloser(bytes.NewReader(bundle)))
+	// MinIO cannot encode the error of a failed entity, decoding it fails after the rest of the result is read
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		logger.Debug().Err(err).Msg("Ignoring undecodable IAM import errors")
+		err = nil
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to import MinIO IAM")
+		return nil, fmt.Errorf("failed to import IAM: %w", err)
+	}
+
+	response := &ImportIAMResponse{
+		Added:   newIAMEntities(result.Added),
+		Skipped: newIAMEntities(result.Skipped),
+		Removed: newIAMEntities(result.Removed),
+		Failed:  iamImportFailures(result.Failed),
+	}
+
+	logger.Info().
+		Int("addedUsers", len(response.Added.Users)).
+		Int("addedPolicies", len(response.Added.Policies)).
+		Int("failed", len(response.Failed)).
+		Msg("Successfully imported MinIO IAM")
+
+	return response, nil
+}
+
+// validateIAMBundle checks that bundle is a zip archive holding exported IAM entities
+func validateIAMBundle(bundle []byte) error {
+	if len(bundle) > MaxIAMBundleSize {
+		return fmt.Errorf("%w: bundle is larger than %d bytes", ErrInvalidIAMBundle, MaxIAMBundleSize)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+	if err != nil {
+		return fmt.Errorf("%w: not a zip archive", ErrInvalidIAMBundle)
+	}
+
+	for _, file := range archive.File {
+		if strings.HasPrefix(file.Name, iamAssetsDir) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: no %s entries found", ErrInvalidIAMBundle, strings.TrimSuffix(iamAssetsDir, "/"))
+}
+
+// newIAMEntities converts the madmin entities, merging the per-entry mappings into a single map
+func newIAMEntities(entities madmin.IAMEntities) IAMEntities {
+	return IAMEntities{
+		Policies:        nonNilStrings(entities.Policies),
+		Users:           nonNilStrings(entities.Users),
+		Groups:          nonNilStrings(entities.Groups),
+		ServiceAccounts: nonNilStrings(entities.ServiceAccounts),
+		UserPolicies:    mergePolicyMappings(entities.UserPolicies),
+		GroupPolicies:   mergePolicyMappings(entities.GroupPolicies),
+		STSPolicies:     mergePolicyMappings(entities.STSPolicies),
+	}
+}
+
+// iamImportFailures flattens the failed entities into a single list
+func iamImportFailures(failed madmin.IAMErrEntities) []IAMImportFailure {
+	failures := []IAMImportFailure{}
+
+	for _, group := range []struct {
+		entity   string
+		entities []madmin.IAMErrEntity
+	}{
+		{"policy", failed.Policies},
+		{"user", failed.Users},
+		{"group", failed.Groups},
+		{"serviceAccount", failed.ServiceAccounts},
+	} {
+		for _, entity := range group.entities {
+			failures = append(failures, IAMImportFailure{
+				Entity: group.entity,
+				Name:   entity.Name,
+				Error:  errorMessage(entity.Error),
+			})
+		}
+	}
+
+	for _, group := range []struct {
+		entity   string
+		entities []madmin.IAMErrPolicyEntity
+	}{
+		{"userPolicy", failed.UserPolicies},
+		{"groupPolicy", failed.GroupPolicies},
+		{"stsPolicy", failed.STSPolicies},
+	} {
+		for _, entity := range group.entities {
+			failures = append(failures, IAMImportFailure{
+				Entity:   group.entity,
+				Name:     entity.Name,
+				Policies: entity.Policies,
+				Error:    errorMessage(entity.Error),
+			})
+		}
+	}
+
+	return failures
+}
+
+func mergePolicyMappings(mappings []map[string][]string) map[string][]string {
+	merged := map[string][]string{}
+	for _, mapping := range mappings {
+		for name, policies := range mapping {
+			merged[name] = append(merged[name], policies...)
+		}
+	}
+	return merged
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestImportIAMService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name            string
+		bundle          []byte
+		setupMock       func(*minio.MockMinIOServer)
+		expectedError   string
+		expectedInvalid bool
+		validateResult  func(t *testing.T, result *ImportIAMResponse)
+	}{
+		{
+			name:   "partial import reports skipped and failed entities",
+			bundle: scenarios.IAMExportBundle(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMImportResponse(scenarios.PartialIAMImport())
+			},
+			validateResult: func(t *testing.T, result *ImportIAMResponse) {
+				if !reflect.DeepEqual(result.Added.Policies, []string{"readonly-reports"}) {
+					t.Errorf("Expected added policies %v, got %v", []string{"readonly-reports"}, result.Added.Policies)
+				}
+				if got := result.Added.UserPolicies["alice"]; !reflect.DeepEqual(got, []string{"readonly-reports"}) {
+					t.Errorf("Expected alice mapped to %v, got %v", []string{"readonly-reports"}, got)
+				}
+				if got := result.Skipped.GroupPolicies["auditors"]; !reflect.DeepEqual(got, []string{"audit-policy"}) {
+					t.Errorf("Expected skipped auditors mapping %v, got %v", []string{"audit-policy"}, got)
+				}
+				if len(result.Failed) != 1 {
+					t.Fatalf("Expected 1 failure, got %d", len(result.Failed))
+				}
+				if result.Failed[0].Entity != "user" || result.Failed[0].Name != "alice" {
+					t.Errorf("Expected failed user alice, got %s %s", result.Failed[0].Entity, result.Failed[0].Name)
+				}
+			},
+		},
+		{
+			name:   "complete import",
+			bundle: scenarios.IAMExportBundle(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMImportResponse(madmin.ImportIAMResult{
+					Added: madmin.IAMEntities{Users: []string{"alice"}},
+				})
+			},
+			validateResult: func(t *testing.T, result *ImportIAMResponse) {
+				if !reflect.DeepEqual(result.Added.Users, []string{"alice"}) {
+					t.Errorf("Expected added users %v, got %v", []string{"alice"}, result.Added.Users)
+				}
+				if result.Skipped.Users == nil || result.Failed == nil {
+					t.Error("Expected empty lists instead of nil")
+				}
+			},
+		},
+		{
+			name:            "not a zip archive",
+			bundle:          []byte("users: alice"),
+			setupMock:       func(mock *minio.MockMinIOServer) {},
+			expectedError:   "not a zip archive",
+			expectedInvalid: true,
+		},
+		{
+			name:            "zip archive without IAM assets",
+			bundle:          emptyZip(t),
+			setupMock:       func(mock *minio.MockMinIOServer) {},
+			expectedError:   "no iam-assets entries found",
+			expectedInvalid: true,
+		},
+		{
+			name:   "MinIO server error",
+			bundle: scenarios.IAMExportBundle(),
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIAMError(403, "Access Denied")
+			},
+			expectedError: "failed to import IAM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewImportIAMService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.bundle)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if got := errors.Is(err, ErrInvalidIAMBundle); got != tt.expectedInvalid {
+					t.Errorf("Expected ErrInvalidIAMBundle %v, got %v", tt.expectedInvalid, got)
+				}
+				if tt.expectedInvalid && len(mockServer.Requests("import-iam")) != 0 {
+					t.Error("Expected invalid bundle not to be sent to MinIO")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
+
+func emptyZip(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := zip.NewWriter(&buf).Close(); err != nil {
+		t.Fatalf("Failed to create zip: %v", err)
+	}
+	return buf.Bytes()
+}
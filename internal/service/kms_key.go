@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+var (
+	// ErrInvalidKMSKeyID is returned when a key ID is not accepted by the KMS
+	ErrInvalidKMSKeyID = errors.New("invalid KMS key ID")
+	// ErrKMSKeyNotFound is returned when a key does not exist in the KMS
+	ErrKMSKeyNotFound = errors.New("KMS key not found")
+)
+
+// kmsKeyIDPattern matches the key names accepted by KES
+var kmsKeyIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,79}$`)
+
+// KMSKeyCheck represents whether MinIO can encrypt and decrypt with a KMS key
+type KMSKeyCheck struct {
+	KeyID           string `json:"keyId"`
+	Usable          bool   `json:"usable"`
+	EncryptionError string `json:"encryptionError,omitempty"`
+	DecryptionError string `json:"decryptionError,omitempty"`
+}
+
+// ValidKMSKeyID reports whether keyID is a valid KMS key name
+func ValidKMSKeyID(keyID string) bool {
+	return kmsKeyIDPattern.MatchString(keyID)
+}
+
+// checkKMSKey lets MinIO run an encryption and decryption round-trip with a key
+func checkKMSKey(ctx context.Context, client *madmin.AdminClient, keyID string) (*KMSKeyCheck, error) {
+	status, err := client.GetKeyStatus(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key status: %w", err)
+	}
+
+	return &KMSKeyCheck{
+		KeyID:           keyID,
+		Usable:          status.EncryptionErr == "" && status.DecryptionErr == "",
+		EncryptionError: status.EncryptionErr,
+		DecryptionError: status.DecryptionErr,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+type ListAccessKeyRotationsService struct {
+	cluster   string
+	rotations *store.Rotations
+}
+
+// ListAccessKeyRotationsResponse represents the rotations of a cluster
+type ListAccessKeyRotationsResponse struct {
+	Rotations []store.Rotation `json:"rotations"`
+	Total     int              `json:"total"`
+}
+
+func NewListAccessKeyRotationsService(cluster string, rotations *store.Rotations) *ListAccessKeyRotationsService {
+	return &ListAccessKeyRotationsService{
+		cluster:   cluster,
+		rotations: rotations,
+	}
+}
+
+// Execute returns the rotations of the cluster with the most recent first, state filters them when not empty
+func (s *ListAccessKeyRotationsService) Execute(ctx context.Context, state string) (*ListAccessKeyRotationsResponse, error) {
+	logger := zerolog.Ctx(ctx)
+	logger.Debug().Str("state", state).Msg("Listing access key rotations")
+
+	rotations, err := s.rotations.List()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read rotations")
+		return nil, fmt.Errorf("failed to list rotations: %w", err)
+	}
+
+	response := &ListAccessKeyRotationsResponse{Rotations: []store.Rotation{}}
+	for _, rotation := range rotations {
+		if rotation.Cluster != s.cluster || (state != "" && rotation.State != state) {
+			continue
+		}
+		response.Rotations = append(response.Rotations, rotation)
+	}
+	response.Total = len(response.Rotations)
+
+	return response, nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+func TestListAccessKeyRotationsService_Execute(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		state       string
+		expectedIDs []string
+	}{
+		{
+			name:        "rotations of the cluster",
+			expectedIDs: []string{"completed", "overlap"},
+		},
+		{
+			name:        "filtered by state",
+			state:       store.RotationOverlap,
+			expectedIDs: []string{"overlap"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotations := newTestRotations(t)
+			for i, rotation := range []store.Rotation{
+				{ID: "overlap", Cluster: "default", State: store.RotationOverlap},
+				{ID: "completed", Cluster: "default", State: store.RotationCompleted},
+				{ID: "other-cluster", Cluster: "backup", State: store.RotationOverlap},
+			} {
+				rotation.CreatedAt = created.Add(time.Duration(i) * time.Hour)
+				if err := rotations.Save(rotation); err != nil {
+					t.Fatalf("Failed to seed rotation: %v", err)
+				}
+			}
+
+			service := NewListAccessKeyRotationsService("default", rotations)
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := service.Execute(ctx, tt.state)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result.Total != len(tt.expectedIDs) || len(result.Rotations) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d rotations, got %d", len(tt.expectedIDs), result.Total)
+			}
+			for i, id := range tt.expectedIDs {
+				if result.Rotations[i].ID != id {
+					t.Errorf("Expected rotation %d to be %q, got %q", i, id, result.Rotations[i].ID)
+				}
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
 	"github.com/rs/zerolog"
 )
 
@@ -330,7 +333,7 @@ func TestListAccessKeysService_Execute(t *testing.T) {
 			}
 
 			// Create service
-			service := NewListAccessKeysService(minioClient)
+			service := NewListAccessKeysService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
 
 			// Create context with logger
 			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
@@ -373,7 +376,7 @@ func TestListAccessKeysService_NewListAccessKeysService(t *testing.T) {
 		t.Fatalf("Failed to create MinIO client: %v", err)
 	}
 
-	service := NewListAccessKeysService(minioClient)
+	service := NewListAccessKeysService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
 	if service == nil {
 		t.Fatal("Expected service, got nil")
 	}
@@ -479,7 +482,7 @@ func TestListAccessKeysService_InfoServiceAccountIntegration(t *testing.T) {
 			}
 
 			// Create service
-			service := NewListAccessKeysService(minioClient)
+			service := NewListAccessKeysService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
 
 			// Create context with logger
 			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
@@ -525,3 +528,552 @@ func TestListAccessKeysService_InfoServiceAccountIntegration(t *testing.T) {
 		})
 	}
 }
+
+// TestListAccessKeysService_ExternalProviders tests listing access keys owned by LDAP and OpenID users
+func TestListAccessKeysService_ExternalProviders(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		options        ListAccessKeysOptions
+		expectedError  string
+		validateResult func(t *testing.T, result *ListAccessKeysResponse, mock *minio.MockMinIOServer)
+	}{
+		{
+			name: "all providers",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				users, accessKeys := scenarios.SuccessfulAccessKeys()
+				mock.SetUsersResponse(users)
+				mock.SetAccessKeysBulkResponse(accessKeys)
+				mock.SetLDAPAccessKeysResponse(scenarios.LDAPAccessKeys())
+				mock.SetOpenIDAccessKeysResponse(scenarios.OpenIDAccessKeys())
+			},
+			options: ListAccessKeysOptions{Type: "all"},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse, mock *minio.MockMinIOServer) {
+				providers := map[string]int{}
+				for _, ak := range result.AccessKeys {
+					providers[ak.Provider]++
+				}
+				if providers["builtin"] != 7 || providers["ldap"] != 2 || providers["openid"] != 1 {
+					t.Errorf("Expected 7 builtin, 2 LDAP and 1 OpenID access keys, got %v", providers)
+				}
+
+				requests := mock.Requests("openid-access-keys-bulk")
+				if len(requests) != 1 {
+					t.Fatalf("Expected %d OpenID request, got %d", 1, len(requests))
+				}
+				if requests[0].Get("all") != "true" || requests[0].Get("allConfigs") != "true" {
+					t.Errorf("Expected all users of all configs, got %v", requests[0])
+				}
+			},
+		},
+		{
+			name: "only LDAP",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetUsersError(403, "Access Denied")
+				mock.SetLDAPAccessKeysResponse(scenarios.LDAPAccessKeys())
+			},
+			options: ListAccessKeysOptions{Type: "all", Provider: "ldap"},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Total != 2 {
+					t.Fatalf("Expected Total %d, got %d", 2, result.Total)
+				}
+				for _, ak := range result.AccessKeys {
+					if ak.Provider != "ldap" {
+						t.Errorf("Expected LDAP provider, got %q", ak.Provider)
+					}
+					if ak.ParentUser != "uid=alice,ou=people,dc=example,dc=com" {
+						t.Errorf("Expected alice DN as parent, got %q", ak.ParentUser)
+					}
+				}
+
+				i := slices.IndexFunc(result.AccessKeys, func(ak AccessKeyInfo) bool { return ak.Type == "sts" })
+				if i < 0 {
+					t.Fatal("Expected an STS key")
+				}
+				sts := result.AccessKeys[i]
+				if sts.Expiration == nil || *sts.Expiration != "2025-01-01T01:00:00Z" {
+					t.Errorf("Expected expiring STS key, got %+v", sts)
+				}
+			},
+		},
+		{
+			name: "OpenID user filter",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetOpenIDAccessKeysResponse(scenarios.OpenIDAccessKeys())
+			},
+			options: ListAccessKeysOptions{Type: "serviceAccounts", User: "bob@example.com", Provider: "openid"},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Total != 1 || result.AccessKeys[0].AccessKey != "OIDCBOBSVC0000000001" {
+					t.Errorf("Expected bob's service account, got %+v", result.AccessKeys)
+				}
+
+				requests := mock.Requests("openid-access-keys-bulk")
+				if len(requests) != 1 {
+					t.Fatalf("Expected %d OpenID request, got %d", 1, len(requests))
+				}
+				if requests[0].Get("users") != "bob@example.com" || requests[0].Get("listType") != "svcacc-only" {
+					t.Errorf("Expected filtered service accounts of bob, got %v", requests[0])
+				}
+			},
+		},
+		{
+			name: "users type skips external providers",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				users, accessKeys := scenarios.SuccessfulAccessKeys()
+				mock.SetUsersResponse(users)
+				mock.SetAccessKeysBulkResponse(accessKeys)
+				mock.SetLDAPAccessKeysResponse(scenarios.LDAPAccessKeys())
+			},
+			options: ListAccessKeysOptions{Type: "users"},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if len(mock.Requests("ldap-access-keys-bulk")) != 0 {
+					t.Error("Expected LDAP access keys not to be listed")
+				}
+				if result.Total != 3 {
+					t.Errorf("Expected Total %d, got %d", 3, result.Total)
+				}
+			},
+		},
+		{
+			name: "unconfigured provider is skipped when listing all providers",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				users, accessKeys := scenarios.SuccessfulAccessKeys()
+				mock.SetUsersResponse(users)
+				mock.SetAccessKeysBulkResponse(accessKeys)
+				mock.SetOpenIDAccessKeysResponse(scenarios.OpenIDAccessKeys())
+			},
+			options: ListAccessKeysOptions{Type: "all"},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse, mock *minio.MockMinIOServer) {
+				if result.Total != 8 {
+					t.Errorf("Expected Total %d, got %d", 8, result.Total)
+				}
+			},
+		},
+		{
+			name:          "unconfigured provider fails when requested",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			options:       ListAccessKeysOptions{Type: "all", Provider: "ldap"},
+			expectedError: "failed to list ldap access keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewListAccessKeysService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.options)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result, mockServer)
+			}
+		})
+	}
+}
+
+func TestListAccessKeysService_Query(t *testing.T) {
+	tests := []struct {
+		name           string
+		options        ListAccessKeysOptions
+		expectedError  error
+		validateResult func(t *testing.T, result *ListAccessKeysResponse)
+	}{
+		{
+			name:    "search matches access key, name and description ignoring case",
+			options: ListAccessKeysOptions{Type: "all", Search: "BACKUP"},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse) {
+				if result.Total != 1 || len(result.AccessKeys) != 1 {
+					t.Fatalf("Expected %d access key, got %d", 1, result.Total)
+				}
+				if result.AccessKeys[0].AccessKey != "AKIAI44QH8DHBEXAMPLE" {
+					t.Errorf("Expected access key %q, got %q", "AKIAI44QH8DHBEXAMPLE", result.AccessKeys[0].AccessKey)
+				}
+			},
+		},
+		{
+			name:    "sorted by access key by default",
+			options: ListAccessKeysOptions{Type: "all"},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse) {
+				for i := 1; i < len(result.AccessKeys); i++ {
+					if result.AccessKeys[i-1].AccessKey > result.AccessKeys[i].AccessKey {
+						t.Errorf("Expected %q before %q", result.AccessKeys[i].AccessKey, result.AccessKeys[i-1].AccessKey)
+					}
+				}
+			},
+		},
+		{
+			name:    "sorted by status descending",
+			options: ListAccessKeysOptions{Type: "users", Sort: AccessKeySortStatus, Order: AccessKeyOrderDesc},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse) {
+				expected := []string{"testuser", "minioadmin", "readonly"}
+				if len(result.AccessKeys) != len(expected) {
+					t.Fatalf("Expected %d access keys, got %d", len(expected), len(result.AccessKeys))
+				}
+				for i, accessKey := range expected {
+					if result.AccessKeys[i].AccessKey != accessKey {
+						t.Errorf("Expected access key %d to be %q, got %q", i, accessKey, result.AccessKeys[i].AccessKey)
+					}
+				}
+			},
+		},
+		{
+			name:    "offset skips access keys",
+			options: ListAccessKeysOptions{Type: "all", Offset: 5, Limit: 5},
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse) {
+				if result.Total != 7 {
+					t.Errorf("Expected Total %d, got %d", 7, result.Total)
+				}
+				if len(result.AccessKeys) != 2 {
+					t.Errorf("Expected %d access keys, got %d", 2, len(result.AccessKeys))
+				}
+				if result.NextCursor != "" {
+					t.Errorf("Expected no next cursor on the last page, got %q", result.NextCursor)
+				}
+			},
+		},
+		{
+			name:          "malformed cursor",
+			options:       ListAccessKeysOptions{Type: "all", Cursor: "not-a-cursor"},
+			expectedError: ErrInvalidAccessKeyCursor,
+		},
+		{
+			name:          "cursor of another sort",
+			options:       ListAccessKeysOptions{Type: "all", Sort: AccessKeySortName, Cursor: encodeAccessKeyCursor(AccessKeyInfo{AccessKey: "minioadmin"}, AccessKeySortAccessKey, AccessKeyOrderAsc)},
+			expectedError: ErrInvalidAccessKeyCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			users, accessKeys := scenarios.SuccessfulAccessKeys()
+			mockServer.SetUsersResponse(users)
+			mockServer.SetAccessKeysBulkResponse(accessKeys)
+
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			service := NewListAccessKeysService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := service.Execute(ctx, tt.options)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			tt.validateResult(t, result)
+		})
+	}
+}
+
+func TestListAccessKeysService_CursorPages(t *testing.T) {
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	scenarios := minio.TestScenarios{}
+	users, accessKeys := scenarios.SuccessfulAccessKeys()
+	mockServer.SetUsersResponse(users)
+	mockServer.SetAccessKeysBulkResponse(accessKeys)
+
+	minioClient, err := mockServer.CreateMinIOClient()
+	if err != nil {
+		t.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	service := NewListAccessKeysService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+	// Every access key is listed exactly once while walking the pages by name
+	seen := make(map[string]bool)
+	var names []string
+	opts := ListAccessKeysOptions{Type: "all", Sort: AccessKeySortName, Limit: 3}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("Expected cursor pages to end")
+		}
+
+		result, err := service.Execute(ctx, opts)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Total != 7 {
+			t.Errorf("Expected Total %d, got %d", 7, result.Total)
+		}
+
+		for _, key := range result.AccessKeys {
+			if seen[key.AccessKey] {
+				t.Errorf("Expected access key %q to be listed once", key.AccessKey)
+			}
+			seen[key.AccessKey] = true
+			names = append(names, key.Name)
+		}
+
+		if result.NextCursor == "" {
+			break
+		}
+		opts.Cursor = result.NextCursor
+	}
+
+	if len(seen) != 7 {
+		t.Errorf("Expected %d access keys across pages, got %d", 7, len(seen))
+	}
+	if !slices.IsSorted(names) {
+		t.Errorf("Expected access keys sorted by name across pages, got %v", names)
+	}
+}
+
+func TestListAccessKeysService_ServiceAccountLookups(t *testing.T) {
+	const count = 8
+
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		lookupTimeout  time.Duration
+		execute        func(ctx context.Context, svc *ListAccessKeysService, cache *ServiceAccountCache, client *madmin.AdminClient) *ListAccessKeysResponse
+		expectedLookup int
+		validateResult func(t *testing.T, result *ListAccessKeysResponse)
+	}{
+		{
+			name:           "every service account is looked up once",
+			expectedLookup: count,
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse) {
+				for _, key := range result.AccessKeys {
+					if key.Type == "serviceAccount" && key.Description != "Stored "+key.Name {
+						t.Errorf("Expected detailed info of %q, got %+v", key.AccessKey, key)
+					}
+				}
+			},
+		},
+		{
+			name: "cached details are reused by the next listing",
+			execute: func(ctx context.Context, svc *ListAccessKeysService, _ *ServiceAccountCache, _ *madmin.AdminClient) *ListAccessKeysResponse {
+				if _, err := svc.Execute(ctx, ListAccessKeysOptions{Type: "all"}); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				result, err := svc.Execute(ctx, ListAccessKeysOptions{Type: "all"})
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return result
+			},
+			expectedLookup: count,
+		},
+		{
+			name: "updated service account is looked up again",
+			execute: func(ctx context.Context, svc *ListAccessKeysService, cache *ServiceAccountCache, client *madmin.AdminClient) *ListAccessKeysResponse {
+				if _, err := svc.Execute(ctx, ListAccessKeysOptions{Type: "all"}); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+
+				update := NewUpdateServiceAccountService(client, cache)
+				if _, err := update.Execute(ctx, UpdateServiceAccountRequest{AccessKey: "BULKSVC0000000000000", NewStatus: "disabled"}); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+
+				result, err := svc.Execute(ctx, ListAccessKeysOptions{Type: "all"})
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return result
+			},
+			expectedLookup: count + 1,
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse) {
+				i := slices.IndexFunc(result.AccessKeys, func(key AccessKeyInfo) bool { return key.AccessKey == "BULKSVC0000000000000" })
+				if i < 0 || result.AccessKeys[i].AccountStatus != "disabled" {
+					t.Errorf("Expected updated service account to be disabled, got %+v", result.AccessKeys)
+				}
+			},
+		},
+		{
+			name: "lookups past the deadline keep basic info",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLatency(50 * time.Millisecond)
+			},
+			lookupTimeout: time.Millisecond,
+			validateResult: func(t *testing.T, result *ListAccessKeysResponse) {
+				if result.Total != count+1 {
+					t.Errorf("Expected Total %d, got %d", count+1, result.Total)
+				}
+				for _, key := range result.AccessKeys {
+					if key.Type == "serviceAccount" && key.Description != "" {
+						t.Errorf("Expected basic info of %q, got %+v", key.AccessKey, key)
+					}
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			users, accessKeys := scenarios.ManyServiceAccounts(count)
+			mockServer.SetUsersResponse(users)
+			mockServer.SetAccessKeysBulkResponse(accessKeys)
+			for _, svcAccount := range accessKeys["bulkuser"].ServiceAccounts {
+				mockServer.AddServiceAccountToStore(svcAccount.AccessKey, "", svcAccount.Name, "Stored "+svcAccount.Name, "enabled", "bulkuser", nil, nil)
+			}
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			cache := NewServiceAccountCache(DefaultServiceAccountCacheTTL)
+			service := NewListAccessKeysService(minioClient, cache)
+			if tt.lookupTimeout > 0 {
+				service.lookupTimeout = tt.lookupTimeout
+			}
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			var result *ListAccessKeysResponse
+			if tt.execute != nil {
+				result = tt.execute(ctx, service, cache, minioClient)
+			} else {
+				result, err = service.Execute(ctx, ListAccessKeysOptions{Type: "all"})
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+			}
+
+			if tt.expectedLookup > 0 {
+				if lookups := len(mockServer.Requests("info-service-account")); lookups != tt.expectedLookup {
+					t.Errorf("Expected %d lookups, got %d", tt.expectedLookup, lookups)
+				}
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
+
+func TestListAccessKeysService_InvalidatedDuringLookup(t *testing.T) {
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	mockServer.AddServiceAccountToStore("BULKSVC0000000000000", "", "bulk", "", "enabled", "bulkuser", nil, nil)
+	mockServer.SetLatency(100 * time.Millisecond)
+
+	minioClient, err := mockServer.CreateMinIOClient()
+	if err != nil {
+		t.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	cache := NewServiceAccountCache(DefaultServiceAccountCacheTTL)
+	service := NewListAccessKeysService(minioClient, cache)
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+	done := make(chan AccessKeyInfo)
+	go func() {
+		done <- service.lookupServiceAccount(ctx, madmin.ServiceAccountInfo{AccessKey: "BULKSVC0000000000000", ParentUser: "bulkuser"})
+	}()
+
+	// A change completes while the lookup waits for its response
+	time.Sleep(20 * time.Millisecond)
+	cache.Invalidate("BULKSVC0000000000000")
+
+	if accessKey := <-done; accessKey.Name != "bulk" {
+		t.Errorf("Expected the looked up details to be returned, got %+v", accessKey)
+	}
+	if _, cached := cache.Get("BULKSVC0000000000000"); cached {
+		t.Error("Expected the details looked up before the invalidation not to be cached")
+	}
+}
+
+// BenchmarkListAccessKeysService_ServiceAccountLookups compares the detail lookups against a cluster with a round trip of 20ms.
+// Responses of MinIO are encrypted, the decryption is CPU bound and limits the speedup of the worker pool to the available cores.
+func BenchmarkListAccessKeysService_ServiceAccountLookups(b *testing.B) {
+	const count = 20
+
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	scenarios := minio.TestScenarios{}
+	users, accessKeys := scenarios.ManyServiceAccounts(count)
+	mockServer.SetUsersResponse(users)
+	mockServer.SetAccessKeysBulkResponse(accessKeys)
+	for _, svcAccount := range accessKeys["bulkuser"].ServiceAccounts {
+		mockServer.AddServiceAccountToStore(svcAccount.AccessKey, "", svcAccount.Name, "", "enabled", "bulkuser", nil, nil)
+	}
+	mockServer.SetLatency(20 * time.Millisecond)
+
+	minioClient, err := mockServer.CreateMinIOClient()
+	if err != nil {
+		b.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	ctx := zerolog.Nop().WithContext(context.Background())
+	benchmarks := []struct {
+		name    string
+		workers int
+		ttl     time.Duration
+	}{
+		{name: "sequential", workers: 1},
+		{name: "worker pool", workers: ServiceAccountLookupWorkers},
+		{name: "cached", workers: ServiceAccountLookupWorkers, ttl: time.Hour},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			service := NewListAccessKeysService(minioClient, NewServiceAccountCache(bm.ttl))
+			service.workers = bm.workers
+
+			for b.Loop() {
+				if _, err := service.Execute(ctx, ListAccessKeysOptions{Type: "all"}); err != nil {
+					b.Fatalf("Expected no error, got %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// DefaultConfigHistoryCount is the number of history entries returned when none is given
+const DefaultConfigHistoryCount = 10
+
+type ListConfigHistoryService struct {
+	minioClient *madmin.AdminClient
+}
+
+// ConfigHistoryEntry represents a previous config change which can be restored
+type ConfigHistoryEntry struct {
+	RestoreID  string    `json:"restoreId"`
+	CreateTime time.Time `json:"createTime"`
+	Data       string    `json:"data"` // Config lines of the change with sensitive values masked
+}
+
+// ListConfigHistoryResponse represents the latest config changes, oldest first
+type ListConfigHistoryResponse struct {
+	Entries []ConfigHistoryEntry `json:"entries"`
+	Total   int                  `json:"total"`
+}
+
+func NewListConfigHistoryService(minioClient *madmin.AdminClient) *ListConfigHistoryService {
+	return &ListConfigHistoryService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *ListConfigHistoryService) Execute(ctx context.Context, count int) (*ListConfigHistoryResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if count <= 0 {
+		count = DefaultConfigHistoryCount
+	}
+
+	logger.Debug().Int("count", count).Msg("Fetching MinIO config history")
+
+	entries, err := s.minioClient.ListConfigHistoryKV(ctx, count)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch MinIO config history")
+		return nil, fmt.Errorf("failed to list config history: %w", err)
+	}
+
+	history := make([]ConfigHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		history = append(history, ConfigHistoryEntry{
+			RestoreID:  entry.RestoreID,
+			CreateTime: entry.CreateTime,
+			Data:       maskConfigLines(entry.Data),
+		})
+	}
+
+	logger.Debug().Int("count", len(history)).Msg("Successfully fetched MinIO config history")
+
+	return &ListConfigHistoryResponse{
+		Entries: history,
+		Total:   len(history),
+	}, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestListConfigHistoryService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		count          int
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		expectedCount  string
+		validateResult func(t *testing.T, result *ListConfigHistoryResponse)
+	}{
+		{
+			name:  "history with masked secrets",
+			count: 0,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigHistoryResponse(scenarios.ConfigHistory())
+			},
+			expectedCount: "10",
+			validateResult: func(t *testing.T, result *ListConfigHistoryResponse) {
+				if result.Total != 2 {
+					t.Fatalf("Expected Total %d, got %d", 2, result.Total)
+				}
+				if result.Entries[0].RestoreID != "a1b2c3d4-restore-1" {
+					t.Errorf("Expected RestoreID %q, got %q", "a1b2c3d4-restore-1", result.Entries[0].RestoreID)
+				}
+				data := result.Entries[1].Data
+				if strings.Contains(data, "s3cr3t") {
+					t.Errorf("Expected webhook token to be masked, got %q", data)
+				}
+				if !strings.Contains(data, "auth_token="+MaskedConfigValue) {
+					t.Errorf("Expected masked auth_token, got %q", data)
+				}
+			},
+		},
+		{
+			name:  "custom count",
+			count: 25,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigHistoryResponse(scenarios.ConfigHistory())
+			},
+			expectedCount: "25",
+		},
+		{
+			name:  "MinIO server error",
+			count: 10,
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(403, "Access Denied")
+			},
+			expectedError: "failed to list config history",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewListConfigHistoryService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.count)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if got := mockServer.Requests("list-config-history-kv")[0].Get("count"); got != tt.expectedCount {
+				t.Errorf("Expected count %q, got %q", tt.expectedCount, got)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
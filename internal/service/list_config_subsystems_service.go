@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type ListConfigSubsystemsService struct {
+	minioClient *madmin.AdminClient
+}
+
+// ConfigSubsystem represents a configuration subsystem of the MinIO server, e.g. api or notify_webhook
+type ConfigSubsystem struct {
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	MultipleTargets bool   `json:"multipleTargets"` // Supports named targets, e.g. notify_webhook:primary
+}
+
+// ListConfigSubsystemsResponse represents the config subsystems of the MinIO server
+type ListConfigSubsystemsResponse struct {
+	Subsystems []ConfigSubsystem `json:"subsystems"`
+	Total      int               `json:"total"`
+}
+
+func NewListConfigSubsystemsService(minioClient *madmin.AdminClient) *ListConfigSubsystemsService {
+	return &ListConfigSubsystemsService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *ListConfigSubsystemsService) Execute(ctx context.Context) (*ListConfigSubsystemsResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Msg("Fetching MinIO config subsystems")
+
+	// Help without a subsystem describes every subsystem
+	help, err := s.minioClient.HelpConfigKV(ctx, "", "", false)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch MinIO config subsystems")
+		return nil, fmt.Errorf("failed to list config subsystems: %w", err)
+	}
+
+	subsystems := make([]ConfigSubsystem, 0, len(help.KeysHelp))
+	for _, kv := range help.KeysHelp {
+		subsystems = append(subsystems, ConfigSubsystem{
+			Name:            kv.Key,
+			Description:     kv.Description,
+			MultipleTargets: kv.MultipleTargets,
+		})
+	}
+
+	logger.Debug().Int("count", len(subsystems)).Msg("Successfully fetched MinIO config subsystems")
+
+	return &ListConfigSubsystemsResponse{
+		Subsystems: subsystems,
+		Total:      len(subsystems),
+	}, nil
+}
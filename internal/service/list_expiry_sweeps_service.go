@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+type ListExpirySweepsService struct {
+	sweeps *store.ExpirySweeps
+}
+
+// ListExpirySweepsResponse represents the latest access key expiry sweeps
+type ListExpirySweepsResponse struct {
+	Sweeps []store.ExpirySweep `json:"sweeps"`
+	Total  int                 `json:"total"` // Sweeps stored, including the ones beyond the limit
+}
+
+func NewListExpirySweepsService(sweeps *store.ExpirySweeps) *ListExpirySweepsService {
+	return &ListExpirySweepsService{
+		sweeps: sweeps,
+	}
+}
+
+// Execute returns up to limit sweeps with the most recent first
+func (s *ListExpirySweepsService) Execute(ctx context.Context, limit int) (*ListExpirySweepsResponse, error) {
+	logger := zerolog.Ctx(ctx)
+	logger.Debug().Int("limit", limit).Msg("Listing access key expiry sweeps")
+
+	sweeps, err := s.sweeps.Latest(limit)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read expiry sweeps")
+		return nil, fmt.Errorf("failed to list expiry sweeps: %w", err)
+	}
+
+	total, err := s.sweeps.Count()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to count expiry sweeps")
+		return nil, fmt.Errorf("failed to count expiry sweeps: %w", err)
+	}
+
+	return &ListExpirySweepsResponse{
+		Sweeps: sweeps,
+		Total:  total,
+	}, nil
+}
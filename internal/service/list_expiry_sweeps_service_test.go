@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+func TestListExpirySweepsService_Execute(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		limit          int
+		expectedSweeps int
+	}{
+		{
+			name:           "limited to the latest sweeps",
+			limit:          2,
+			expectedSweeps: 2,
+		},
+		{
+			name:           "limit beyond the stored sweeps",
+			limit:          10,
+			expectedSweeps: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sweeps := newTestExpirySweeps(t)
+			for i := range 3 {
+				if err := sweeps.Save(store.ExpirySweep{Timestamp: start.Add(time.Duration(i) * time.Hour), Expired: i}); err != nil {
+					t.Fatalf("Failed to save sweep: %v", err)
+				}
+			}
+
+			service := NewListExpirySweepsService(sweeps)
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := service.Execute(ctx, tt.limit)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if len(result.Sweeps) != tt.expectedSweeps {
+				t.Fatalf("Expected %d sweeps, got %d", tt.expectedSweeps, len(result.Sweeps))
+			}
+			if result.Total != 3 {
+				t.Errorf("Expected Total %d, got %d", 3, result.Total)
+			}
+			if result.Sweeps[0].Expired != 2 {
+				t.Errorf("Expected the latest sweep first, got %+v", result.Sweeps[0])
+			}
+		})
+	}
+}
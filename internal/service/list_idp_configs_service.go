@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type ListIDPConfigsService struct {
+	minioClient *madmin.AdminClient
+}
+
+// IDPConfigSummary represents a configured identity provider
+type IDPConfigSummary struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	RoleARN string `json:"roleArn,omitempty"` // Only set for OpenID providers using role policies
+}
+
+// ListIDPConfigsResponse represents the configured identity providers
+type ListIDPConfigsResponse struct {
+	Configs []IDPConfigSummary `json:"configs"`
+	Total   int                `json:"total"`
+}
+
+func NewListIDPConfigsService(minioClient *madmin.AdminClient) *ListIDPConfigsService {
+	return &ListIDPConfigsService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute lists the identity provider configs of a type, an empty type lists LDAP and OpenID configs
+func (s *ListIDPConfigsService) Execute(ctx context.Context, idpType string) (*ListIDPConfigsResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	types := []string{madmin.LDAPIDPCfg, madmin.OpenidIDPCfg}
+	if idpType != "" {
+		types = []string{idpType}
+	}
+
+	response := &ListIDPConfigsResponse{
+		Configs: []IDPConfigSummary{},
+	}
+
+	for _, t := range types {
+		logger.Debug().Str("type", t).Msg("Listing MinIO IDP configs")
+
+		items, err := s.minioClient.ListIDPConfig(ctx, t)
+		if err != nil {
+			logger.Error().Err(err).Str("type", t).Msg("Failed to list MinIO IDP configs")
+			return nil, fmt.Errorf("failed to list IDP configs: %w", err)
+		}
+
+		for _, item := range items {
+			response.Configs = append(response.Configs, IDPConfigSummary{
+				Type:    t,
+				Name:    item.Name,
+				Enabled: item.Enabled,
+				RoleARN: item.RoleARN,
+			})
+		}
+	}
+	response.Total = len(response.Configs)
+
+	logger.Info().Int("count", response.Total).Msg("Successfully listed MinIO IDP configs")
+
+	return response, nil
+}
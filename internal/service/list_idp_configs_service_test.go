@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestListIDPConfigsService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		idpType        string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *ListIDPConfigsResponse)
+	}{
+		{
+			name: "all identity providers",
+			validateResult: func(t *testing.T, result *ListIDPConfigsResponse) {
+				if result.Total != 2 {
+					t.Fatalf("Expected %d configs, got %d", 2, result.Total)
+				}
+
+				ldap := result.Configs[0]
+				if ldap.Type != "ldap" || ldap.Name != "_" || !ldap.Enabled {
+					t.Errorf("Expected enabled default LDAP config, got %+v", ldap)
+				}
+
+				openID := result.Configs[1]
+				if openID.Type != "openid" || openID.Name != "keycloak" || openID.Enabled {
+					t.Errorf("Expected disabled keycloak OpenID config, got %+v", openID)
+				}
+				if openID.RoleARN != "arn:minio:iam:::role/dfOSBR8BdCPeCRy1" {
+					t.Errorf("Expected role ARN, got %q", openID.RoleARN)
+				}
+			},
+		},
+		{
+			name:    "only OpenID",
+			idpType: "openid",
+			validateResult: func(t *testing.T, result *ListIDPConfigsResponse) {
+				if result.Total != 1 || result.Configs[0].Type != "openid" {
+					t.Errorf("Expected only the OpenID config, got %+v", result.Configs)
+				}
+			},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(403, "Access Denied")
+			},
+			expectedError: "failed to list IDP configs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetIDPConfigResponse(scenarios.LDAPConfig())
+			mockServer.SetIDPConfigResponse(scenarios.OpenIDConfig())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewListIDPConfigsService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.idpType)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
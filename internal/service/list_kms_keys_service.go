@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// DefaultKMSKeyPattern matches every key
+const DefaultKMSKeyPattern = "*"
+
+type ListKMSKeysService struct {
+	minioClient *madmin.AdminClient
+}
+
+// KMSKey represents a key stored in the KMS
+type KMSKey struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy string    `json:"createdBy"`
+}
+
+// ListKMSKeysResponse represents the keys matching a pattern
+type ListKMSKeysResponse struct {
+	Keys  []KMSKey `json:"keys"`
+	Total int      `json:"total"`
+}
+
+func NewListKMSKeysService(minioClient *madmin.AdminClient) *ListKMSKeysService {
+	return &ListKMSKeysService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute lists the keys matching a glob pattern sorted by name, an empty pattern lists every key
+func (s *ListKMSKeysService) Execute(ctx context.Context, pattern string) (*ListKMSKeysResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if pattern == "" {
+		pattern = DefaultKMSKeyPattern
+	}
+
+	logger.Debug().Str("pattern", pattern).Msg("Listing MinIO KMS keys")
+
+	keys, err := s.minioClient.ListKeys(ctx, pattern)
+	if err != nil {
+		logger.Error().Err(err).Str("pattern", pattern).Msg("Failed to list MinIO KMS keys")
+		return nil, fmt.Errorf("failed to list KMS keys: %w", err)
+	}
+
+	response := &ListKMSKeysResponse{
+		Keys: make([]KMSKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		response.Keys = append(response.Keys, KMSKey{
+			Name:      key.Name,
+			CreatedAt: key.CreatedAt,
+			CreatedBy: key.CreatedBy,
+		})
+	}
+	sort.Slice(response.Keys, func(i, j int) bool {
+		return response.Keys[i].Name < response.Keys[j].Name
+	})
+	response.Total = len(response.Keys)
+
+	logger.Info().Int("count", response.Total).Msg("Successfully listed MinIO KMS keys")
+
+	return response, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestListKMSKeysService_Execute(t *testing.T) {
+	scenarios := minio.TestScenarios{}
+
+	tests := []struct {
+		name          string
+		pattern       string
+		setupMock     func(*minio.MockMinIOServer)
+		expectedError string
+		expectedKeys  []string
+	}{
+		{
+			name: "every key sorted by name",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedKeys: []string{"minio-default-key", "tenant-a-key"},
+		},
+		{
+			name:    "keys matching a pattern",
+			pattern: "tenant-*",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSKeysResponse(scenarios.KMSKeys())
+			},
+			expectedKeys: []string{"tenant-a-key"},
+		},
+		{
+			name:         "no keys",
+			setupMock:    func(mock *minio.MockMinIOServer) {},
+			expectedKeys: []string{},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetKMSError(403, "Access Denied")
+			},
+			expectedError: "failed to list KMS keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewListKMSKeysService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.pattern)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result.Total != len(tt.expectedKeys) {
+				t.Fatalf("Expected Total %d, got %d", len(tt.expectedKeys), result.Total)
+			}
+			for i, name := range tt.expectedKeys {
+				if result.Keys[i].Name != name {
+					t.Errorf("Expected key %d to be %q, got %q", i, name, result.Keys[i].Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type ListLDAPPolicyMappingsService struct {
+	minioClient *madmin.AdminClient
+}
+
+// LDAPPolicyMappingsQuery filters the policy mappings, empty filters return every mapping
+type LDAPPolicyMappingsQuery struct {
+	Users    []string
+	Groups   []string
+	Policies []string
+}
+
+// LDAPGroupMapping represents the policies mapped to an LDAP group DN
+type LDAPGroupMapping struct {
+	Group    string   `json:"group"`
+	Policies []string `json:"policies"`
+}
+
+// LDAPUserMapping represents the policies mapped to an LDAP user DN, directly and through its groups
+type LDAPUserMapping struct {
+	User     string             `json:"user"`
+	Policies []string           `json:"policies"`
+	Groups   []LDAPGroupMapping `json:"groups"`
+}
+
+// LDAPPolicyMapping represents the LDAP users and groups a policy is mapped to
+type LDAPPolicyMapping struct {
+	Policy string   `json:"policy"`
+	Users  []string `json:"users"`
+	Groups []string `json:"groups"`
+}
+
+// LDAPPolicyMappingsResponse represents the policy mappings of LDAP users and groups
+type LDAPPolicyMappingsResponse struct {
+	Users    []LDAPUserMapping   `json:"users"`
+	Groups   []LDAPGroupMapping  `json:"groups"`
+	Policies []LDAPPolicyMapping `json:"policies"`
+}
+
+func NewListLDAPPolicyMappingsService(minioClient *madmin.AdminClient) *ListLDAPPolicyMappingsService {
+	return &ListLDAPPolicyMappingsService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *ListLDAPPolicyMappingsService) Execute(ctx context.Context, query LDAPPolicyMappingsQuery) (*LDAPPolicyMappingsResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().
+		Strs("users", query.Users).
+		Strs("groups", query.Groups).
+		Strs("policies", query.Policies).
+		Msg("Listing MinIO LDAP policy mappings")
+
+	result, err := s.minioClient.GetLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{
+		Users:  query.Users,
+		Groups: query.Groups,
+		Policy: query.Policies,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list MinIO LDAP policy mappings")
+		return nil, fmt.Errorf("failed to list LDAP policy mappings: %w", err)
+	}
+
+	response := &LDAPPolicyMappingsResponse{
+		Users:    make([]LDAPUserMapping, 0, len(result.UserMappings)),
+		Groups:   newLDAPGroupMappings(result.GroupMappings),
+		Policies: make([]LDAPPolicyMapping, 0, len(result.PolicyMappings)),
+	}
+	for _, mapping := range result.UserMappings {
+		response.Users = append(response.Users, newLDAPUserMapping(mapping))
+	}
+	for _, mapping := range result.PolicyMappings {
+		response.Policies = append(response.Policies, LDAPPolicyMapping{
+			Policy: mapping.Policy,
+			Users:  nonNilStrings(mapping.Users),
+			Groups: nonNilStrings(mapping.Groups),
+		})
+	}
+
+	logger.Info().
+		Int("users", len(response.Users)).
+		Int("groups", len(response.Groups)).
+		Int("policies", len(response.Policies)).
+		Msg("Successfully listed MinIO LDAP policy mappings")
+
+	return response, nil
+}
+
+func newLDAPUserMapping(mapping madmin.UserPolicyEntities) LDAPUserMapping {
+	return LDAPUserMapping{
+		User:     mapping.User,
+		Policies: nonNilStrings(mapping.Policies),
+		Groups:   newLDAPGroupMappings(mapping.MemberOfMappings),
+	}
+}
+
+func newLDAPGroupMappings(mappings []madmin.GroupPolicyEntities) []LDAPGroupMapping {
+	groups := make([]LDAPGroupMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		groups = append(groups, LDAPGroupMapping{
+			Group:    mapping.Group,
+			Policies: nonNilStrings(mapping.Policies),
+		})
+	}
+	return groups
+}
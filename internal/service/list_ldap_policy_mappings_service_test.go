@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestListLDAPPolicyMappingsService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          LDAPPolicyMappingsQuery
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *LDAPPolicyMappingsResponse, mock *minio.MockMinIOServer)
+	}{
+		{
+			name: "policy mappings of users and groups",
+			query: LDAPPolicyMappingsQuery{
+				Users:    []string{"alice"},
+				Policies: []string{"readwrite", "consoleAdmin"},
+			},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPPolicyEntitiesResponse(minio.TestScenarios{}.LDAPPolicyEntities())
+			},
+			validateResult: func(t *testing.T, result *LDAPPolicyMappingsResponse, mock *minio.MockMinIOServer) {
+				requests := mock.Requests("ldap-policy-entities")
+				if len(requests) != 1 {
+					t.Fatalf("Expected %d policy entities request, got %d", 1, len(requests))
+				}
+				if got := requests[0]["policy"]; !slices.Equal(got, []string{"readwrite", "consoleAdmin"}) {
+					t.Errorf("Expected policy filter to be sent, got %v", got)
+				}
+				if got := requests[0].Get("user"); got != "alice" {
+					t.Errorf("Expected user filter alice, got %q", got)
+				}
+
+				if len(result.Users) != 1 || len(result.Users[0].Groups) != 1 {
+					t.Fatalf("Expected one user with one group, got %+v", result.Users)
+				}
+				if result.Users[0].Groups[0].Policies[0] != "consoleAdmin" {
+					t.Errorf("Expected group policy consoleAdmin, got %v", result.Users[0].Groups[0].Policies)
+				}
+				if len(result.Policies) != 2 || result.Policies[0].Users == nil {
+					t.Errorf("Expected two policies with non-nil users, got %+v", result.Policies)
+				}
+			},
+		},
+		{
+			name: "no mappings",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPPolicyEntitiesResponse(madmin.PolicyEntitiesResult{})
+			},
+			validateResult: func(t *testing.T, result *LDAPPolicyMappingsResponse, mock *minio.MockMinIOServer) {
+				if result.Users == nil || result.Groups == nil || result.Policies == nil {
+					t.Errorf("Expected empty lists instead of nil, got %+v", result)
+				}
+			},
+		},
+		{
+			name: "LDAP not configured",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(400, "LDAP is not enabled")
+			},
+			expectedError: "failed to list LDAP policy mappings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewListLDAPPolicyMappingsService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.query)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result, mockServer)
+			}
+		})
+	}
+}
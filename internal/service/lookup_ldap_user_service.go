@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// ErrLDAPUserNotFound is returned when the LDAP lookup bind finds no user for the name
+var ErrLDAPUserNotFound = errors.New("LDAP user not found")
+
+type LookupLDAPUserService struct {
+	minioClient *madmin.AdminClient
+}
+
+// LDAPUserLookupResponse represents an LDAP username resolved to its DN
+type LDAPUserLookupResponse struct {
+	User string `json:"user"` // The user as given in the lookup
+	DN   string `json:"dn"`
+}
+
+func NewLookupLDAPUserService(minioClient *madmin.AdminClient) *LookupLDAPUserService {
+	return &LookupLDAPUserService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute resolves an LDAP username or DN to its DN. MinIO searches the LDAP server with the lookup bind
+// when listing the access keys of a user, and keys the result by the DN it found.
+func (s *LookupLDAPUserService) Execute(ctx context.Context, user string) (*LDAPUserLookupResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Str("user", user).Msg("Looking up MinIO LDAP user")
+
+	result, err := s.minioClient.ListAccessKeysLDAPBulk(ctx, []string{user}, madmin.AccessKeyListAll, false)
+	if err != nil {
+		logger.Error().Err(err).Str("user", user).Msg("Failed to look up MinIO LDAP user")
+		return nil, fmt.Errorf("failed to look up LDAP user: %w", err)
+	}
+
+	// Users which are not found are left out of the response
+	response := &LDAPUserLookupResponse{User: user}
+	for dn := range result {
+		response.DN = dn
+		break
+	}
+	if response.DN == "" {
+		return nil, fmt.Errorf("%w: %s", ErrLDAPUserNotFound, user)
+	}
+
+	logger.Info().
+		Str("user", user).
+		Str("dn", response.DN).
+		Msg("Successfully looked up MinIO LDAP user")
+
+	return response, nil
+}
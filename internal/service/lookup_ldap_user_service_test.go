@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestLookupLDAPUserService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  error
+		expectedErrMsg string
+		validateResult func(t *testing.T, result *LDAPUserLookupResponse)
+	}{
+		{
+			name: "user resolved to DN",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPAccessKeysResponse(minio.TestScenarios{}.LDAPAccessKeys())
+			},
+			validateResult: func(t *testing.T, result *LDAPUserLookupResponse) {
+				if result.DN != "uid=alice,ou=people,dc=example,dc=com" {
+					t.Errorf("Expected alice to resolve to her DN, got %q", result.DN)
+				}
+				if result.User != "alice" {
+					t.Errorf("Expected looked up user alice, got %q", result.User)
+				}
+			},
+		},
+		{
+			name: "user resolved without policy mapping",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPAccessKeysResponse(map[string]madmin.ListAccessKeysLDAPResp{
+					"uid=alice,ou=people,dc=example,dc=com": {},
+				})
+				mock.SetLDAPPolicyEntitiesResponse(madmin.PolicyEntitiesResult{})
+			},
+			validateResult: func(t *testing.T, result *LDAPUserLookupResponse) {
+				if result.DN != "uid=alice,ou=people,dc=example,dc=com" {
+					t.Errorf("Expected alice to resolve to her DN, got %q", result.DN)
+				}
+			},
+		},
+		{
+			name: "user not found in LDAP",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPAccessKeysResponse(map[string]madmin.ListAccessKeysLDAPResp{
+					"uid=bob,ou=people,dc=example,dc=com": {},
+				})
+			},
+			expectedError: ErrLDAPUserNotFound,
+		},
+		{
+			name: "LDAP is not enabled",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetExternalAccessKeysError("ldap", 400, "LDAP is not enabled")
+			},
+			expectedErrMsg: "failed to look up LDAP user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewLookupLDAPUserService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, "alice")
+
+			// Validate results
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if tt.expectedErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErrMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedErrMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
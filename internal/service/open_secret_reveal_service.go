@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/rs/zerolog"
+)
+
+var (
+	ErrRevealNotFound   = errors.New("reveal link does not exist, expired or was already used")
+	ErrInvalidRevealKey = errors.New("invalid reveal key")
+)
+
+// OpenSecretRevealService decrypts the secret of a reveal link and burns it
+type OpenSecretRevealService struct {
+	reveals *store.Reveals
+	now     func() time.Time
+}
+
+// OpenSecretRevealResponse represents the secret of a reveal link
+type OpenSecretRevealResponse struct {
+	Secret string `json:"secret"`
+}
+
+func NewOpenSecretRevealService(reveals *store.Reveals) *OpenSecretRevealService {
+	return &OpenSecretRevealService{
+		reveals: reveals,
+		now:     time.Now,
+	}
+}
+
+// Execute burns the reveal on the first attempt, even when the key is wrong, so a link cannot be guessed at repeatedly
+func (s *OpenSecretRevealService) Execute(ctx context.Context, id string, key string) (*OpenSecretRevealResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	reveal, err := s.reveals.Take(id)
+	if err != nil {
+		logger.Error().Err(err).Str("reveal", id).Msg("Failed to read reveal")
+		return nil, fmt.Errorf("failed to read reveal: %w", err)
+	}
+	if reveal == nil || !s.now().Before(reveal.ExpiresAt) {
+		return nil, ErrRevealNotFound
+	}
+
+	rawKey, err := base64.RawURLEncoding.DecodeString(key)
+	if err != nil {
+		return nil, ErrInvalidRevealKey
+	}
+
+	secret, err := openSecret(rawKey, id, reveal.Ciphertext)
+	if err != nil {
+		logger.Warn().Err(err).Str("reveal", id).Msg("Failed to decrypt reveal")
+		return nil, ErrInvalidRevealKey
+	}
+
+	logger.Info().Str("reveal", id).Msg("Reveal link opened")
+
+	return &OpenSecretRevealResponse{Secret: secret}, nil
+}
+
+// openSecret decrypts a secret sealed by sealSecret
+func openSecret(key []byte, id string, ciphertext []byte) (string, error) {
+	gcm, err := newRevealCipher(key)
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return "", errors.New("ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, []byte(id))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+
+	return string(plaintext), nil
+}
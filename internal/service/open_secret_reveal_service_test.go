@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestOpenSecretRevealService_Execute(t *testing.T) {
+	const secret = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+
+	tests := []struct {
+		name          string
+		modifyID      func(string) string
+		modifyKey     func(string) string
+		openAfter     time.Duration
+		openTwice     bool
+		expectedError error
+	}{
+		{
+			name: "valid link",
+		},
+		{
+			name:          "link used twice",
+			openTwice:     true,
+			expectedError: ErrRevealNotFound,
+		},
+		{
+			name:          "expired link",
+			openAfter:     2 * time.Hour,
+			expectedError: ErrRevealNotFound,
+		},
+		{
+			name:          "unknown link",
+			modifyID:      func(string) string { return "unknown" },
+			expectedError: ErrRevealNotFound,
+		},
+		{
+			name:          "wrong key",
+			modifyKey:     func(string) string { return strings.Repeat("A", 43) },
+			expectedError: ErrInvalidRevealKey,
+		},
+		{
+			name:          "malformed key",
+			modifyKey:     func(string) string { return "not base64!" },
+			expectedError: ErrInvalidRevealKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reveals := newTestReveals(t)
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			createService := NewCreateSecretRevealService(reveals, time.Hour, "")
+			createService.now = func() time.Time { return now }
+			link, err := createService.Execute(ctx, secret)
+			if err != nil {
+				t.Fatalf("Failed to create reveal link: %v", err)
+			}
+
+			id, key, _ := strings.Cut(strings.TrimPrefix(link.URL, "/reveal/"), "#")
+			if tt.modifyID != nil {
+				id = tt.modifyID(id)
+			}
+			if tt.modifyKey != nil {
+				key = tt.modifyKey(key)
+			}
+
+			service := NewOpenSecretRevealService(reveals)
+			service.now = func() time.Time { return now.Add(tt.openAfter) }
+
+			if tt.openTwice {
+				if _, err := service.Execute(ctx, id, key); err != nil {
+					t.Fatalf("Expected first open to succeed, got %v", err)
+				}
+			}
+
+			result, err := service.Execute(ctx, id, key)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result.Secret != secret {
+				t.Errorf("Expected secret %q, got %q", secret, result.Secret)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// Cluster actions which restart or stop every MinIO server
+const (
+	ClusterActionRestart = "restart"
+	ClusterActionStop    = "stop"
+	ClusterActionUpdate  = "update"
+)
+
+type PrepareClusterActionService struct {
+	minioClient *madmin.AdminClient
+	tokens      *ConfirmationTokens
+}
+
+// ClusterActionRequest represents a restart, stop or update of the cluster.
+// UpdateURL optionally points to the binary to update to, the latest release is used when empty.
+type ClusterActionRequest struct {
+	Action            string `json:"-"`
+	UpdateURL         string `json:"updateUrl"`
+	ConfirmationToken string `json:"confirmationToken"`
+}
+
+// ClusterActionPeer represents the outcome of a cluster action on one server
+type ClusterActionPeer struct {
+	Host           string `json:"host"`
+	Error          string `json:"error,omitempty"`
+	CurrentVersion string `json:"currentVersion,omitempty"` // Only reported for updates
+	TargetVersion  string `json:"targetVersion,omitempty"`  // Only reported for updates
+}
+
+// ClusterActionConfirmation represents the dry-run of a cluster action and the token required to run it
+type ClusterActionConfirmation struct {
+	Action            string              `json:"action"`
+	UpdateURL         string              `json:"updateUrl,omitempty"`
+	ConfirmationToken string              `json:"confirmationToken"`
+	ExpiresAt         time.Time           `json:"expiresAt"`
+	Peers             []ClusterActionPeer `json:"peers"`
+}
+
+func NewPrepareClusterActionService(minioClient *madmin.AdminClient, tokens *ConfirmationTokens) *PrepareClusterActionService {
+	return &PrepareClusterActionService{
+		minioClient: minioClient,
+		tokens:      tokens,
+	}
+}
+
+// ValidClusterAction reports whether action is a supported cluster action
+func ValidClusterAction(action string) bool {
+	switch action {
+	case ClusterActionRestart, ClusterActionStop, ClusterActionUpdate:
+		return true
+	}
+	return false
+}
+
+func (s *PrepareClusterActionService) Execute(ctx context.Context, req ClusterActionRequest) (*ClusterActionConfirmation, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !ValidClusterAction(req.Action) {
+		return nil, fmt.Errorf("invalid cluster action: %s", req.Action)
+	}
+
+	logger.Debug().Str("action", req.Action).Msg("Dry-running MinIO cluster action")
+
+	peers, err := runClusterAction(ctx, s.minioClient, req, true)
+	if err != nil {
+		logger.Error().Err(err).Str("action", req.Action).Msg("Failed to dry-run MinIO cluster action")
+		return nil, fmt.Errorf("failed to dry-run cluster %s: %w", req.Action, err)
+	}
+
+	token, expiresAt, err := s.tokens.Issue(clusterActionSubject(req))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().
+		Str("action", req.Action).
+		Time("expiresAt", expiresAt).
+		Msg("Issued confirmation token for MinIO cluster action")
+
+	return &ClusterActionConfirmation{
+		Action:            req.Action,
+		UpdateURL:         req.UpdateURL,
+		ConfirmationToken: token,
+		ExpiresAt:         expiresAt,
+		Peers:             peers,
+	}, nil
+}
+
+// clusterActionSubject binds a confirmation token to the action and, for updates, the binary URL
+func clusterActionSubject(req ClusterActionRequest) string {
+	if req.Action == ClusterActionUpdate {
+		return "cluster:" + req.Action + ":" + req.UpdateURL
+	}
+	return "cluster:" + req.Action
+}
+
+// runClusterAction sends the cluster action to MinIO and returns the result of every server
+func runClusterAction(ctx context.Context, minioClient *madmin.AdminClient, req ClusterActionRequest, dryRun bool) ([]ClusterActionPeer, error) {
+	peers := []ClusterActionPeer{}
+
+	if req.Action == ClusterActionUpdate {
+		status, err := minioClient.ServerUpdate(ctx, madmin.ServerUpdateOpts{
+			UpdateURL: req.UpdateURL,
+			DryRun:    dryRun,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range status.Results {
+			peers = append(peers, ClusterActionPeer{
+				Host:           result.Host,
+				Error:          result.Err,
+				CurrentVersion: result.CurrentVersion,
+				TargetVersion:  result.UpdatedVersion,
+			})
+		}
+		return peers, nil
+	}
+
+	action := madmin.ServiceActionRestart
+	if req.Action == ClusterActionStop {
+		action = madmin.ServiceActionStop
+	}
+
+	result, err := minioClient.ServiceAction(ctx, madmin.ServiceActionOpts{
+		Action: action,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, peer := range result.Results {
+		peers = append(peers, ClusterActionPeer{
+			Host:  peer.Host,
+			Error: peer.Err,
+		})
+	}
+	return peers, nil
+}
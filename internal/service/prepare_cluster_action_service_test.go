@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestPrepareClusterActionService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        ClusterActionRequest
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *ClusterActionConfirmation, mock *minio.MockMinIOServer)
+	}{
+		{
+			name:    "restart dry-run lists the servers",
+			request: ClusterActionRequest{Action: ClusterActionRestart},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServiceActionResponse(scenarios.SuccessfulServiceAction())
+			},
+			validateResult: func(t *testing.T, result *ClusterActionConfirmation, mock *minio.MockMinIOServer) {
+				if result.ConfirmationToken == "" {
+					t.Error("Expected confirmation token to be issued")
+				}
+				if len(result.Peers) != 2 {
+					t.Errorf("Expected %d peers, got %d", 2, len(result.Peers))
+				}
+
+				requests := mock.Requests("service")
+				if len(requests) != 1 || requests[0].Get("dry-run") != "true" {
+					t.Errorf("Expected one dry-run request, got %v", requests)
+				}
+				if mock.RestartRequested() {
+					t.Error("Expected no restart to be requested")
+				}
+			},
+		},
+		{
+			name:    "update dry-run shows target version",
+			request: ClusterActionRequest{Action: ClusterActionUpdate},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerUpdateResponse(scenarios.SuccessfulServerUpdate())
+			},
+			validateResult: func(t *testing.T, result *ClusterActionConfirmation, mock *minio.MockMinIOServer) {
+				if len(result.Peers) != 2 {
+					t.Fatalf("Expected %d peers, got %d", 2, len(result.Peers))
+				}
+				peer := result.Peers[0]
+				if peer.CurrentVersion != "RELEASE.2025-01-20T14-49-07Z" {
+					t.Errorf("Expected CurrentVersion %q, got %q", "RELEASE.2025-01-20T14-49-07Z", peer.CurrentVersion)
+				}
+				if peer.TargetVersion != "RELEASE.2025-04-22T22-12-26Z" {
+					t.Errorf("Expected TargetVersion %q, got %q", "RELEASE.2025-04-22T22-12-26Z", peer.TargetVersion)
+				}
+				if mock.UpdateRequested() {
+					t.Error("Expected no update to be requested")
+				}
+			},
+		},
+		{
+			name:          "invalid action",
+			request:       ClusterActionRequest{Action: "freeze"},
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			expectedError: "invalid cluster action",
+		},
+		{
+			name:    "MinIO server error",
+			request: ClusterActionRequest{Action: ClusterActionStop},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceActionError(403, "Access Denied")
+			},
+			expectedError: "failed to dry-run cluster stop",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewPrepareClusterActionService(minioClient, NewConfirmationTokens(time.Minute))
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result, mockServer)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// MaxConfigSize is the largest config accepted by MinIO
+const MaxConfigSize = 256 * 1024
+
+// Kinds of config changes of an import
+const (
+	ConfigChangeAdded   = "added"
+	ConfigChangeRemoved = "removed" // Reset to the default value
+	ConfigChangeChanged = "changed"
+)
+
+type PrepareConfigImportService struct {
+	minioClient *madmin.AdminClient
+	tokens      *ConfirmationTokens
+}
+
+// ConfigDiffEntry represents a config key changed by an import, sensitive values are masked
+type ConfigDiffEntry struct {
+	Target   string `json:"target"` // Subsystem with the target suffix, e.g. notify_webhook:primary
+	Key      string `json:"key"`
+	Change   string `json:"change"`
+	Current  string `json:"current,omitempty"`
+	Imported string `json:"imported,omitempty"`
+}
+
+// ConfigImportPreview represents the changes of an import and the token required to apply it
+type ConfigImportPreview struct {
+	ConfirmationToken string            `json:"confirmationToken"`
+	ExpiresAt         time.Time         `json:"expiresAt"`
+	Changes           []ConfigDiffEntry `json:"changes"`
+	Total             int               `json:"total"`
+}
+
+// configImport is an import with masked values resolved against the current config
+type configImport struct {
+	config  string // Config lines sent to MinIO
+	current []byte // Current config the changes are compared with
+	changes []ConfigDiffEntry
+}
+
+func NewPrepareConfigImportService(minioClient *madmin.AdminClient, tokens *ConfirmationTokens) *PrepareConfigImportService {
+	return &PrepareConfigImportService{
+		minioClient: minioClient,
+		tokens:      tokens,
+	}
+}
+
+func (s *PrepareConfigImportService) Execute(ctx context.Context, imported []byte) (*ConfigImportPreview, error) {
+	logger := zerolog.Ctx(ctx)
+
+	resolved, err := resolveConfigImport(ctx, s.minioClient, imported)
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := s.tokens.Issue(configImportSubject(resolved))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().
+		Int("changes", len(resolved.changes)).
+		Time("expiresAt", expiresAt).
+		Msg("Issued confirmation token for MinIO config import")
+
+	return &ConfigImportPreview{
+		ConfirmationToken: token,
+		ExpiresAt:         expiresAt,
+		Changes:           resolved.changes,
+		Total:             len(resolved.changes),
+	}, nil
+}
+
+// configImportSubject binds a confirmation token to the exact config which will be applied
+// and the current config it replaces, so that the previewed changes are the applied ones
+func configImportSubject(resolved *configImport) string {
+	config := sha256.Sum256([]byte(resolved.config))
+	current := sha256.Sum256(resolved.current)
+	return "config:import:" + hex.EncodeToString(config[:]) + ":" + hex.EncodeToString(current[:])
+}
+
+// resolveConfigImport validates the imported config, replaces masked values with the current ones
+// and compares it with the current config
+func resolveConfigImport(ctx context.Context, minioClient *madmin.AdminClient, imported []byte) (*configImport, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if len(imported) > MaxConfigSize {
+		return nil, fmt.Errorf("%w: config exceeds %d bytes", ErrInvalidConfig, MaxConfigSize)
+	}
+
+	importedConfigs, err := madmin.ParseServerConfigOutput(string(imported))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidConfig, err.Error())
+	}
+	if len(importedConfigs) == 0 {
+		return nil, fmt.Errorf("%w: config is empty", ErrInvalidConfig)
+	}
+
+	raw, err := minioClient.GetConfig(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch current MinIO server config")
+		return nil, fmt.Errorf("failed to get current config: %w", err)
+	}
+
+	currentConfigs, err := madmin.ParseServerConfigOutput(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse current config: %w", err)
+	}
+
+	current := configValuesByTarget(currentConfigs)
+	seen := make(map[string]bool, len(importedConfigs))
+
+	resolved := &configImport{current: raw, changes: []ConfigDiffEntry{}}
+	lines := make([]string, 0, len(importedConfigs))
+
+	for _, config := range importedConfigs {
+		if !ValidConfigSubsystem(config.SubSystem) {
+			return nil, fmt.Errorf("%w: unknown subsystem %s", ErrInvalidConfig, config.SubSystem)
+		}
+		if err := validateConfigTarget(config.Target); err != nil {
+			return nil, err
+		}
+
+		name := configTargetName(config.SubSystem, config.Target)
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s is defined more than once", ErrInvalidConfig, name)
+		}
+		seen[name] = true
+
+		currentValues := current[name]
+		pairs := make([]string, 0, len(config.KV))
+		importedKeys := make(map[string]bool, len(config.KV))
+
+		for _, kv := range config.KV {
+			// Environment overrides are reported as comments and are not part of the config
+			if kv.EnvOverride != nil && kv.Value == "" {
+				continue
+			}
+			importedKeys[kv.Key] = true
+
+			value := kv.Value
+			currentValue, exists := currentValues[kv.Key]
+			if value == MaskedConfigValue {
+				if !exists || currentValue == "" {
+					return nil, fmt.Errorf("%w: masked value of %s %s has no current value", ErrInvalidConfig, name, kv.Key)
+				}
+				value = currentValue
+			}
+			pairs = append(pairs, fmt.Sprintf(`%s="%s"`, kv.Key, value))
+
+			switch {
+			case !exists:
+				resolved.changes = append(resolved.changes, ConfigDiffEntry{
+					Target:   name,
+					Key:      kv.Key,
+					Change:   ConfigChangeAdded,
+					Imported: maskConfigValue(kv.Key, value),
+				})
+			case currentValue != value:
+				resolved.changes = append(resolved.changes, ConfigDiffEntry{
+					Target:   name,
+					Key:      kv.Key,
+					Change:   ConfigChangeChanged,
+					Current:  maskConfigValue(kv.Key, currentValue),
+					Imported: maskConfigValue(kv.Key, value),
+				})
+			}
+		}
+
+		for _, key := range sortedKeys(currentValues) {
+			if !importedKeys[key] && currentValues[key] != "" {
+				resolved.changes = append(resolved.changes, ConfigDiffEntry{
+					Target:  name,
+					Key:     key,
+					Change:  ConfigChangeRemoved,
+					Current: maskConfigValue(key, currentValues[key]),
+				})
+			}
+		}
+
+		lines = append(lines, strings.TrimSpace(name+" "+strings.Join(pairs, " ")))
+	}
+
+	// Targets missing from the import are reset to their defaults
+	for _, name := range sortedKeys(current) {
+		if seen[name] {
+			continue
+		}
+		for _, key := range sortedKeys(current[name]) {
+			if current[name][key] == "" {
+				continue
+			}
+			resolved.changes = append(resolved.changes, ConfigDiffEntry{
+				Target:  name,
+				Key:     key,
+				Change:  ConfigChangeRemoved,
+				Current: maskConfigValue(key, current[name][key]),
+			})
+		}
+	}
+
+	resolved.config = strings.Join(lines, "\n") + "\n"
+
+	return resolved, nil
+}
+
+// configValuesByTarget indexes config values by subsystem target name and key
+func configValuesByTarget(configs []madmin.SubsysConfig) map[string]map[string]string {
+	values := make(map[string]map[string]string, len(configs))
+	for _, config := range configs {
+		name := configTargetName(config.SubSystem, config.Target)
+		if values[name] == nil {
+			values[name] = make(map[string]string, len(config.KV))
+		}
+		for _, kv := range config.KV {
+			values[name][kv.Key] = kv.Value
+		}
+	}
+	return values
+}
+
+// sortedKeys returns the keys of a map in stable order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
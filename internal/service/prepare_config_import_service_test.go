@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestPrepareConfigImportService_Execute(t *testing.T) {
+	tests := []struct {
+		name            string
+		imported        string
+		setupMock       func(*minio.MockMinIOServer)
+		expectedError   string
+		invalid         bool // Expect ErrInvalidConfig
+		expectedChanges []ConfigDiffEntry
+	}{
+		{
+			name: "diff against current config",
+			imported: `api requests_max=2000 requests_deadline=10s
+scanner speed=slow
+notify_webhook:primary endpoint=https://hooks.example.com/v2 auth_token=********
+compression enable=on
+`,
+			expectedChanges: []ConfigDiffEntry{
+				{Target: "api", Key: "requests_max", Change: ConfigChangeChanged, Current: "1000", Imported: "2000"},
+				{Target: "scanner", Key: "speed", Change: ConfigChangeChanged, Current: "default", Imported: "slow"},
+				{Target: "notify_webhook:primary", Key: "endpoint", Change: ConfigChangeChanged, Current: "https://hooks.example.com/minio", Imported: "https://hooks.example.com/v2"},
+				{Target: "notify_webhook:primary", Key: "queue_limit", Change: ConfigChangeRemoved, Current: "10000"},
+				{Target: "compression", Key: "enable", Change: ConfigChangeAdded, Imported: "on"},
+				{Target: "region", Key: "name", Change: ConfigChangeRemoved, Current: "us-east-1"},
+			},
+		},
+		{
+			name:            "export without changes",
+			imported:        minio.TestScenarios{}.ServerConfig(),
+			expectedChanges: []ConfigDiffEntry{},
+		},
+		{
+			name:     "changed secret is masked in the diff",
+			imported: "notify_webhook:primary endpoint=https://hooks.example.com/minio auth_token=n3w-t0ken queue_limit=10000",
+			expectedChanges: []ConfigDiffEntry{
+				{Target: "notify_webhook:primary", Key: "auth_token", Change: ConfigChangeChanged, Current: MaskedConfigValue, Imported: MaskedConfigValue},
+				{Target: "api", Key: "requests_deadline", Change: ConfigChangeRemoved, Current: "10s"},
+				{Target: "api", Key: "requests_max", Change: ConfigChangeRemoved, Current: "1000"},
+				{Target: "region", Key: "name", Change: ConfigChangeRemoved, Current: "us-east-1"},
+				{Target: "scanner", Key: "speed", Change: ConfigChangeRemoved, Current: "default"},
+			},
+		},
+		{
+			name:          "unknown subsystem",
+			imported:      "notify_pigeon enable=on",
+			invalid:       true,
+			expectedError: "unknown subsystem notify_pigeon",
+		},
+		{
+			name:          "malformed config line",
+			imported:      "api requests_max",
+			invalid:       true,
+			expectedError: "invalid config",
+		},
+		{
+			name:          "masked value without current value",
+			imported:      "notify_webhook:secondary auth_token=********",
+			invalid:       true,
+			expectedError: "masked value of notify_webhook:secondary auth_token has no current value",
+		},
+		{
+			name:          "empty config",
+			imported:      "# comment only\n",
+			invalid:       true,
+			expectedError: "config is empty",
+		},
+		{
+			name:     "MinIO server error",
+			imported: "api requests_max=2000",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(403, "Access Denied")
+			},
+			expectedError: "failed to get current config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetServerConfigResponse(scenarios.ServerConfig())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewPrepareConfigImportService(minioClient, NewConfirmationTokens(time.Minute))
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, []byte(tt.imported))
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if errors.Is(err, ErrInvalidConfig) != tt.invalid {
+					t.Errorf("Expected ErrInvalidConfig to be %v, got %v", tt.invalid, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result.ConfirmationToken == "" {
+				t.Error("Expected confirmation token to be issued")
+			}
+			if result.Total != len(tt.expectedChanges) {
+				t.Fatalf("Expected %d changes, got %d: %+v", len(tt.expectedChanges), result.Total, result.Changes)
+			}
+			for i, expected := range tt.expectedChanges {
+				if result.Changes[i] != expected {
+					t.Errorf("Expected change %d to be %+v, got %+v", i, expected, result.Changes[i])
+				}
+			}
+			if len(mockServer.Requests("set-config")) != 0 {
+				t.Error("Expected preview not to change the config")
+			}
+		})
+	}
+}
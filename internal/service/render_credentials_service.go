@@ -0,0 +1,279 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Formats of a credential bundle
+const (
+	CredentialFormatMC         = "mc"         // mc config.json with an alias
+	CredentialFormatAWS        = "aws"        // AWS CLI credentials and config profile
+	CredentialFormatEnv        = "env"        // .env file
+	CredentialFormatKubernetes = "kubernetes" // Kubernetes Secret manifest
+	CredentialFormatRclone     = "rclone"     // rclone.conf remote
+)
+
+// DefaultCredentialBundleName names the alias, profile, remote or secret when none is given
+const DefaultCredentialBundleName = "minio"
+
+// defaultRegion is the region MinIO uses unless configured otherwise
+const defaultRegion = "us-east-1"
+
+var ErrInvalidCredentialBundle = errors.New("invalid credential bundle")
+
+// bundleNamePattern is accepted by every format, as mc alias, AWS profile, rclone remote and environment variable suffix.
+// A - is not allowed, the shell cannot read a variable like MC_HOST_app-backup.
+var bundleNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,62}$`)
+
+// ValidCredentialFormat reports whether format is a known credential bundle format
+func ValidCredentialFormat(format string) bool {
+	switch format {
+	case CredentialFormatMC, CredentialFormatAWS, CredentialFormatEnv, CredentialFormatKubernetes, CredentialFormatRclone:
+		return true
+	}
+	return false
+}
+
+// RenderCredentialsService renders the credentials of a service account in the format the consumer uses
+type RenderCredentialsService struct {
+	endpoint string
+}
+
+// RenderCredentialsRequest represents the credentials to render, empty options use the defaults
+type RenderCredentialsRequest struct {
+	Format    string
+	Name      string // Alias, profile, remote or secret name
+	Endpoint  string // S3 endpoint of the cluster
+	Region    string
+	Namespace string // Kubernetes namespace, omitted when empty
+	AccessKey string
+	SecretKey string
+}
+
+// CredentialBundle represents a rendered credential file
+type CredentialBundle struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// NewRenderCredentialsService creates a renderer using endpoint unless a request overrides it
+func NewRenderCredentialsService(endpoint string) *RenderCredentialsService {
+	return &RenderCredentialsService{
+		endpoint: endpoint,
+	}
+}
+
+// Validate fills the defaults of the options and checks them, it is called before the credentials exist
+func (s *RenderCredentialsService) Validate(req *RenderCredentialsRequest) error {
+	if !ValidCredentialFormat(req.Format) {
+		return fmt.Errorf("%w: unknown format %q, valid formats are mc, aws, env, kubernetes, rclone", ErrInvalidCredentialBundle, req.Format)
+	}
+
+	if req.Name == "" {
+		req.Name = DefaultCredentialBundleName
+	}
+	if !bundleNamePattern.MatchString(req.Name) {
+		return fmt.Errorf("%w: name must start with a letter and only contain letters, digits and _", ErrInvalidCredentialBundle)
+	}
+
+	if req.Endpoint == "" {
+		req.Endpoint = s.endpoint
+	}
+	endpoint, err := url.Parse(req.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return fmt.Errorf("%w: endpoint must be an http or https URL", ErrInvalidCredentialBundle)
+	}
+
+	if req.Region == "" {
+		req.Region = defaultRegion
+	}
+	if strings.ContainsAny(req.Region, " \t\r\n\"'=#") {
+		return fmt.Errorf("%w: invalid region", ErrInvalidCredentialBundle)
+	}
+
+	if req.Namespace != "" && !kubernetesNamePattern.MatchString(req.Namespace) {
+		return fmt.Errorf("%w: namespace must be a lowercase DNS label", ErrInvalidCredentialBundle)
+	}
+
+	return nil
+}
+
+func (s *RenderCredentialsService) Execute(ctx context.Context, req RenderCredentialsRequest) (*CredentialBundle, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := s.Validate(&req); err != nil {
+		return nil, err
+	}
+	// Supplied keys end up on a line of an ini file, a line break would add entries
+	if strings.ContainsAny(req.AccessKey+req.SecretKey, "\r\n") {
+		return nil, fmt.Errorf("%w: keys must not contain line breaks", ErrInvalidCredentialBundle)
+	}
+
+	var bundle *CredentialBundle
+	var err error
+	switch req.Format {
+	case CredentialFormatMC:
+		bundle, err = renderMC(req)
+	case CredentialFormatAWS:
+		bundle = renderAWS(req)
+	case CredentialFormatEnv:
+		bundle = renderEnv(req)
+	case CredentialFormatKubernetes:
+		bundle, err = renderKubernetes(req)
+	case CredentialFormatRclone:
+		bundle = renderRclone(req)
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("format", req.Format).Msg("Failed to render credentials")
+		return nil, fmt.Errorf("failed to render %s credentials: %w", req.Format, err)
+	}
+
+	logger.Debug().Str("format", req.Format).Str("name", req.Name).Str("accessKey", req.AccessKey).Msg("Rendered credentials")
+
+	return bundle, nil
+}
+
+// renderMC renders an mc config.json with the credentials as alias
+func renderMC(req RenderCredentialsRequest) (*CredentialBundle, error) {
+	type alias struct {
+		URL       string `json:"url"`
+		AccessKey string `json:"accessKey"`
+		SecretKey string `json:"secretKey"`
+		API       string `json:"api"`
+		Path      string `json:"path"`
+	}
+
+	content, err := json.MarshalIndent(struct {
+		Version string           `json:"version"`
+		Aliases map[string]alias `json:"aliases"`
+	}{
+		Version: "10",
+		Aliases: map[string]alias{
+			req.Name: {URL: req.Endpoint, AccessKey: req.AccessKey, SecretKey: req.SecretKey, API: "s3v4", Path: "auto"},
+		},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CredentialBundle{
+		Filename:    "config.json",
+		ContentType: "application/json",
+		Content:     append(content, '\n'),
+	}, nil
+}
+
+// renderAWS renders the profile of both AWS CLI files, separated by comments naming the file
+func renderAWS(req RenderCredentialsRequest) *CredentialBundle {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# ~/.aws/credentials\n")
+	fmt.Fprintf(&b, "[%s]\n", req.Name)
+	fmt.Fprintf(&b, "aws_access_key_id = %s\n", req.AccessKey)
+	fmt.Fprintf(&b, "aws_secret_access_key = %s\n", req.SecretKey)
+	fmt.Fprintf(&b, "\n# ~/.aws/config\n")
+	fmt.Fprintf(&b, "[profile %s]\n", req.Name)
+	fmt.Fprintf(&b, "region = %s\n", req.Region)
+	fmt.Fprintf(&b, "endpoint_url = %s\n", req.Endpoint)
+	fmt.Fprintf(&b, "s3 =\n  addressing_style = path\n")
+
+	return &CredentialBundle{
+		Filename:    req.Name + ".aws",
+		ContentType: "text/plain; charset=utf-8",
+		Content:     b.Bytes(),
+	}
+}
+
+// renderEnv renders the AWS SDK variables and an mc host variable
+func renderEnv(req RenderCredentialsRequest) *CredentialBundle {
+	endpoint, _ := url.Parse(req.Endpoint) // Checked by Validate
+	endpoint.User = url.UserPassword(req.AccessKey, req.SecretKey)
+
+	var b bytes.Buffer
+	for _, variable := range [][2]string{
+		{"AWS_ACCESS_KEY_ID", req.AccessKey},
+		{"AWS_SECRET_ACCESS_KEY", req.SecretKey},
+		{"AWS_REGION", req.Region},
+		{"AWS_ENDPOINT_URL", req.Endpoint},
+		{"MC_HOST_" + req.Name, endpoint.String()},
+	} {
+		fmt.Fprintf(&b, "%s=%s\n", variable[0], quoteEnv(variable[1]))
+	}
+
+	return &CredentialBundle{
+		Filename:    ".env",
+		ContentType: "text/plain; charset=utf-8",
+		Content:     b.Bytes(),
+	}
+}
+
+// quoteEnv double quotes a value and escapes the characters shells and dotenv parsers expand
+func quoteEnv(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "`", "\\`")
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// kubernetesNamePattern is a DNS label as required of namespaces and secret names
+var kubernetesNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// renderKubernetes renders an Opaque Secret with the AWS SDK variables as keys.
+// Values are JSON strings, which are valid YAML double quoted scalars.
+func renderKubernetes(req RenderCredentialsRequest) (*CredentialBundle, error) {
+	name := strings.Trim(strings.ReplaceAll(strings.ToLower(req.Name), "_", "-"), "-")
+	if !kubernetesNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("%w: name %q is not a valid secret name", ErrInvalidCredentialBundle, req.Name)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("apiVersion: v1\nkind: Secret\nmetadata:\n")
+	fmt.Fprintf(&b, "  name: %s\n", name)
+	if req.Namespace != "" {
+		fmt.Fprintf(&b, "  namespace: %s\n", req.Namespace)
+	}
+	b.WriteString("type: Opaque\nstringData:\n")
+	for _, entry := range [][2]string{
+		{"AWS_ACCESS_KEY_ID", req.AccessKey},
+		{"AWS_SECRET_ACCESS_KEY", req.SecretKey},
+		{"AWS_REGION", req.Region},
+		{"AWS_ENDPOINT_URL", req.Endpoint},
+	} {
+		value, err := json.Marshal(entry[1])
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "  %s: %s\n", entry[0], value)
+	}
+
+	return &CredentialBundle{
+		Filename:    name + "-secret.yaml",
+		ContentType: "application/yaml",
+		Content:     b.Bytes(),
+	}, nil
+}
+
+// renderRclone renders an S3 remote of the MinIO provider
+func renderRclone(req RenderCredentialsRequest) *CredentialBundle {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "[%s]\n", req.Name)
+	fmt.Fprintf(&b, "type = s3\n")
+	fmt.Fprintf(&b, "provider = Minio\n")
+	fmt.Fprintf(&b, "access_key_id = %s\n", req.AccessKey)
+	fmt.Fprintf(&b, "secret_access_key = %s\n", req.SecretKey)
+	fmt.Fprintf(&b, "region = %s\n", req.Region)
+	fmt.Fprintf(&b, "endpoint = %s\n", req.Endpoint)
+
+	return &CredentialBundle{
+		Filename:    "rclone.conf",
+		ContentType: "text/plain; charset=utf-8",
+		Content:     b.Bytes(),
+	}
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type RestoreConfigHistoryService struct {
+	minioClient *madmin.AdminClient
+}
+
+func NewRestoreConfigHistoryService(minioClient *madmin.AdminClient) *RestoreConfigHistoryService {
+	return &RestoreConfigHistoryService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute restores the config saved with the history entry identified by restoreID
+func (s *RestoreConfigHistoryService) Execute(ctx context.Context, restoreID string) error {
+	logger := zerolog.Ctx(ctx)
+
+	logger.Debug().Str("restoreId", restoreID).Msg("Restoring MinIO config history")
+
+	if err := s.minioClient.RestoreConfigHistoryKV(ctx, restoreID); err != nil {
+		logger.Error().Err(err).Str("restoreId", restoreID).Msg("Failed to restore MinIO config history")
+		return fmt.Errorf("failed to restore config history: %w", err)
+	}
+
+	logger.Info().Str("restoreId", restoreID).Msg("Successfully restored MinIO config history")
+
+	return nil
+}
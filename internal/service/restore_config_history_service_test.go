@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestRestoreConfigHistoryService_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		restoreID     string
+		setupMock     func(*minio.MockMinIOServer)
+		expectedError string
+	}{
+		{
+			name:      "successful restore",
+			restoreID: "a1b2c3d4-restore-1",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetConfigHistoryResponse(scenarios.ConfigHistory())
+			},
+		},
+		{
+			name:          "unknown history entry",
+			restoreID:     "missing",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			expectedError: "failed to restore config history",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewRestoreConfigHistoryService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			err = service.Execute(ctx, tt.restoreID)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			requests := mockServer.Requests("restore-config-history-kv")
+			if len(requests) != 1 || requests[0].Get("restoreId") != tt.restoreID {
+				t.Errorf("Expected restore of %q, got %v", tt.restoreID, requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type RunClusterActionService struct {
+	minioClient *madmin.AdminClient
+	tokens      *ConfirmationTokens
+}
+
+// ClusterActionResult represents a cluster action accepted by MinIO
+type ClusterActionResult struct {
+	Action string              `json:"action"`
+	Peers  []ClusterActionPeer `json:"peers"`
+}
+
+func NewRunClusterActionService(minioClient *madmin.AdminClient, tokens *ConfirmationTokens) *RunClusterActionService {
+	return &RunClusterActionService{
+		minioClient: minioClient,
+		tokens:      tokens,
+	}
+}
+
+// Execute runs the cluster action once the confirmation token issued by PrepareClusterActionService is verified.
+// The token is single-use and must have been issued for the same action and update URL.
+func (s *RunClusterActionService) Execute(ctx context.Context, req ClusterActionRequest) (*ClusterActionResult, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !ValidClusterAction(req.Action) {
+		return nil, fmt.Errorf("invalid cluster action: %s", req.Action)
+	}
+
+	if err := s.tokens.Consume(clusterActionSubject(req), req.ConfirmationToken); err != nil {
+		logger.Warn().Str("action", req.Action).Msg("Rejected MinIO cluster action without valid confirmation")
+		return nil, err
+	}
+
+	logger.Info().Str("action", req.Action).Msg("Running MinIO cluster action")
+
+	peers, err := runClusterAction(ctx, s.minioClient, req, false)
+	if err != nil {
+		logger.Error().Err(err).Str("action", req.Action).Msg("Failed to run MinIO cluster action")
+		return nil, fmt.Errorf("failed to %s cluster: %w", req.Action, err)
+	}
+
+	logger.Info().Str("action", req.Action).Int("peers", len(peers)).Msg("Successfully ran MinIO cluster action")
+
+	return &ClusterActionResult{
+		Action: req.Action,
+		Peers:  peers,
+	}, nil
+}
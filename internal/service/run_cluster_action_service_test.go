@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestRunClusterActionService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		confirm        ClusterActionRequest // Request the confirmation token is issued for
+		request        ClusterActionRequest
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		validateResult func(t *testing.T, result *ClusterActionResult, mock *minio.MockMinIOServer)
+	}{
+		{
+			name:    "confirmed restart",
+			confirm: ClusterActionRequest{Action: ClusterActionRestart},
+			request: ClusterActionRequest{Action: ClusterActionRestart},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServiceActionResponse(scenarios.SuccessfulServiceAction())
+			},
+			validateResult: func(t *testing.T, result *ClusterActionResult, mock *minio.MockMinIOServer) {
+				if !mock.RestartRequested() {
+					t.Error("Expected restart to be requested")
+				}
+				if len(result.Peers) != 2 {
+					t.Errorf("Expected %d peers, got %d", 2, len(result.Peers))
+				}
+			},
+		},
+		{
+			name:    "confirmed update with custom URL",
+			confirm: ClusterActionRequest{Action: ClusterActionUpdate, UpdateURL: "https://dl.example.com/minio"},
+			request: ClusterActionRequest{Action: ClusterActionUpdate, UpdateURL: "https://dl.example.com/minio"},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetServerUpdateResponse(scenarios.SuccessfulServerUpdate())
+			},
+			validateResult: func(t *testing.T, result *ClusterActionResult, mock *minio.MockMinIOServer) {
+				if !mock.UpdateRequested() {
+					t.Fatal("Expected update to be requested")
+				}
+				if got := mock.Requests("update")[0].Get("updateURL"); got != "https://dl.example.com/minio" {
+					t.Errorf("Expected updateURL %q, got %q", "https://dl.example.com/minio", got)
+				}
+			},
+		},
+		{
+			name:          "token confirmed for another action",
+			confirm:       ClusterActionRequest{Action: ClusterActionRestart},
+			request:       ClusterActionRequest{Action: ClusterActionStop},
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			expectedError: ErrInvalidConfirmationToken.Error(),
+			validateResult: func(t *testing.T, result *ClusterActionResult, mock *minio.MockMinIOServer) {
+				if mock.StopRequested() {
+					t.Error("Expected no stop to be requested")
+				}
+			},
+		},
+		{
+			name:          "token confirmed for another update URL",
+			confirm:       ClusterActionRequest{Action: ClusterActionUpdate},
+			request:       ClusterActionRequest{Action: ClusterActionUpdate, UpdateURL: "https://dl.example.com/minio"},
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			expectedError: ErrInvalidConfirmationToken.Error(),
+		},
+		{
+			name:    "MinIO server error",
+			confirm: ClusterActionRequest{Action: ClusterActionStop},
+			request: ClusterActionRequest{Action: ClusterActionStop},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceActionError(403, "Access Denied")
+			},
+			expectedError: "failed to stop cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Issue the confirmation token without a dry-run, the mock may be set up to fail
+			tokens := NewConfirmationTokens(time.Minute)
+			token, _, err := tokens.Issue(clusterActionSubject(tt.confirm))
+			if err != nil {
+				t.Fatalf("Failed to issue confirmation token: %v", err)
+			}
+			tt.request.ConfirmationToken = token
+
+			// Create service
+			service := NewRunClusterActionService(minioClient, tokens)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if tt.validateResult != nil {
+					tt.validateResult(t, result, mockServer)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result, mockServer)
+			}
+		})
+	}
+}
+
+// TestRunClusterActionService_PreparedToken tests the full confirmation flow and that a token cannot be replayed
+func TestRunClusterActionService_PreparedToken(t *testing.T) {
+	mockServer := minio.NewMockMinIOServer()
+	defer mockServer.Close()
+
+	minioClient, err := mockServer.CreateMinIOClient()
+	if err != nil {
+		t.Fatalf("Failed to create MinIO client: %v", err)
+	}
+
+	tokens := NewConfirmationTokens(time.Minute)
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+	confirmation, err := NewPrepareClusterActionService(minioClient, tokens).Execute(ctx, ClusterActionRequest{Action: ClusterActionRestart})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	runService := NewRunClusterActionService(minioClient, tokens)
+	req := ClusterActionRequest{Action: ClusterActionRestart, ConfirmationToken: confirmation.ConfirmationToken}
+
+	if _, err := runService.Execute(ctx, req); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !mockServer.RestartRequested() {
+		t.Error("Expected restart to be requested")
+	}
+
+	if _, err := runService.Execute(ctx, req); !errors.Is(err, ErrInvalidConfirmationToken) {
+		t.Errorf("Expected replayed token to fail with %v, got %v", ErrInvalidConfirmationToken, err)
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// DefaultServiceAccountCacheTTL is how long the details of a service account are reused, changes made outside this instance show up after it
+const DefaultServiceAccountCacheTTL = 30 * time.Second
+
+// ServiceAccountCache keeps the details of service accounts for a short time to save a round trip per account when listing.
+// Services changing a service account invalidate its entry.
+type ServiceAccountCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu          sync.Mutex
+	entries     map[string]cachedServiceAccount
+	generations map[string]uint64 // Bumped by every invalidation, only invalidated accounts are tracked
+	lastSweep   time.Time
+}
+
+type cachedServiceAccount struct {
+	info      madmin.InfoServiceAccountResp
+	expiresAt time.Time
+}
+
+func NewServiceAccountCache(ttl time.Duration) *ServiceAccountCache {
+	return &ServiceAccountCache{
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     make(map[string]cachedServiceAccount),
+		generations: make(map[string]uint64),
+	}
+}
+
+// Get returns the cached details of the service account when they have not expired
+func (c *ServiceAccountCache) Get(accessKey string) (madmin.InfoServiceAccountResp, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[accessKey]
+	if !exists {
+		return madmin.InfoServiceAccountResp{}, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, accessKey)
+		return madmin.InfoServiceAccountResp{}, false
+	}
+
+	return entry.info, true
+}
+
+// Generation returns the current generation of the service account, read it before looking up the details to cache
+func (c *ServiceAccountCache) Generation(accessKey string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generations[accessKey]
+}
+
+// Set caches the details of the service account looked up at generation. They are dropped when the service
+// account was invalidated since, as a lookup started before a change may return the details prior to it.
+func (c *ServiceAccountCache) Set(accessKey string, info madmin.InfoServiceAccountResp, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[accessKey] != generation {
+		return
+	}
+
+	now := c.now()
+	// Drop expired entries once per TTL so deleted accounts do not pile up
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[accessKey] = cachedServiceAccount{info: info, expiresAt: now.Add(c.ttl)}
+}
+
+// Invalidate forgets the details of the service account after it was changed
+func (c *ServiceAccountCache) Invalidate(accessKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, accessKey)
+	c.generations[accessKey]++
+}
+
+// ServiceAccountCaches keeps the cache of each cluster, so that the API and the background jobs
+// changing service accounts share and invalidate the same entries
+type ServiceAccountCaches struct {
+	ttl time.Duration
+
+	mu     sync.Mutex
+	caches map[string]*ServiceAccountCache
+}
+
+func NewServiceAccountCaches(ttl time.Duration) *ServiceAccountCaches {
+	return &ServiceAccountCaches{
+		ttl:    ttl,
+		caches: make(map[string]*ServiceAccountCache),
+	}
+}
+
+// Cluster returns the cache of the named cluster, it is created on first use
+func (c *ServiceAccountCaches) Cluster(name string) *ServiceAccountCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cache, exists := c.caches[name]
+	if !exists {
+		cache = NewServiceAccountCache(c.ttl)
+		c.caches[name] = cache
+	}
+	return cache
+}
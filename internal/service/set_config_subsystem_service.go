@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type SetConfigSubsystemService struct {
+	minioClient *madmin.AdminClient
+}
+
+// SetConfigRequest represents the change of config keys of a subsystem target.
+// Values equal to MaskedConfigValue are left unchanged.
+type SetConfigRequest struct {
+	SubSystem string            `json:"-"`
+	Target    string            `json:"target"`
+	Values    map[string]string `json:"values"`
+}
+
+// ConfigChangeResponse represents an applied config change
+type ConfigChangeResponse struct {
+	SubSystem       string `json:"subSystem"`
+	Target          string `json:"target"`
+	RestartRequired bool   `json:"restartRequired"` // MinIO only applies the change after a restart
+}
+
+func NewSetConfigSubsystemService(minioClient *madmin.AdminClient) *SetConfigSubsystemService {
+	return &SetConfigSubsystemService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *SetConfigSubsystemService) Execute(ctx context.Context, req SetConfigRequest) (*ConfigChangeResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := validateConfigTarget(req.Target); err != nil {
+		return nil, err
+	}
+
+	help, err := s.minioClient.HelpConfigKV(ctx, req.SubSystem, "", false)
+	if err != nil {
+		logger.Error().Err(err).Str("subSystem", req.SubSystem).Msg("Failed to fetch MinIO config help")
+		return nil, fmt.Errorf("failed to get config help: %w", err)
+	}
+
+	if req.Target != "" && !help.MultipleTargets {
+		return nil, fmt.Errorf("%w: %s does not support targets", ErrInvalidConfig, req.SubSystem)
+	}
+
+	types := make(map[string]string, len(help.KeysHelp))
+	for _, keyHelp := range help.KeysHelp {
+		types[keyHelp.Key] = keyHelp.Type
+	}
+
+	keys := make([]string, 0, len(req.Values))
+	for key, value := range req.Values {
+		// Masked values were returned by the viewer and never edited
+		if value == MaskedConfigValue {
+			continue
+		}
+
+		valueType, known := types[key]
+		if !known {
+			return nil, fmt.Errorf("%w: unknown key %s for %s", ErrInvalidConfig, key, req.SubSystem)
+		}
+		if err := validateConfigValue(key, valueType, value); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no values to change", ErrInvalidConfig)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, key, req.Values[key]))
+	}
+	name := configTargetName(req.SubSystem, req.Target)
+
+	logger.Debug().Str("config", name).Strs("keys", keys).Msg("Setting MinIO config")
+
+	restart, err := s.minioClient.SetConfigKV(ctx, name+" "+strings.Join(pairs, " "))
+	if err != nil {
+		logger.Error().Err(err).Str("config", name).Msg("Failed to set MinIO config")
+		return nil, fmt.Errorf("failed to set config: %w", err)
+	}
+
+	logger.Info().
+		Str("config", name).
+		Strs("keys", keys).
+		Bool("restartRequired", restart).
+		Msg("Successfully set MinIO config")
+
+	return &ConfigChangeResponse{
+		SubSystem:       req.SubSystem,
+		Target:          req.Target,
+		RestartRequired: restart,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestSetConfigSubsystemService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        SetConfigRequest
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		invalid        bool // Expect ErrInvalidConfig
+		expectedKV     string
+		validateResult func(t *testing.T, result *ConfigChangeResponse)
+	}{
+		{
+			name: "set keys of a named target",
+			request: SetConfigRequest{
+				SubSystem: "notify_webhook",
+				Target:    "primary",
+				Values: map[string]string{
+					"endpoint":    "https://hooks.example.com/v2",
+					"queue_limit": "5000",
+					"comment":     "Audit events v2",
+				},
+			},
+			expectedKV: `notify_webhook:primary comment="Audit events v2" endpoint="https://hooks.example.com/v2" queue_limit="5000"`,
+			validateResult: func(t *testing.T, result *ConfigChangeResponse) {
+				if result.RestartRequired {
+					t.Error("Expected change to be applied without restart")
+				}
+			},
+		},
+		{
+			name: "masked values are left unchanged",
+			request: SetConfigRequest{
+				SubSystem: "notify_webhook",
+				Values: map[string]string{
+					"enable":     "on",
+					"auth_token": MaskedConfigValue,
+				},
+			},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigRestartRequired(true)
+			},
+			expectedKV: `notify_webhook enable="on"`,
+			validateResult: func(t *testing.T, result *ConfigChangeResponse) {
+				if !result.RestartRequired {
+					t.Error("Expected change to require a restart")
+				}
+			},
+		},
+		{
+			name:          "unknown key",
+			request:       SetConfigRequest{SubSystem: "notify_webhook", Values: map[string]string{"retries": "3"}},
+			invalid:       true,
+			expectedError: "unknown key retries",
+		},
+		{
+			name:          "value does not match key type",
+			request:       SetConfigRequest{SubSystem: "notify_webhook", Values: map[string]string{"queue_limit": "unlimited"}},
+			invalid:       true,
+			expectedError: "value of queue_limit must be a number",
+		},
+		{
+			name:          "only masked values",
+			request:       SetConfigRequest{SubSystem: "notify_webhook", Values: map[string]string{"auth_token": MaskedConfigValue}},
+			invalid:       true,
+			expectedError: "no values to change",
+		},
+		{
+			name:          "target on subsystem without targets",
+			request:       SetConfigRequest{SubSystem: "api", Target: "primary", Values: map[string]string{"requests_max": "10"}},
+			invalid:       true,
+			expectedError: "api does not support targets",
+		},
+		{
+			name:          "target with a space adds keys",
+			request:       SetConfigRequest{SubSystem: "notify_webhook", Target: "primary enable=off", Values: map[string]string{"enable": "on"}},
+			invalid:       true,
+			expectedError: "target must start with a letter or digit",
+		},
+		{
+			name:          "value with a line break adds a subsystem",
+			request:       SetConfigRequest{SubSystem: "notify_webhook", Values: map[string]string{"comment": "ok\nidentity_openid enable=off"}},
+			invalid:       true,
+			expectedError: "must not contain line breaks",
+		},
+		{
+			name:    "MinIO server error",
+			request: SetConfigRequest{SubSystem: "notify_webhook", Values: map[string]string{"enable": "on"}},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigError(403, "Access Denied")
+			},
+			expectedError: "failed to get config help",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetConfigHelpResponse("notify_webhook", scenarios.NotifyWebhookConfigHelp())
+			mockServer.SetConfigHelpResponse("api", scenarios.APIConfigHelp())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewSetConfigSubsystemService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if errors.Is(err, ErrInvalidConfig) != tt.invalid {
+					t.Errorf("Expected ErrInvalidConfig to be %v, got %v", tt.invalid, err)
+				}
+				if tt.invalid && len(mockServer.Requests("set-config-kv")) != 0 {
+					t.Error("Expected invalid config not to be sent to MinIO")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			requests := mockServer.Requests("set-config-kv")
+			if len(requests) != 1 {
+				t.Fatalf("Expected %d set config request, got %d", 1, len(requests))
+			}
+			if got := requests[0].Get("kv"); got != tt.expectedKV {
+				t.Errorf("Expected config %q, got %q", tt.expectedKV, got)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
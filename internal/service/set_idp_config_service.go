@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type SetIDPConfigService struct {
+	minioClient *madmin.AdminClient
+}
+
+// SetIDPConfigRequest represents adding or editing an identity provider config.
+// Values equal to MaskedConfigValue are left unchanged.
+type SetIDPConfigRequest struct {
+	Type   string            `json:"-"`
+	Name   string            `json:"-"`
+	Update bool              `json:"-"` // Edit an existing config instead of adding a new one
+	Values map[string]string `json:"values"`
+}
+
+// IDPConfigChangeResponse represents an applied identity provider config change
+type IDPConfigChangeResponse struct {
+	Type            string `json:"type"`
+	Name            string `json:"name"`
+	RestartRequired bool   `json:"restartRequired"` // MinIO only applies the change after a restart
+}
+
+func NewSetIDPConfigService(minioClient *madmin.AdminClient) *SetIDPConfigService {
+	return &SetIDPConfigService{
+		minioClient: minioClient,
+	}
+}
+
+// Execute adds or edits an identity provider config, values are validated against the config help
+// of the identity_ldap or identity_openid subsystem
+func (s *SetIDPConfigService) Execute(ctx context.Context, req SetIDPConfigRequest) (*IDPConfigChangeResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if req.Type == madmin.LDAPIDPCfg && req.Name != madmin.Default {
+		return nil, fmt.Errorf("%w: LDAP only supports the default config %s", ErrInvalidConfig, madmin.Default)
+	}
+
+	data, keys, err := idpConfigData(ctx, s.minioClient, req.Type, req.Values)
+	if err != nil {
+		logger.Warn().Err(err).Str("type", req.Type).Str("name", req.Name).Msg("Rejected MinIO IDP config")
+		return nil, err
+	}
+
+	logger.Debug().
+		Str("type", req.Type).
+		Str("name", req.Name).
+		Bool("update", req.Update).
+		Strs("keys", keys).
+		Msg("Setting MinIO IDP config")
+
+	restart, err := s.minioClient.AddOrUpdateIDPConfig(ctx, req.Type, req.Name, data, req.Update)
+	if err != nil {
+		logger.Error().Err(err).Str("type", req.Type).Str("name", req.Name).Msg("Failed to set MinIO IDP config")
+		return nil, fmt.Errorf("failed to set IDP config: %w", err)
+	}
+
+	logger.Info().
+		Str("type", req.Type).
+		Str("name", req.Name).
+		Bool("update", req.Update).
+		Bool("restartRequired", restart).
+		Msg("Successfully set MinIO IDP config")
+
+	return &IDPConfigChangeResponse{
+		Type:            req.Type,
+		Name:            req.Name,
+		RestartRequired: restart,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestSetIDPConfigService_Execute(t *testing.T) {
+	tests := []struct {
+		name              string
+		request           SetIDPConfigRequest
+		setupMock         func(*minio.MockMinIOServer)
+		expectedError     string
+		invalid           bool // Expect ErrInvalidConfig
+		expectedOperation string
+		expectedConfig    string
+		validateResult    func(t *testing.T, result *IDPConfigChangeResponse)
+	}{
+		{
+			name: "add OpenID config",
+			request: SetIDPConfigRequest{
+				Type: "openid",
+				Name: "keycloak",
+				Values: map[string]string{
+					"config_url":    "https://sso.example.com/realms/minio/.well-known/openid-configuration",
+					"client_id":     "minio",
+					"client_secret": "0pen1d-s3cret",
+				},
+			},
+			expectedOperation: "add-idp-config",
+			expectedConfig:    `client_id="minio" client_secret="0pen1d-s3cret" config_url="https://sso.example.com/realms/minio/.well-known/openid-configuration"`,
+			validateResult: func(t *testing.T, result *IDPConfigChangeResponse) {
+				if result.Type != "openid" || result.Name != "keycloak" {
+					t.Errorf("Expected openid/keycloak, got %s/%s", result.Type, result.Name)
+				}
+				if result.RestartRequired {
+					t.Error("Expected change to be applied without restart")
+				}
+			},
+		},
+		{
+			name: "edit LDAP config keeps masked password",
+			request: SetIDPConfigRequest{
+				Type:   "ldap",
+				Name:   "_",
+				Update: true,
+				Values: map[string]string{
+					"server_addr":          "ldap2.example.com:636",
+					"lookup_bind_password": MaskedConfigValue,
+				},
+			},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetConfigRestartRequired(true)
+			},
+			expectedOperation: "update-idp-config",
+			expectedConfig:    `server_addr="ldap2.example.com:636"`,
+			validateResult: func(t *testing.T, result *IDPConfigChangeResponse) {
+				if !result.RestartRequired {
+					t.Error("Expected change to require a restart")
+				}
+			},
+		},
+		{
+			name:          "named LDAP config",
+			request:       SetIDPConfigRequest{Type: "ldap", Name: "corp", Values: map[string]string{"enable": "on"}},
+			invalid:       true,
+			expectedError: "LDAP only supports the default config",
+		},
+		{
+			name:          "unknown key",
+			request:       SetIDPConfigRequest{Type: "openid", Name: "keycloak", Values: map[string]string{"redirect": "on"}},
+			invalid:       true,
+			expectedError: "unknown key redirect for openid",
+		},
+		{
+			name:          "value does not match key type",
+			request:       SetIDPConfigRequest{Type: "openid", Name: "keycloak", Values: map[string]string{"config_url": "sso.example.com"}},
+			invalid:       true,
+			expectedError: "value of config_url must be an absolute URL",
+		},
+		{
+			name:    "MinIO server error",
+			request: SetIDPConfigRequest{Type: "openid", Name: "keycloak", Values: map[string]string{"enable": "on"}},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(400, "Invalid OpenID configuration")
+			},
+			expectedError: "failed to set IDP config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			mockServer.SetConfigHelpResponse("identity_ldap", scenarios.LDAPConfigHelp())
+			mockServer.SetConfigHelpResponse("identity_openid", scenarios.OpenIDConfigHelp())
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewSetIDPConfigService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if errors.Is(err, ErrInvalidConfig) != tt.invalid {
+					t.Errorf("Expected ErrInvalidConfig to be %v, got %v", tt.invalid, err)
+				}
+				if tt.invalid && len(mockServer.Requests("add-idp-config")) != 0 {
+					t.Error("Expected invalid config not to be sent to MinIO")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			requests := mockServer.Requests(tt.expectedOperation)
+			if len(requests) != 1 {
+				t.Fatalf("Expected %d %s request, got %d", 1, tt.expectedOperation, len(requests))
+			}
+			if got := requests[0].Get("config"); got != tt.expectedConfig {
+				t.Errorf("Expected config %q, got %q", tt.expectedConfig, got)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/minio/pkg/v3/policy"
+	"github.com/minio/pkg/v3/policy/condition"
+	"github.com/rs/zerolog"
+)
+
+const errCodeNoSuchUser = "XMinioAdminNoSuchUser"
+
+var (
+	ErrInvalidPolicySimulation = errors.New("invalid policy simulation")
+	ErrServiceAccountNotFound  = errors.New("service account not found")
+	// ErrUnresolvedParentPolicy is returned when the parent is neither a built-in nor a mapped LDAP user, e.g. an OpenID identity
+	ErrUnresolvedParentPolicy = errors.New("policies of the parent user cannot be resolved")
+
+	errParentNotBuiltin = errors.New("parent is not a built-in user")
+)
+
+// Decisions of a policy simulation
+const (
+	PolicyDecisionAllow = "allow"
+	PolicyDecisionDeny  = "deny"
+)
+
+// Sources of the policies evaluated by a simulation
+const (
+	PolicySourceServiceAccount = "serviceAccount" // Policy attached to the service account
+	PolicySourceParent         = "parent"         // Policies of the parent user and its groups
+)
+
+// SimulateAccessKeyPolicyService evaluates whether an access key is allowed to perform an action
+type SimulateAccessKeyPolicyService struct {
+	minioClient *madmin.AdminClient
+	rootUser    string
+}
+
+// SimulateAccessKeyPolicyRequest represents an action on a resource to evaluate for an access key
+type SimulateAccessKeyPolicyRequest struct {
+	AccessKey  string
+	Action     string              // e.g. s3:GetObject
+	Resource   string              // bucket/object with or without the arn:aws:s3::: prefix, empty for admin actions
+	Conditions map[string][]string // Condition values like aws:SourceIp, keys may omit the aws: and s3: prefix
+}
+
+// PolicyStatementMatch represents a statement whose action, resource and conditions match the request
+type PolicyStatementMatch struct {
+	Policy    string          `json:"policy,omitempty"` // Canned policy name, empty for the policy of the service account
+	Index     int             `json:"index"`            // Position of the statement in the policy
+	SID       string          `json:"sid,omitempty"`
+	Effect    string          `json:"effect"`
+	Statement json.RawMessage `json:"statement"`
+}
+
+// PolicyEvaluation represents the decision of the policies of one source
+type PolicyEvaluation struct {
+	Source     string                 `json:"source"`
+	Policies   []string               `json:"policies,omitempty"` // Canned policies of the parent user and its groups
+	Owner      bool                   `json:"owner,omitempty"`    // The parent is the root user, which is allowed everything
+	Allowed    bool                   `json:"allowed"`
+	Statements []PolicyStatementMatch `json:"statements"`
+}
+
+// SimulateAccessKeyPolicyResponse represents the effective decision of an access key
+type SimulateAccessKeyPolicyResponse struct {
+	AccessKey     string             `json:"accessKey"`
+	ParentUser    string             `json:"parentUser"`
+	ImpliedPolicy bool               `json:"impliedPolicy"`
+	Action        string             `json:"action"`
+	Resource      string             `json:"resource,omitempty"`
+	Decision      string             `json:"decision"`
+	Evaluations   []PolicyEvaluation `json:"evaluations"`
+}
+
+// NewSimulateAccessKeyPolicyService creates a simulator, rootUser owns the cluster and is allowed every action
+func NewSimulateAccessKeyPolicyService(minioClient *madmin.AdminClient, rootUser string) *SimulateAccessKeyPolicyService {
+	return &SimulateAccessKeyPolicyService{
+		minioClient: minioClient,
+		rootUser:    rootUser,
+	}
+}
+
+// Execute evaluates the request the way MinIO authorizes a service account. The parent policies decide when the
+// policy is implied, otherwise both the policy of the service account and the parent policies have to allow.
+func (s *SimulateAccessKeyPolicyService) Execute(ctx context.Context, req SimulateAccessKeyPolicyRequest) (*SimulateAccessKeyPolicyResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	action := policy.Action(strings.TrimSpace(req.Action))
+	if !validSimulationAction(action) {
+		return nil, fmt.Errorf("%w: unknown action %q", ErrInvalidPolicySimulation, req.Action)
+	}
+
+	resource := strings.TrimPrefix(strings.TrimSpace(req.Resource), policy.ResourceARNPrefix)
+	bucket, object, _ := strings.Cut(strings.TrimPrefix(resource, "/"), "/")
+	if bucket == "" && !isBucketlessAction(action) {
+		return nil, fmt.Errorf("%w: action %s requires a bucket resource", ErrInvalidPolicySimulation, action)
+	}
+
+	info, err := s.minioClient.InfoServiceAccount(ctx, req.AccessKey)
+	if serviceAccountNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", ErrServiceAccountNotFound, req.AccessKey)
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("accessKey", req.AccessKey).Msg("Failed to get service account to simulate")
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+
+	args := policy.Args{
+		AccountName:     info.ParentUser,
+		Action:          action,
+		BucketName:      bucket,
+		ObjectName:      object,
+		ConditionValues: simulationConditions(req.Conditions, info.ParentUser),
+	}
+
+	logger.Debug().
+		Str("accessKey", req.AccessKey).
+		Str("parentUser", info.ParentUser).
+		Str("action", string(action)).
+		Str("resource", resource).
+		Msg("Simulating access key policy")
+
+	parent, err := s.evaluateParent(ctx, info.ParentUser, args)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimulateAccessKeyPolicyResponse{
+		AccessKey:     req.AccessKey,
+		ParentUser:    info.ParentUser,
+		ImpliedPolicy: info.ImpliedPolicy,
+		Action:        string(action),
+		Resource:      resource,
+		Evaluations:   []PolicyEvaluation{*parent},
+	}
+	allowed := parent.Allowed
+
+	if !info.ImpliedPolicy {
+		own, err := parsePolicy([]byte(info.Policy))
+		if err != nil {
+			logger.Error().Err(err).Str("accessKey", req.AccessKey).Msg("Failed to parse service account policy")
+			return nil, fmt.Errorf("failed to parse service account policy: %w", err)
+		}
+
+		// The root user does not bypass the policy of its service accounts
+		evaluation := PolicyEvaluation{
+			Source:     PolicySourceServiceAccount,
+			Allowed:    own.IsAllowed(args),
+			Statements: matchStatements("", own, args),
+		}
+		response.Evaluations = append(response.Evaluations, evaluation)
+		allowed = allowed && evaluation.Allowed
+	}
+
+	response.Decision = PolicyDecisionDeny
+	if allowed {
+		response.Decision = PolicyDecisionAllow
+	}
+
+	logger.Info().
+		Str("accessKey", req.AccessKey).
+		Str("action", string(action)).
+		Str("resource", resource).
+		Str("decision", response.Decision).
+		Msg("Simulated access key policy")
+
+	return response, nil
+}
+
+// evaluateParent evaluates the canned policies of the parent user and its groups
+func (s *SimulateAccessKeyPolicyService) evaluateParent(ctx context.Context, parentUser string, args policy.Args) (*PolicyEvaluation, error) {
+	logger := zerolog.Ctx(ctx)
+
+	evaluation := &PolicyEvaluation{Source: PolicySourceParent, Statements: []PolicyStatementMatch{}}
+	if parentUser == s.rootUser {
+		evaluation.Owner = true
+		evaluation.Allowed = true
+		return evaluation, nil
+	}
+
+	names, groups, err := s.builtinParentPolicies(ctx, parentUser)
+	if errors.Is(err, errParentNotBuiltin) {
+		names, groups, err = s.ldapParentPolicies(ctx, parentUser)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	args.Groups = groups
+
+	policies := make([]policy.Policy, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		info, err := s.minioClient.InfoCannedPolicy(ctx, name)
+		if err != nil {
+			logger.Error().Err(err).Str("policy", name).Msg("Failed to get canned policy")
+			return nil, fmt.Errorf("failed to get policy %s: %w", name, err)
+		}
+		p, err := parsePolicy(info.Policy)
+		if err != nil {
+			logger.Error().Err(err).Str("policy", name).Msg("Failed to parse canned policy")
+			return nil, fmt.Errorf("failed to parse policy %s: %w", name, err)
+		}
+
+		policies = append(policies, *p)
+		evaluation.Policies = append(evaluation.Policies, name)
+		evaluation.Statements = append(evaluation.Statements, matchStatements(name, p, args)...)
+	}
+
+	merged := policy.MergePolicies(policies...)
+	evaluation.Allowed = merged.IsAllowed(args)
+
+	return evaluation, nil
+}
+
+// builtinParentPolicies returns the policies of a built-in parent user and its enabled groups
+func (s *SimulateAccessKeyPolicyService) builtinParentPolicies(ctx context.Context, parentUser string) ([]string, []string, error) {
+	logger := zerolog.Ctx(ctx)
+
+	user, err := s.minioClient.GetUserInfo(ctx, parentUser)
+	if err != nil && madmin.ToErrorResponse(err).Code == errCodeNoSuchUser {
+		return nil, nil, errParentNotBuiltin
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("parentUser", parentUser).Msg("Failed to get parent user")
+		return nil, nil, fmt.Errorf("failed to get parent user: %w", err)
+	}
+
+	names := splitPolicyNames(user.PolicyName)
+	for _, group := range user.MemberOf {
+		desc, err := s.minioClient.GetGroupDescription(ctx, group)
+		if err != nil {
+			logger.Error().Err(err).Str("group", group).Msg("Failed to get group of parent user")
+			return nil, nil, fmt.Errorf("failed to get group %s: %w", group, err)
+		}
+		// Policies of disabled groups are not applied
+		if desc.Status == string(madmin.GroupDisabled) {
+			continue
+		}
+		names = append(names, splitPolicyNames(desc.Policy)...)
+	}
+
+	return names, user.MemberOf, nil
+}
+
+// ldapParentPolicies returns the policies mapped to an LDAP parent DN and its groups. MinIO only reports
+// the groups with a mapped policy, which are the only ones a policy can refer to.
+func (s *SimulateAccessKeyPolicyService) ldapParentPolicies(ctx context.Context, parentUser string) ([]string, []string, error) {
+	logger := zerolog.Ctx(ctx)
+
+	result, err := s.minioClient.GetLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{
+		Users: []string{parentUser},
+	})
+	if err != nil {
+		// LDAP is not configured, e.g. the parent is an OpenID identity
+		logger.Warn().Err(err).Str("parentUser", parentUser).Msg("Failed to look up LDAP policy mappings of parent user")
+		return nil, nil, fmt.Errorf("%w: %s is not a built-in user and its LDAP policy mappings cannot be read", ErrUnresolvedParentPolicy, parentUser)
+	}
+	if len(result.UserMappings) == 0 {
+		return nil, nil, fmt.Errorf("%w: %s is neither a built-in user nor a mapped LDAP user", ErrUnresolvedParentPolicy, parentUser)
+	}
+
+	mapping := newLDAPUserMapping(result.UserMappings[0])
+	names := mapping.Policies
+	groups := make([]string, 0, len(mapping.Groups))
+	for _, group := range mapping.Groups {
+		groups = append(groups, group.Group)
+		names = append(names, group.Policies...)
+	}
+
+	return names, groups, nil
+}
+
+// matchStatements returns the statements of p which apply to args, for both effects
+func matchStatements(name string, p *policy.Policy, args policy.Args) []PolicyStatementMatch {
+	matches := []PolicyStatementMatch{}
+	for i, statement := range p.Statements {
+		// IsAllowed is negated by the effect, a matching deny statement is not allowed
+		if statement.IsAllowed(args) != (statement.Effect == policy.Allow) {
+			continue
+		}
+
+		raw, _ := json.Marshal(statement)
+		matches = append(matches, PolicyStatementMatch{
+			Policy:    name,
+			Index:     i,
+			SID:       string(statement.SID),
+			Effect:    string(statement.Effect),
+			Statement: raw,
+		})
+	}
+	return matches
+}
+
+// parsePolicy parses a policy document, an empty document is a policy without statements
+func parsePolicy(document []byte) (*policy.Policy, error) {
+	if len(bytes.TrimSpace(document)) == 0 {
+		return &policy.Policy{}, nil
+	}
+	return policy.ParseConfig(bytes.NewReader(document))
+}
+
+// splitPolicyNames splits a comma separated list of policy names
+func splitPolicyNames(names string) []string {
+	var result []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// validSimulationAction reports whether action is a single S3, admin, KMS or STS action
+func validSimulationAction(action policy.Action) bool {
+	if action == "" || strings.ContainsAny(string(action), "*?") {
+		return false
+	}
+	return action.IsValid() ||
+		policy.AdminAction(action).IsValid() ||
+		policy.KMSAction(action).IsValid() ||
+		policy.STSAction(action).IsValid()
+}
+
+// isBucketlessAction reports whether action is authorized without a bucket, like admin and KMS actions
+func isBucketlessAction(action policy.Action) bool {
+	for _, prefix := range []string{"admin:", "kms:", "sts:"} {
+		if strings.HasPrefix(string(action), prefix) {
+			return true
+		}
+	}
+	return action == policy.ListAllMyBucketsAction
+}
+
+// simulationConditions keys the condition values by name without prefix as MinIO does,
+// the username defaults to the parent user for policy variables like ${aws:username}
+func simulationConditions(values map[string][]string, parentUser string) map[string][]string {
+	conditions := make(map[string][]string, len(values)+1)
+	for key, value := range values {
+		conditions[condition.KeyName(key).Name()] = value
+	}
+	if _, ok := conditions["username"]; !ok {
+		conditions["username"] = []string{parentUser}
+	}
+	return conditions
+}
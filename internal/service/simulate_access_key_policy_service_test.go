@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestSimulateAccessKeyPolicyService_Execute(t *testing.T) {
+	readBackups := `{"Version":"2012-10-17","Statement":[{"Sid":"ReadBackups","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::backups/*"]}]}`
+	denySecrets := `{"Version":"2012-10-17","Statement":[{"Sid":"DenySecrets","Effect":"Deny","Action":["s3:*"],"Resource":["arn:aws:s3:::backups/secrets/*"]}]}`
+	officeOnly := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::backups/*"],"Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8"]}}}]}`
+
+	tests := []struct {
+		name             string
+		setupMock        func(*minio.MockMinIOServer)
+		request          SimulateAccessKeyPolicyRequest
+		expectedError    error
+		expectedDecision string
+		validateResult   func(t *testing.T, result *SimulateAccessKeyPolicyResponse)
+	}{
+		{
+			name: "implied policy allowed by parent policy",
+			request: SimulateAccessKeyPolicyRequest{
+				AccessKey: "BOBBACKUP00000000001",
+				Action:    "s3:GetObject",
+				Resource:  "arn:aws:s3:::backups/2025/db.tar.gz",
+			},
+			expectedDecision: PolicyDecisionAllow,
+			validateResult: func(t *testing.T, result *SimulateAccessKeyPolicyResponse) {
+				if len(result.Evaluations) != 1 {
+					t.Fatalf("Expected only the parent evaluation, got %d", len(result.Evaluations))
+				}
+				parent := result.Evaluations[0]
+				if len(parent.Policies) != 2 || parent.Policies[0] != "readbackups" || parent.Policies[1] != "denysecrets" {
+					t.Errorf("Expected user and group policies, got %v", parent.Policies)
+				}
+				if len(parent.Statements) != 1 || parent.Statements[0].SID != "ReadBackups" || parent.Statements[0].Policy != "readbackups" {
+					t.Errorf("Expected the ReadBackups statement to match, got %+v", parent.Statements)
+				}
+			},
+		},
+		{
+			name: "group deny overrides allow",
+			request: SimulateAccessKeyPolicyRequest{
+				AccessKey: "BOBBACKUP00000000001",
+				Action:    "s3:GetObject",
+				Resource:  "backups/secrets/root.key",
+			},
+			expectedDecision: PolicyDecisionDeny,
+			validateResult: func(t *testing.T, result *SimulateAccessKeyPolicyResponse) {
+				statements := result.Evaluations[0].Statements
+				if len(statements) != 2 || statements[1].Effect != "Deny" || statements[1].Policy != "denysecrets" {
+					t.Errorf("Expected the allow and deny statements to match, got %+v", statements)
+				}
+			},
+		},
+		{
+			name: "action outside the parent policy",
+			request: SimulateAccessKeyPolicyRequest{
+				AccessKey: "BOBBACKUP00000000001",
+				Action:    "s3:PutObject",
+				Resource:  "backups/2025/db.tar.gz",
+			},
+			expectedDecision: PolicyDecisionDeny,
+			validateResult: func(t *testing.T, result *SimulateAccessKeyPolicyResponse) {
+				if len(result.Evaluations[0].Statements) != 0 {
+					t.Errorf("Expected no matching statements, got %+v", result.Evaluations[0].Statements)
+				}
+			},
+		},
+		{
+			name: "own policy restricts the parent policy",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetInfoServiceAccountResponse("BOBBACKUP00000000001", minio.InfoServiceAccountResponse{
+					ParentUser:    "bob",
+					AccountStatus: "enabled",
+					Policy:        json.RawMessage(strconv.Quote(officeOnly)), // MinIO returns the policy as a string
+				})
+			},
+			request: SimulateAccessKeyPolicyRequest{
+				AccessKey:  "BOBBACKUP00000000001",
+				Action:     "s3:GetObject",
+				Resource:   "backups/2025/db.tar.gz",
+				Conditions: map[string][]string{"aws:SourceIp": {"192.168.1.10"}},
+			},
+			expectedDecision: PolicyDecisionDeny,
+			validateResult: func(t *testing.T, result *SimulateAccessKeyPolicyResponse) {
+				if len(result.Evaluations) != 2 {
+					t.Fatalf("Expected parent and service account evaluations, got %d", len(result.Evaluations))
+				}
+				if !result.Evaluations[0].Allowed || result.Evaluations[1].Allowed {
+					t.Errorf("Expected the parent to allow and the service account to deny, got %+v", result.Evaluations)
+				}
+			},
+		},
+		{
+			name: "own policy condition satisfied",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetInfoServiceAccountResponse("BOBBACKUP00000000001", minio.InfoServiceAccountResponse{
+					ParentUser:    "bob",
+					AccountStatus: "enabled",
+					Policy:        json.RawMessage(strconv.Quote(officeOnly)),
+				})
+			},
+			request: SimulateAccessKeyPolicyRequest{
+				AccessKey:  "BOBBACKUP00000000001",
+				Action:     "s3:GetObject",
+				Resource:   "backups/2025/db.tar.gz",
+				Conditions: map[string][]string{"SourceIp": {"10.1.2.3"}},
+			},
+			expectedDecision: PolicyDecisionAllow,
+		},
+		{
+			name: "root parent is the owner",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.AddServiceAccountToStore("ADMINKEY000000000001", "", "", "", "enabled", "minioadmin", nil, nil)
+			},
+			request: SimulateAccessKeyPolicyRequest{
+				AccessKey: "ADMINKEY000000000001",
+				Action:    "admin:ServerInfo",
+			},
+			expectedDecision: PolicyDecisionAllow,
+			validateResult: func(t *testing.T, result *SimulateAccessKeyPolicyResponse) {
+				if !result.Evaluations[0].Owner {
+					t.Error("Expected the root user to be reported as owner")
+				}
+			},
+		},
+		{
+			name: "LDAP parent resolved through its policy mappings",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.AddServiceAccountToStore("LDAPKEY0000000000001", "", "", "", "enabled", "uid=carol,dc=example,dc=org", nil, nil)
+				mock.SetLDAPPolicyEntitiesResponse(madmin.PolicyEntitiesResult{
+					UserMappings: []madmin.UserPolicyEntities{
+						{
+							User:     "uid=carol,dc=example,dc=org",
+							Policies: []string{"readbackups"},
+							MemberOfMappings: []madmin.GroupPolicyEntities{
+								{Group: "cn=operators,dc=example,dc=org", Policies: []string{"denysecrets"}},
+							},
+						},
+					},
+				})
+			},
+			request: SimulateAccessKeyPolicyRequest{
+				AccessKey: "LDAPKEY0000000000001",
+				Action:    "s3:GetObject",
+				Resource:  "backups/secrets/root.key",
+			},
+			expectedDecision: PolicyDecisionDeny,
+			validateResult: func(t *testing.T, result *SimulateAccessKeyPolicyResponse) {
+				parent := result.Evaluations[0]
+				if len(parent.Policies) != 2 || parent.Policies[0] != "readbackups" || parent.Policies[1] != "denysecrets" {
+					t.Errorf("Expected user and group mapped policies, got %v", parent.Policies)
+				}
+				if len(parent.Statements) != 2 || parent.Statements[1].Effect != "Deny" {
+					t.Errorf("Expected the group deny statement to match, got %+v", parent.Statements)
+				}
+			},
+		},
+		{
+			name: "parent is neither a built-in nor a mapped LDAP user",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.AddServiceAccountToStore("OIDCKEY0000000000001", "", "", "", "enabled", "openid-subject", nil, nil)
+			},
+			request:       SimulateAccessKeyPolicyRequest{AccessKey: "OIDCKEY0000000000001", Action: "s3:GetObject", Resource: "backups/db"},
+			expectedError: ErrUnresolvedParentPolicy,
+		},
+		{
+			name: "parent is not a built-in user and LDAP is not enabled",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.AddServiceAccountToStore("OIDCKEY0000000000001", "", "", "", "enabled", "openid-subject", nil, nil)
+				mock.SetIDPError(400, "LDAP is not enabled")
+			},
+			request:       SimulateAccessKeyPolicyRequest{AccessKey: "OIDCKEY0000000000001", Action: "s3:GetObject", Resource: "backups/db"},
+			expectedError: ErrUnresolvedParentPolicy,
+		},
+		{
+			name:          "unknown service account",
+			request:       SimulateAccessKeyPolicyRequest{AccessKey: "UNKNOWN000000000000", Action: "s3:GetObject", Resource: "backups/db"},
+			expectedError: ErrServiceAccountNotFound,
+		},
+		{
+			name:          "unknown action",
+			request:       SimulateAccessKeyPolicyRequest{AccessKey: "BOBBACKUP00000000001", Action: "s3:Teleport", Resource: "backups/db"},
+			expectedError: ErrInvalidPolicySimulation,
+		},
+		{
+			name:          "missing bucket",
+			request:       SimulateAccessKeyPolicyRequest{AccessKey: "BOBBACKUP00000000001", Action: "s3:GetObject"},
+			expectedError: ErrInvalidPolicySimulation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			mockServer.AddServiceAccountToStore("BOBBACKUP00000000001", "", "backup", "", "enabled", "bob", nil, nil)
+			mockServer.SetUserInfoResponse("bob", madmin.UserInfo{PolicyName: "readbackups", MemberOf: []string{"operators", "retired"}, Status: madmin.AccountEnabled})
+			mockServer.SetGroupDescriptionResponse(madmin.GroupDesc{Name: "operators", Status: string(madmin.GroupEnabled), Policy: "denysecrets,readbackups"})
+			mockServer.SetGroupDescriptionResponse(madmin.GroupDesc{Name: "retired", Status: string(madmin.GroupDisabled), Policy: "consoleAdmin"})
+			mockServer.SetCannedPolicyResponse("readbackups", json.RawMessage(readBackups))
+			mockServer.SetCannedPolicyResponse("denysecrets", json.RawMessage(denySecrets))
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			service := NewSimulateAccessKeyPolicyService(minioClient, "minioadmin")
+
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			result, err := service.Execute(ctx, tt.request)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result.Decision != tt.expectedDecision {
+				t.Errorf("Expected decision %q, got %q", tt.expectedDecision, result.Decision)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+var (
+	ErrRotationInProgress     = errors.New("access key is already being rotated")
+	ErrInvalidRotationWindows = errors.New("rotation overlap and grace must not be negative")
+	ErrAccessKeyExpired       = errors.New("access key has expired")
+)
+
+// RotationPolicy configures the default windows of a rotation
+type RotationPolicy struct {
+	Overlap time.Duration // Time both access keys stay valid
+	Grace   time.Duration // Time the old access key stays disabled before it is deleted
+}
+
+type StartAccessKeyRotationService struct {
+	minioClient *madmin.AdminClient
+	cluster     string
+	rotations   *store.Rotations
+	policy      RotationPolicy
+	rules       CredentialRules
+	now         func() time.Time
+
+	// Serializes starts so that an access key cannot be rotated twice at once
+	mu sync.Mutex
+}
+
+// StartAccessKeyRotationRequest represents a rotation of a service account, zero windows use the policy
+type StartAccessKeyRotationRequest struct {
+	AccessKey string
+	Overlap   time.Duration
+	Grace     time.Duration
+}
+
+// StartAccessKeyRotationResponse represents a started rotation with the credentials of the new access key.
+// The secret key is not stored and cannot be retrieved again, unless it is replaced by a reveal link.
+type StartAccessKeyRotationResponse struct {
+	Rotation  store.Rotation `json:"rotation"`
+	AccessKey string         `json:"accessKey"`
+	SecretKey string         `json:"secretKey,omitempty"` // Empty when replaced by a reveal link
+	Reveal    *RevealLink    `json:"reveal,omitempty"`
+}
+
+func NewStartAccessKeyRotationService(minioClient *madmin.AdminClient, cluster string, rotations *store.Rotations, policy RotationPolicy, rules CredentialRules) *StartAccessKeyRotationService {
+	return &StartAccessKeyRotationService{
+		minioClient: minioClient,
+		cluster:     cluster,
+		rotations:   rotations,
+		policy:      policy,
+		rules:       rules,
+		now:         time.Now,
+	}
+}
+
+func (s *StartAccessKeyRotationService) Execute(ctx context.Context, req StartAccessKeyRotationRequest) (*StartAccessKeyRotationResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if req.Overlap < 0 || req.Grace < 0 {
+		return nil, ErrInvalidRotationWindows
+	}
+	if req.Overlap == 0 {
+		req.Overlap = s.policy.Overlap
+	}
+	if req.Grace == 0 {
+		req.Grace = s.policy.Grace
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rotations, err := s.rotations.List()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read rotations")
+		return nil, fmt.Errorf("failed to read rotations: %w", err)
+	}
+	for _, rotation := range rotations {
+		if rotation.Cluster == s.cluster && rotation.OldAccessKey == req.AccessKey && rotation.State != store.RotationCompleted {
+			return nil, fmt.Errorf("%w: rotation %s", ErrRotationInProgress, rotation.ID)
+		}
+	}
+
+	logger.Debug().Str("accessKey", req.AccessKey).Dur("overlap", req.Overlap).Dur("grace", req.Grace).Msg("Starting access key rotation")
+
+	info, err := s.minioClient.InfoServiceAccount(ctx, req.AccessKey)
+	if err != nil {
+		logger.Error().Err(err).Str("accessKey", req.AccessKey).Msg("Failed to get service account to rotate")
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+
+	accessKey, err := s.rules.GenerateAccessKey()
+	if err != nil {
+		return nil, err
+	}
+	secretKey, err := s.rules.GenerateSecretKey()
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+
+	// A sibling of an expired access key would otherwise never expire
+	if info.Expiration != nil && !info.Expiration.After(now) {
+		return nil, fmt.Errorf("%w: %s expired at %s", ErrAccessKeyExpired, req.AccessKey, info.Expiration.Format(time.RFC3339))
+	}
+
+	// The sibling keeps the parent, the policy and the remaining lifetime of the old access key
+	addReq := madmin.AddServiceAccountReq{
+		AccessKey:   accessKey,
+		SecretKey:   secretKey,
+		TargetUser:  info.ParentUser,
+		Name:        info.Name,
+		Description: info.Description,
+	}
+	if !info.ImpliedPolicy {
+		addReq.Policy = []byte(info.Policy)
+	}
+	if info.Expiration != nil {
+		addReq.Expiration = info.Expiration
+	}
+
+	credentials, err := s.minioClient.AddServiceAccount(ctx, addReq)
+	if err != nil {
+		logger.Error().Err(err).Str("accessKey", req.AccessKey).Msg("Failed to create sibling service account")
+		return nil, fmt.Errorf("failed to create sibling service account: %w", err)
+	}
+
+	id, err := newRotationID()
+	if err != nil {
+		return nil, err
+	}
+
+	rotation := store.Rotation{
+		ID:           id,
+		Cluster:      s.cluster,
+		OldAccessKey: req.AccessKey,
+		NewAccessKey: credentials.AccessKey,
+		ParentUser:   info.ParentUser,
+		Name:         info.Name,
+		State:        store.RotationOverlap,
+		CreatedAt:    now,
+		DisableAt:    now.Add(req.Overlap),
+		DeleteAt:     now.Add(req.Overlap + req.Grace),
+		UpdatedAt:    now,
+	}
+	if err := s.rotations.Save(rotation); err != nil {
+		// The sibling exists but nothing will retire the old access key, report both to clean up by hand
+		logger.Error().Err(err).Str("accessKey", req.AccessKey).Str("newAccessKey", credentials.AccessKey).Msg("Failed to save rotation")
+		return nil, fmt.Errorf("failed to save rotation of %s to %s: %w", req.AccessKey, credentials.AccessKey, err)
+	}
+
+	logger.Info().
+		Str("rotation", rotation.ID).
+		Str("accessKey", req.AccessKey).
+		Str("newAccessKey", credentials.AccessKey).
+		Time("disableAt", rotation.DisableAt).
+		Msg("Access key rotation started")
+
+	return &StartAccessKeyRotationResponse{
+		Rotation:  rotation,
+		AccessKey: credentials.AccessKey,
+		SecretKey: credentials.SecretKey,
+	}, nil
+}
+
+// newRotationID generates a random identifier of a rotation
+func newRotationID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate rotation ID: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
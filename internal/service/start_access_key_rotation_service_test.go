@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestStartAccessKeyRotationService_Execute(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	policyDocument := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::backups/*"]}]}`
+
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		setupStore     func(*testing.T, *store.Rotations)
+		request        StartAccessKeyRotationRequest
+		expectedError  error
+		expectedErrMsg string
+		validateResult func(t *testing.T, result *StartAccessKeyRotationResponse, mock *minio.MockMinIOServer)
+	}{
+		{
+			name: "sibling inherits parent and policy",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetInfoServiceAccountResponse("BOBBACKUP00000000001", minio.InfoServiceAccountResponse{
+					ParentUser:    "bob",
+					AccountStatus: "enabled",
+					Policy:        json.RawMessage(strconv.Quote(policyDocument)), // MinIO returns the policy as a string
+					Name:          "backup",
+				})
+				mock.SetAddServiceAccountResponse(minio.AddServiceAccountResponse{
+					Credentials: minio.AddServiceAccountCredentials{AccessKey: "BOBBACKUP00000000002", SecretKey: "new-secret-key"},
+				})
+			},
+			request: StartAccessKeyRotationRequest{AccessKey: "BOBBACKUP00000000001", Overlap: 72 * time.Hour},
+			validateResult: func(t *testing.T, result *StartAccessKeyRotationResponse, mock *minio.MockMinIOServer) {
+				if result.AccessKey != "BOBBACKUP00000000002" || result.SecretKey != "new-secret-key" {
+					t.Errorf("Expected the new credentials, got %q and %q", result.AccessKey, result.SecretKey)
+				}
+
+				rotation := result.Rotation
+				if rotation.State != store.RotationOverlap || rotation.Cluster != "default" {
+					t.Errorf("Expected overlapping rotation of the default cluster, got %q of %q", rotation.State, rotation.Cluster)
+				}
+				if !rotation.DisableAt.Equal(now.Add(72 * time.Hour)) {
+					t.Errorf("Expected DisableAt %v, got %v", now.Add(72*time.Hour), rotation.DisableAt)
+				}
+				if !rotation.DeleteAt.Equal(now.Add(96 * time.Hour)) {
+					t.Errorf("Expected DeleteAt from the default grace %v, got %v", now.Add(96*time.Hour), rotation.DeleteAt)
+				}
+
+				adds := mock.Requests("add-service-account")
+				if len(adds) != 1 {
+					t.Fatalf("Expected %d add request, got %d", 1, len(adds))
+				}
+				if adds[0].Get("targetUser") != "bob" || adds[0].Get("name") != "backup" {
+					t.Errorf("Expected sibling of bob named backup, got %v", adds[0])
+				}
+				if !strings.Contains(adds[0].Get("policy"), "backups/*") {
+					t.Errorf("Expected the policy to be copied, got %q", adds[0].Get("policy"))
+				}
+			},
+		},
+		{
+			name: "implied policy is not copied",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.AddServiceAccountToStore("BOBBACKUP00000000001", "", "backup", "", "enabled", "bob", nil, nil)
+			},
+			request: StartAccessKeyRotationRequest{AccessKey: "BOBBACKUP00000000001"},
+			validateResult: func(t *testing.T, result *StartAccessKeyRotationResponse, mock *minio.MockMinIOServer) {
+				adds := mock.Requests("add-service-account")
+				if len(adds) != 1 || adds[0].Get("policy") != "" {
+					t.Errorf("Expected a sibling without policy, got %v", adds)
+				}
+				if !result.Rotation.DisableAt.Equal(now.Add(7 * 24 * time.Hour)) {
+					t.Errorf("Expected DisableAt from the default overlap %v, got %v", now.Add(7*24*time.Hour), result.Rotation.DisableAt)
+				}
+			},
+		},
+		{
+			name: "access key already being rotated",
+			setupStore: func(t *testing.T, rotations *store.Rotations) {
+				if err := rotations.Save(store.Rotation{ID: "pending", Cluster: "default", OldAccessKey: "BOBBACKUP00000000001", State: store.RotationDisabled}); err != nil {
+					t.Fatalf("Failed to seed rotation: %v", err)
+				}
+			},
+			request:       StartAccessKeyRotationRequest{AccessKey: "BOBBACKUP00000000001"},
+			expectedError: ErrRotationInProgress,
+		},
+		{
+			name: "expired access key",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				expiration := now.Add(-time.Hour)
+				mock.AddServiceAccountToStore("BOBBACKUP00000000001", "", "backup", "", "enabled", "bob", nil, &expiration)
+			},
+			request:       StartAccessKeyRotationRequest{AccessKey: "BOBBACKUP00000000001"},
+			expectedError: ErrAccessKeyExpired,
+		},
+		{
+			name:          "negative overlap",
+			request:       StartAccessKeyRotationRequest{AccessKey: "BOBBACKUP00000000001", Overlap: -time.Hour},
+			expectedError: ErrInvalidRotationWindows,
+		},
+		{
+			name:           "unknown service account",
+			request:        StartAccessKeyRotationRequest{AccessKey: "UNKNOWN000000000000"},
+			expectedErrMsg: "failed to get service account",
+		},
+		{
+			name: "sibling creation error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.AddServiceAccountToStore("BOBBACKUP00000000001", "", "backup", "", "enabled", "bob", nil, nil)
+				mock.SetAddServiceAccountError(http.StatusBadRequest, "Bad Request")
+			},
+			request:        StartAccessKeyRotationRequest{AccessKey: "BOBBACKUP00000000001"},
+			expectedErrMsg: "failed to create sibling service account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			rotations := newTestRotations(t)
+			if tt.setupStore != nil {
+				tt.setupStore(t, rotations)
+			}
+
+			service := NewStartAccessKeyRotationService(minioClient, "default", rotations, RotationPolicy{Overlap: 7 * 24 * time.Hour, Grace: 24 * time.Hour}, DefaultCredentialRules())
+			service.now = func() time.Time { return now }
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := service.Execute(ctx, tt.request)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if tt.expectedErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErrMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedErrMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			stored, err := rotations.List()
+			if err != nil {
+				t.Fatalf("Failed to read rotations: %v", err)
+			}
+			if len(stored) != 1 || stored[0].ID != result.Rotation.ID {
+				t.Fatalf("Expected the rotation to be saved, got %+v", stored)
+			}
+
+			tt.validateResult(t, result, mockServer)
+		})
+	}
+}
+
+// newTestRotations creates rotations backed by a temporary database
+func newTestRotations(t *testing.T) *store.Rotations {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	rotations, err := store.NewRotations(db)
+	if err != nil {
+		t.Fatalf("Failed to create rotations: %v", err)
+	}
+
+	return rotations
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type StartHealService struct {
+	minioClient *madmin.AdminClient
+}
+
+// StartHealRequest represents the request to start a heal sequence.
+// An empty bucket heals the whole cluster, prefix requires a bucket.
+type StartHealRequest struct {
+	Bucket    string `json:"bucket"`
+	Prefix    string `json:"prefix"`
+	DryRun    bool   `json:"dryRun"`    // Only report what would be healed
+	DeepScan  bool   `json:"deepScan"`  // Verify bitrot checksums instead of checking parts presence only
+	Remove    bool   `json:"remove"`    // Remove dangling objects and parts
+	Recursive bool   `json:"recursive"` // Heal everything below the bucket or prefix
+	Force     bool   `json:"force"`     // Replace a heal sequence already running on the same path
+}
+
+// HealSequence identifies a started or stopped heal sequence
+type HealSequence struct {
+	ClientToken   string    `json:"clientToken"`
+	ClientAddress string    `json:"clientAddress"`
+	StartTime     time.Time `json:"startTime"`
+	Bucket        string    `json:"bucket"`
+	Prefix        string    `json:"prefix"`
+}
+
+func NewStartHealService(minioClient *madmin.AdminClient) *StartHealService {
+	return &StartHealService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *StartHealService) Execute(ctx context.Context, req StartHealRequest) (*HealSequence, error) {
+	logger := zerolog.Ctx(ctx)
+
+	req.Bucket = strings.TrimSpace(req.Bucket)
+	req.Prefix = strings.TrimSpace(req.Prefix)
+	if req.Prefix != "" && req.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required when prefix is set")
+	}
+
+	opts := madmin.HealOpts{
+		Recursive: req.Recursive,
+		DryRun:    req.DryRun,
+		Remove:    req.Remove,
+		ScanMode:  madmin.HealNormalScan,
+	}
+	if req.DeepScan {
+		opts.ScanMode = madmin.HealDeepScan
+	}
+
+	logger.Debug().
+		Str("bucket", req.Bucket).
+		Str("prefix", req.Prefix).
+		Bool("dryRun", req.DryRun).
+		Bool("deepScan", req.DeepScan).
+		Msg("Starting heal sequence")
+
+	started, _, err := s.minioClient.Heal(ctx, req.Bucket, req.Prefix, opts, "", req.Force, false)
+	if err != nil {
+		logger.Error().Err(err).Str("bucket", req.Bucket).Msg("Failed to start heal sequence")
+		return nil, fmt.Errorf("failed to start heal: %w", err)
+	}
+
+	logger.Info().
+		Str("clientToken", started.ClientToken).
+		Str("bucket", req.Bucket).
+		Str("prefix", req.Prefix).
+		Msg("Successfully started heal sequence")
+
+	return &HealSequence{
+		ClientToken:   started.ClientToken,
+		ClientAddress: started.ClientAddress,
+		StartTime:     started.StartTime,
+		Bucket:        req.Bucket,
+		Prefix:        req.Prefix,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestStartHealService_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		request        StartHealRequest
+		expectedError  string
+		validateResult func(t *testing.T, result *HealSequence, mock *minio.MockMinIOServer)
+	}{
+		{
+			name: "successful heal of a prefix",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStartResponse(scenarios.SuccessfulHealStart())
+			},
+			request: StartHealRequest{Bucket: "media", Prefix: "videos/", DeepScan: true, Recursive: true},
+			validateResult: func(t *testing.T, result *HealSequence, mock *minio.MockMinIOServer) {
+				if result.ClientToken != "3f1c1b8e-heal-token" {
+					t.Errorf("Expected ClientToken %q, got %q", "3f1c1b8e-heal-token", result.ClientToken)
+				}
+				if result.Bucket != "media" || result.Prefix != "videos/" {
+					t.Errorf("Expected path %q, got %q", "media/videos/", result.Bucket+"/"+result.Prefix)
+				}
+				if requests := mock.Requests("heal-start"); len(requests) != 1 {
+					t.Errorf("Expected %d heal start request, got %d", 1, len(requests))
+				}
+			},
+		},
+		{
+			name: "force start replaces running sequence",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStartResponse(scenarios.SuccessfulHealStart())
+			},
+			request: StartHealRequest{Bucket: "media", DryRun: true, Force: true},
+			validateResult: func(t *testing.T, result *HealSequence, mock *minio.MockMinIOServer) {
+				requests := mock.Requests("heal-start")
+				if len(requests) != 1 {
+					t.Fatalf("Expected %d heal start request, got %d", 1, len(requests))
+				}
+				if got := requests[0].Get("forceStart"); got != "true" {
+					t.Errorf("Expected forceStart %q, got %q", "true", got)
+				}
+			},
+		},
+		{
+			name:          "prefix without bucket",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			request:       StartHealRequest{Prefix: "videos/"},
+			expectedError: "bucket is required",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetHealError(409, "Heal already running")
+			},
+			request:       StartHealRequest{Bucket: "media"},
+			expectedError: "failed to start heal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewStartHealService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.validateResult != nil {
+				tt.validateResult(t, result, mockServer)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+type StopHealService struct {
+	minioClient *madmin.AdminClient
+}
+
+// StopHealRequest identifies the heal sequence to stop by the path it was started on
+type StopHealRequest struct {
+	Bucket string
+	Prefix string
+}
+
+func NewStopHealService(minioClient *madmin.AdminClient) *StopHealService {
+	return &StopHealService{
+		minioClient: minioClient,
+	}
+}
+
+func (s *StopHealService) Execute(ctx context.Context, req StopHealRequest) (*HealSequence, error) {
+	logger := zerolog.Ctx(ctx)
+
+	req.Bucket = strings.TrimSpace(req.Bucket)
+	req.Prefix = strings.TrimSpace(req.Prefix)
+	if req.Prefix != "" && req.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required when prefix is set")
+	}
+
+	logger.Debug().Str("bucket", req.Bucket).Str("prefix", req.Prefix).Msg("Stopping heal sequence")
+
+	// MinIO answers a force stop with the information of the stopped sequence
+	stopped, _, err := s.minioClient.Heal(ctx, req.Bucket, req.Prefix, madmin.HealOpts{}, "", false, true)
+	if err != nil {
+		logger.Error().Err(err).Str("bucket", req.Bucket).Msg("Failed to stop heal sequence")
+		return nil, fmt.Errorf("failed to stop heal: %w", err)
+	}
+
+	logger.Info().
+		Str("clientToken", stopped.ClientToken).
+		Str("bucket", req.Bucket).
+		Str("prefix", req.Prefix).
+		Msg("Successfully stopped heal sequence")
+
+	return &HealSequence{
+		ClientToken:   stopped.ClientToken,
+		ClientAddress: stopped.ClientAddress,
+		StartTime:     stopped.StartTime,
+		Bucket:        req.Bucket,
+		Prefix:        req.Prefix,
+	}, nil
+}
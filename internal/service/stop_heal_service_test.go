@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+func TestStopHealService_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*minio.MockMinIOServer)
+		request       StopHealRequest
+		expectedError string
+	}{
+		{
+			name: "successful stop",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				scenarios := minio.TestScenarios{}
+				mock.SetHealStartResponse(scenarios.SuccessfulHealStart())
+			},
+			request: StopHealRequest{Bucket: "media"},
+		},
+		{
+			name:          "prefix without bucket",
+			setupMock:     func(mock *minio.MockMinIOServer) {},
+			request:       StopHealRequest{Prefix: "videos/"},
+			expectedError: "bucket is required",
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetHealError(404, "No heal sequence running")
+			},
+			request:       StopHealRequest{Bucket: "media"},
+			expectedError: "failed to stop heal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			tt.setupMock(mockServer)
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewStopHealService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got no error", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result.ClientToken != "3f1c1b8e-heal-token" {
+				t.Errorf("Expected ClientToken %q, got %q", "3f1c1b8e-heal-token", result.ClientToken)
+			}
+
+			requests := mockServer.Requests("heal-stop")
+			if len(requests) != 1 {
+				t.Fatalf("Expected %d heal stop request, got %d", 1, len(requests))
+			}
+			if got := requests[0].Get("forceStop"); got != "true" {
+				t.Errorf("Expected forceStop %q, got %q", "true", got)
+			}
+		})
+	}
+}
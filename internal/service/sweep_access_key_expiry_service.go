@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+// Actions applied to expired service accounts by an expiry sweep
+const (
+	ExpiryActionNone    = "none"
+	ExpiryActionDisable = "disable"
+	ExpiryActionDelete  = "delete"
+)
+
+// ExpiryNotifier delivers the report of an expiry sweep
+type ExpiryNotifier interface {
+	Name() string
+	Notify(ctx context.Context, sweep store.ExpirySweep) error
+}
+
+// ExpiryPolicy configures which service accounts a sweep reports and what happens to the expired ones
+type ExpiryPolicy struct {
+	Cluster   string
+	Warning   time.Duration // Service accounts expiring within this window are reported
+	Action    string
+	Retention time.Duration // Sweeps older than this are removed, zero keeps them forever
+}
+
+type SweepAccessKeyExpiryService struct {
+	listAccessKeysService       *ListAccessKeysService
+	updateServiceAccountService *UpdateServiceAccountService
+	deleteServiceAccountService *DeleteServiceAccountService
+	sweeps                      *store.ExpirySweeps
+	policy                      ExpiryPolicy
+	notifiers                   []ExpiryNotifier
+	now                         func() time.Time
+}
+
+// NewSweepAccessKeyExpiryService creates the service reporting expiring service accounts to the notifiers.
+// Every sweep is saved into sweeps, including the sweeps without any service account found.
+func NewSweepAccessKeyExpiryService(minioClient *madmin.AdminClient, serviceAccounts *ServiceAccountCache, sweeps *store.ExpirySweeps, policy ExpiryPolicy, notifiers ...ExpiryNotifier) *SweepAccessKeyExpiryService {
+	return &SweepAccessKeyExpiryService{
+		listAccessKeysService:       NewListAccessKeysService(minioClient, serviceAccounts),
+		updateServiceAccountService: NewUpdateServiceAccountService(minioClient, serviceAccounts),
+		deleteServiceAccountService: NewDeleteServiceAccountService(minioClient, serviceAccounts),
+		sweeps:                      sweeps,
+		policy:                      policy,
+		notifiers:                   notifiers,
+		now:                         time.Now,
+	}
+}
+
+// ValidExpiryAction reports whether action is a supported action for expired service accounts
+func ValidExpiryAction(action string) bool {
+	switch action {
+	case ExpiryActionNone, ExpiryActionDisable, ExpiryActionDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *SweepAccessKeyExpiryService) Execute(ctx context.Context) (*store.ExpirySweep, error) {
+	logger := zerolog.Ctx(ctx)
+	logger.Debug().Str("action", s.policy.Action).Dur("warning", s.policy.Warning).Msg("Sweeping expiring access keys")
+
+	now := s.now().UTC()
+	listed, err := s.listAccessKeysService.Execute(ctx, ListAccessKeysOptions{Type: "serviceAccounts"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sweep access keys: %w", err)
+	}
+
+	sweep := store.ExpirySweep{
+		Timestamp:     now,
+		Cluster:       s.policy.Cluster,
+		Action:        s.policy.Action,
+		Keys:          []store.ExpiryKey{},
+		Notifications: []store.ExpiryNotification{},
+	}
+
+	for _, key := range listed.AccessKeys {
+		if key.Type != "serviceAccount" || key.Expiration == nil {
+			continue
+		}
+
+		expiration, err := time.Parse(time.RFC3339, *key.Expiration)
+		if err != nil {
+			logger.Warn().Err(err).Str("accessKey", key.AccessKey).Msg("Skipping access key with invalid expiration")
+			continue
+		}
+		if expiration.After(now.Add(s.policy.Warning)) {
+			continue
+		}
+
+		expiryKey := store.ExpiryKey{
+			AccessKey:  key.AccessKey,
+			ParentUser: key.ParentUser,
+			Name:       key.Name,
+			Expiration: expiration,
+			Expired:    !expiration.After(now),
+		}
+		if expiryKey.Expired {
+			sweep.Expired++
+			s.apply(ctx, key, &expiryKey)
+		} else {
+			sweep.Expiring++
+		}
+
+		sweep.Keys = append(sweep.Keys, expiryKey)
+	}
+
+	slices.SortFunc(sweep.Keys, func(a, b store.ExpiryKey) int {
+		return a.Expiration.Compare(b.Expiration)
+	})
+
+	// Nothing to remind about, keep the notifiers quiet
+	if len(sweep.Keys) > 0 {
+		for _, notifier := range s.notifiers {
+			notification := store.ExpiryNotification{Notifier: notifier.Name()}
+			if err := notifier.Notify(ctx, sweep); err != nil {
+				logger.Error().Err(err).Str("notifier", notifier.Name()).Msg("Failed to send expiry notification")
+				notification.Error = err.Error()
+			}
+			sweep.Notifications = append(sweep.Notifications, notification)
+		}
+	}
+
+	if err := s.sweeps.Save(sweep); err != nil {
+		logger.Error().Err(err).Msg("Failed to save expiry sweep")
+		return nil, fmt.Errorf("failed to save expiry sweep: %w", err)
+	}
+
+	if s.policy.Retention > 0 {
+		removed, err := s.sweeps.Prune(now.Add(-s.policy.Retention))
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to prune expiry sweeps")
+			return nil, err
+		}
+		if removed > 0 {
+			logger.Debug().Int("removed", removed).Msg("Pruned old expiry sweeps")
+		}
+	}
+
+	logger.Info().
+		Int("expiring", sweep.Expiring).
+		Int("expired", sweep.Expired).
+		Msg("Access key expiry sweep completed")
+
+	return &sweep, nil
+}
+
+// apply runs the policy action on an expired service account and records the outcome on expiryKey
+func (s *SweepAccessKeyExpiryService) apply(ctx context.Context, key AccessKeyInfo, expiryKey *store.ExpiryKey) {
+	var err error
+	switch s.policy.Action {
+	case ExpiryActionDisable:
+		// Disabled accounts are left alone so that every sweep does not update them again
+		if key.AccountStatus == string(madmin.AccountDisabled) || key.AccountStatus == "off" {
+			return
+		}
+		_, err = s.updateServiceAccountService.Execute(ctx, UpdateServiceAccountRequest{AccessKey: key.AccessKey, NewStatus: string(madmin.AccountDisabled)})
+	case ExpiryActionDelete:
+		_, err = s.deleteServiceAccountService.Execute(ctx, DeleteServiceAccountRequest{AccessKey: key.AccessKey})
+	default:
+		return
+	}
+
+	expiryKey.Action = s.policy.Action
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("accessKey", key.AccessKey).Str("action", s.policy.Action).Msg("Failed to apply expiry action")
+		expiryKey.Error = err.Error()
+	}
+}
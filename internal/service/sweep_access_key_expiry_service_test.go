@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/rs/zerolog"
+)
+
+// testExpiryNotifier records the sweeps it is notified about and fails with err when set
+type testExpiryNotifier struct {
+	err    error
+	sweeps []store.ExpirySweep
+}
+
+func (n *testExpiryNotifier) Name() string {
+	return "test"
+}
+
+func (n *testExpiryNotifier) Notify(ctx context.Context, sweep store.ExpirySweep) error {
+	n.sweeps = append(n.sweeps, sweep)
+	return n.err
+}
+
+func TestSweepAccessKeyExpiryService_Execute(t *testing.T) {
+	// The CI accounts of the scenario expire at the start of 2024 and 2030
+	beforeValidExpiry := time.Date(2029, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func(*minio.MockMinIOServer)
+		now            time.Time
+		action         string
+		notifyErr      error
+		expectedError  string
+		validateResult func(t *testing.T, sweep *store.ExpirySweep, notifier *testExpiryNotifier, mock *minio.MockMinIOServer)
+	}{
+		{
+			name:   "expiring and expired service accounts are reported",
+			now:    beforeValidExpiry,
+			action: ExpiryActionNone,
+			validateResult: func(t *testing.T, sweep *store.ExpirySweep, notifier *testExpiryNotifier, mock *minio.MockMinIOServer) {
+				if sweep.Expired != 1 || sweep.Expiring != 1 {
+					t.Fatalf("Expected %d expired and %d expiring, got %d and %d", 1, 1, sweep.Expired, sweep.Expiring)
+				}
+				if sweep.Keys[0].AccessKey != "ALICECI0000000000001" || !sweep.Keys[0].Expired {
+					t.Errorf("Expected the expired CI account first, got %+v", sweep.Keys[0])
+				}
+				if sweep.Keys[1].AccessKey != "ALICECI0000000000002" || sweep.Keys[1].Expired {
+					t.Errorf("Expected the expiring CI account second, got %+v", sweep.Keys[1])
+				}
+				if sweep.Keys[0].Action != "" {
+					t.Errorf("Expected no action, got %q", sweep.Keys[0].Action)
+				}
+				if updates := mock.Requests("update-service-account"); len(updates) != 0 {
+					t.Errorf("Expected no updates, got %d", len(updates))
+				}
+
+				if len(notifier.sweeps) != 1 {
+					t.Fatalf("Expected %d notification, got %d", 1, len(notifier.sweeps))
+				}
+				if len(sweep.Notifications) != 1 || sweep.Notifications[0].Error != "" {
+					t.Errorf("Expected one delivered notification, got %+v", sweep.Notifications)
+				}
+			},
+		},
+		{
+			name:   "expired service accounts are disabled",
+			now:    beforeValidExpiry,
+			action: ExpiryActionDisable,
+			validateResult: func(t *testing.T, sweep *store.ExpirySweep, notifier *testExpiryNotifier, mock *minio.MockMinIOServer) {
+				updates := mock.Requests("update-service-account")
+				if len(updates) != 1 || updates[0].Get("accessKey") != "ALICECI0000000000001" {
+					t.Fatalf("Expected one update of the expired CI account, got %v", updates)
+				}
+				if sweep.Keys[0].Action != ExpiryActionDisable || sweep.Keys[0].Error != "" {
+					t.Errorf("Expected the expired CI account to be disabled, got %+v", sweep.Keys[0])
+				}
+				if sweep.Keys[1].Action != "" {
+					t.Errorf("Expected the expiring CI account to be left alone, got %q", sweep.Keys[1].Action)
+				}
+			},
+		},
+		{
+			name: "failed action is recorded",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetServiceAccountError("ALICECI0000000000001", http.StatusBadRequest, "Access Denied")
+			},
+			now:    beforeValidExpiry,
+			action: ExpiryActionDelete,
+			validateResult: func(t *testing.T, sweep *store.ExpirySweep, notifier *testExpiryNotifier, mock *minio.MockMinIOServer) {
+				if sweep.Keys[0].Action != ExpiryActionDelete || sweep.Keys[0].Error == "" {
+					t.Errorf("Expected the failed delete to be recorded, got %+v", sweep.Keys[0])
+				}
+			},
+		},
+		{
+			name:   "nothing to report",
+			now:    time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+			action: ExpiryActionDelete,
+			validateResult: func(t *testing.T, sweep *store.ExpirySweep, notifier *testExpiryNotifier, mock *minio.MockMinIOServer) {
+				if len(sweep.Keys) != 0 {
+					t.Errorf("Expected no keys, got %+v", sweep.Keys)
+				}
+				if len(notifier.sweeps) != 0 || len(sweep.Notifications) != 0 {
+					t.Errorf("Expected no notifications, got %d", len(notifier.sweeps))
+				}
+			},
+		},
+		{
+			name:      "failed notification is recorded",
+			now:       beforeValidExpiry,
+			action:    ExpiryActionNone,
+			notifyErr: errors.New("connection refused"),
+			validateResult: func(t *testing.T, sweep *store.ExpirySweep, notifier *testExpiryNotifier, mock *minio.MockMinIOServer) {
+				if len(sweep.Notifications) != 1 || sweep.Notifications[0].Error != "connection refused" {
+					t.Errorf("Expected the failed notification to be recorded, got %+v", sweep.Notifications)
+				}
+			},
+		},
+		{
+			name: "MinIO server error",
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetAccessKeysBulkError(http.StatusBadRequest, "Bad Request")
+			},
+			now:           beforeValidExpiry,
+			action:        ExpiryActionNone,
+			expectedError: "failed to sweep access keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			scenarios := minio.TestScenarios{}
+			users, accessKeys, stored := scenarios.BulkServiceAccounts()
+			mockServer.SetUsersResponse(users)
+			mockServer.SetAccessKeysBulkResponse(accessKeys)
+			for _, info := range stored {
+				mockServer.AddServiceAccountToStore(info.AccessKey, "", info.Name, info.Description, info.Status, info.ParentUser, nil, info.Expiration)
+			}
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			sweeps := newTestExpirySweeps(t)
+			notifier := &testExpiryNotifier{err: tt.notifyErr}
+			policy := ExpiryPolicy{
+				Cluster: "default",
+				Warning: 30 * 24 * time.Hour,
+				Action:  tt.action,
+			}
+
+			service := NewSweepAccessKeyExpiryService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL), sweeps, policy, notifier)
+			service.now = func() time.Time { return tt.now }
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			result, err := service.Execute(ctx)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			latest, err := sweeps.Latest(1)
+			if err != nil {
+				t.Fatalf("Failed to read sweeps: %v", err)
+			}
+			if len(latest) != 1 || !latest[0].Timestamp.Equal(tt.now) {
+				t.Fatalf("Expected the sweep to be saved at %v, got %+v", tt.now, latest)
+			}
+
+			tt.validateResult(t, result, notifier, mockServer)
+		})
+	}
+}
+
+// newTestExpirySweeps creates expiry sweeps backed by a temporary database
+func newTestExpirySweeps(t *testing.T) *store.ExpirySweeps {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	sweeps, err := store.NewExpirySweeps(db)
+	if err != nil {
+		t.Fatalf("Failed to create expiry sweeps: %v", err)
+	}
+
+	return sweeps
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+const (
+	LDAPPolicyAttach = "attach"
+	LDAPPolicyDetach = "detach"
+)
+
+// ErrInvalidLDAPPolicyMapping is returned when a policy mapping change is incomplete or ambiguous
+var ErrInvalidLDAPPolicyMapping = errors.New("invalid LDAP policy mapping")
+
+type UpdateLDAPPolicyMappingService struct {
+	minioClient *madmin.AdminClient
+}
+
+// LDAPPolicyMappingRequest represents attaching or detaching policies to exactly one LDAP user or group DN
+type LDAPPolicyMappingRequest struct {
+	Action   string   `json:"-"`
+	User     string   `json:"user,omitempty"`
+	Group    string   `json:"group,omitempty"`
+	Policies []string `json:"policies"`
+}
+
+// LDAPPolicyMappingResponse represents the policies changed by a mapping request
+type LDAPPolicyMappingResponse struct {
+	Attached  []string  `json:"attached"`
+	Detached  []string  `json:"detached"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func NewUpdateLDAPPolicyMappingService(minioClient *madmin.AdminClient) *UpdateLDAPPolicyMappingService {
+	return &UpdateLDAPPolicyMappingService{
+		minioClient: minioClient,
+	}
+}
+
+// ValidLDAPPolicyAction reports whether action is attach or detach
+func ValidLDAPPolicyAction(action string) bool {
+	return action == LDAPPolicyAttach || action == LDAPPolicyDetach
+}
+
+func (s *UpdateLDAPPolicyMappingService) Execute(ctx context.Context, req LDAPPolicyMappingRequest) (*LDAPPolicyMappingResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !ValidLDAPPolicyAction(req.Action) {
+		return nil, fmt.Errorf("%w: unknown action %q", ErrInvalidLDAPPolicyMapping, req.Action)
+	}
+
+	association := madmin.PolicyAssociationReq{
+		Policies: req.Policies,
+		User:     req.User,
+		Group:    req.Group,
+	}
+	if err := association.IsValid(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLDAPPolicyMapping, err)
+	}
+
+	logger.Debug().
+		Str("action", req.Action).
+		Str("user", req.User).
+		Str("group", req.Group).
+		Strs("policies", req.Policies).
+		Msg("Updating MinIO LDAP policy mapping")
+
+	var result madmin.PolicyAssociationResp
+	var err error
+	if req.Action == LDAPPolicyAttach {
+		result, err = s.minioClient.AttachPolicyLDAP(ctx, association)
+	} else {
+		result, err = s.minioClient.DetachPolicyLDAP(ctx, association)
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("action", req.Action).Msg("Failed to update MinIO LDAP policy mapping")
+		return nil, fmt.Errorf("failed to %s LDAP policy: %w", req.Action, err)
+	}
+
+	logger.Info().
+		Str("action", req.Action).
+		Strs("attached", result.PoliciesAttached).
+		Strs("detached", result.PoliciesDetached).
+		Msg("Successfully updated MinIO LDAP policy mapping")
+
+	return &LDAPPolicyMappingResponse{
+		Attached:  nonNilStrings(result.PoliciesAttached),
+		Detached:  nonNilStrings(result.PoliciesDetached),
+		UpdatedAt: result.UpdatedAt,
+	}, nil
+}
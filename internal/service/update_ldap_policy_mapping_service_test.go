@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/testability/minio"
+	"github.com/minio/madmin-go/v4"
+	"github.com/rs/zerolog"
+)
+
+func TestUpdateLDAPPolicyMappingService_Execute(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		request        LDAPPolicyMappingRequest
+		setupMock      func(*minio.MockMinIOServer)
+		expectedError  string
+		invalid        bool // Expect ErrInvalidLDAPPolicyMapping
+		validateResult func(t *testing.T, result *LDAPPolicyMappingResponse, mock *minio.MockMinIOServer)
+	}{
+		{
+			name: "attach policy to group",
+			request: LDAPPolicyMappingRequest{
+				Action:   LDAPPolicyAttach,
+				Group:    "cn=admins,ou=groups,dc=example,dc=com",
+				Policies: []string{"consoleAdmin"},
+			},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPPolicyAssociationResponse(madmin.PolicyAssociationResp{
+					PoliciesAttached: []string{"consoleAdmin"},
+					UpdatedAt:        updatedAt,
+				})
+			},
+			validateResult: func(t *testing.T, result *LDAPPolicyMappingResponse, mock *minio.MockMinIOServer) {
+				requests := mock.Requests("ldap-policy-attach")
+				if len(requests) != 1 {
+					t.Fatalf("Expected %d attach request, got %d", 1, len(requests))
+				}
+				if got := requests[0].Get("group"); got != "cn=admins,ou=groups,dc=example,dc=com" {
+					t.Errorf("Expected group DN to be sent, got %q", got)
+				}
+				if len(result.Attached) != 1 || result.Attached[0] != "consoleAdmin" {
+					t.Errorf("Expected consoleAdmin attached, got %v", result.Attached)
+				}
+				if result.Detached == nil {
+					t.Error("Expected empty detached list instead of nil")
+				}
+				if !result.UpdatedAt.Equal(updatedAt) {
+					t.Errorf("Expected updated at %v, got %v", updatedAt, result.UpdatedAt)
+				}
+			},
+		},
+		{
+			name: "detach policy from user",
+			request: LDAPPolicyMappingRequest{
+				Action:   LDAPPolicyDetach,
+				User:     "uid=alice,ou=people,dc=example,dc=com",
+				Policies: []string{"readwrite"},
+			},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetLDAPPolicyAssociationResponse(madmin.PolicyAssociationResp{
+					PoliciesDetached: []string{"readwrite"},
+					UpdatedAt:        updatedAt,
+				})
+			},
+			validateResult: func(t *testing.T, result *LDAPPolicyMappingResponse, mock *minio.MockMinIOServer) {
+				if len(mock.Requests("ldap-policy-detach")) != 1 {
+					t.Error("Expected a detach request")
+				}
+				if len(result.Detached) != 1 || result.Detached[0] != "readwrite" {
+					t.Errorf("Expected readwrite detached, got %v", result.Detached)
+				}
+			},
+		},
+		{
+			name:          "unknown action",
+			request:       LDAPPolicyMappingRequest{Action: "replace", User: "uid=alice", Policies: []string{"readwrite"}},
+			invalid:       true,
+			expectedError: `unknown action "replace"`,
+		},
+		{
+			name:          "user and group",
+			request:       LDAPPolicyMappingRequest{Action: LDAPPolicyAttach, User: "uid=alice", Group: "cn=admins", Policies: []string{"readwrite"}},
+			invalid:       true,
+			expectedError: "either a group or a user association must be given",
+		},
+		{
+			name:          "no policies",
+			request:       LDAPPolicyMappingRequest{Action: LDAPPolicyAttach, User: "uid=alice"},
+			invalid:       true,
+			expectedError: "no policy names were given",
+		},
+		{
+			name:    "MinIO server error",
+			request: LDAPPolicyMappingRequest{Action: LDAPPolicyAttach, User: "uid=alice", Policies: []string{"missing"}},
+			setupMock: func(mock *minio.MockMinIOServer) {
+				mock.SetIDPError(404, "The canned policy does not exist")
+			},
+			expectedError: "failed to attach LDAP policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock MinIO server
+			mockServer := minio.NewMockMinIOServer()
+			defer mockServer.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mockServer)
+			}
+
+			// Create MinIO client
+			minioClient, err := mockServer.CreateMinIOClient()
+			if err != nil {
+				t.Fatalf("Failed to create MinIO client: %v", err)
+			}
+
+			// Create service
+			service := NewUpdateLDAPPolicyMappingService(minioClient)
+
+			// Create context with logger
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// Execute test
+			result, err := service.Execute(ctx, tt.request)
+
+			// Validate results
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, but got no error", tt.expectedError)
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				if errors.Is(err, ErrInvalidLDAPPolicyMapping) != tt.invalid {
+					t.Errorf("Expected ErrInvalidLDAPPolicyMapping to be %v, got %v", tt.invalid, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tt.validateResult != nil {
+				tt.validateResult(t, result, mockServer)
+			}
+		})
+	}
+}
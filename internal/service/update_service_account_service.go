@@ -11,7 +11,8 @@ import (
 )
 
 type UpdateServiceAccountService struct {
-	minioClient *madmin.AdminClient
+	minioClient     *madmin.AdminClient
+	serviceAccounts *ServiceAccountCache
 }
 
 type UpdateServiceAccountRequest struct {
@@ -30,9 +31,10 @@ type UpdateServiceAccountResponse struct {
 	NewSecretKey string `json:"newSecretKey,omitempty"` // Only populated when secret key is rotated
 }
 
-func NewUpdateServiceAccountService(minioClient *madmin.AdminClient) *UpdateServiceAccountService {
+func NewUpdateServiceAccountService(minioClient *madmin.AdminClient, serviceAccounts *ServiceAccountCache) *UpdateServiceAccountService {
 	return &UpdateServiceAccountService{
-		minioClient: minioClient,
+		minioClient:     minioClient,
+		serviceAccounts: serviceAccounts,
 	}
 }
 
@@ -92,6 +94,8 @@ func (s *UpdateServiceAccountService) Execute(ctx context.Context, req UpdateSer
 
 	// Execute the update
 	err := s.minioClient.UpdateServiceAccount(ctx, req.AccessKey, updateReq)
+	// A failed request may still have changed the account
+	s.serviceAccounts.Invalidate(req.AccessKey)
 	if err != nil {
 		logger.Error().
 			Err(err).
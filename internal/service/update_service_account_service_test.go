@@ -230,7 +230,7 @@ func TestUpdateServiceAccountService_Execute(t *testing.T) {
 			}
 
 			// Create service
-			service := NewUpdateServiceAccountService(minioClient)
+			service := NewUpdateServiceAccountService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
 
 			// Create context with logger
 			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
@@ -342,7 +342,7 @@ func TestUpdateServiceAccountService_Execute_PolicyValidation(t *testing.T) {
 			}
 
 			// Create service
-			service := NewUpdateServiceAccountService(minioClient)
+			service := NewUpdateServiceAccountService(minioClient, NewServiceAccountCache(DefaultServiceAccountCacheTTL))
 
 			// Create context with logger
 			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
@@ -0,0 +1,124 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var expirySweepsBucket = []byte("expiry_sweeps")
+
+// ExpirySweep represents the outcome of a single access key expiry sweep
+type ExpirySweep struct {
+	Timestamp     time.Time            `json:"timestamp"`
+	Cluster       string               `json:"cluster"`
+	Action        string               `json:"action"` // Applied to expired service accounts: none, disable or delete
+	Keys          []ExpiryKey          `json:"keys"`
+	Expiring      int                  `json:"expiring"`
+	Expired       int                  `json:"expired"`
+	Notifications []ExpiryNotification `json:"notifications"`
+}
+
+// ExpiryKey represents a service account found by a sweep
+type ExpiryKey struct {
+	AccessKey  string    `json:"accessKey"`
+	ParentUser string    `json:"parentUser,omitempty"`
+	Name       string    `json:"name,omitempty"`
+	Expiration time.Time `json:"expiration"`
+	Expired    bool      `json:"expired"`
+	Action     string    `json:"action,omitempty"` // Empty when no action was applied
+	Error      string    `json:"error,omitempty"`
+}
+
+// ExpiryNotification represents the delivery of a sweep report to a notifier
+type ExpiryNotification struct {
+	Notifier string `json:"notifier"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ExpirySweeps stores expiry sweeps ordered by time
+type ExpirySweeps struct {
+	db *bbolt.DB
+}
+
+// NewExpirySweeps prepares the expiry sweeps bucket in db
+func NewExpirySweeps(db *bbolt.DB) (*ExpirySweeps, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(expirySweepsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expiry sweeps bucket: %w", err)
+	}
+
+	return &ExpirySweeps{db: db}, nil
+}
+
+// Save stores a sweep, replacing any sweep run at the same instant
+func (s *ExpirySweeps) Save(sweep ExpirySweep) error {
+	value, err := json.Marshal(sweep)
+	if err != nil {
+		return fmt.Errorf("failed to encode expiry sweep: %w", err)
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(expirySweepsBucket).Put(timeKey(sweep.Timestamp), value)
+	})
+}
+
+// Latest returns up to limit sweeps with the most recent first, zero returns every sweep
+func (s *ExpirySweeps) Latest(limit int) ([]ExpirySweep, error) {
+	sweeps := []ExpirySweep{}
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(expirySweepsBucket).Cursor()
+		for k, v := cursor.Last(); k != nil && (limit <= 0 || len(sweeps) < limit); k, v = cursor.Prev() {
+			var sweep ExpirySweep
+			if err := json.Unmarshal(v, &sweep); err != nil {
+				return fmt.Errorf("failed to decode expiry sweep: %w", err)
+			}
+			sweeps = append(sweeps, sweep)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sweeps, nil
+}
+
+// Count returns the number of stored sweeps
+func (s *ExpirySweeps) Count() (int, error) {
+	count := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		count = tx.Bucket(expirySweepsBucket).Stats().KeyN
+		return nil
+	})
+	return count, err
+}
+
+// Prune deletes the sweeps run before the given time and returns how many were removed
+func (s *ExpirySweeps) Prune(before time.Time) (int, error) {
+	removed := 0
+	end := timeKey(before)
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(expirySweepsBucket).Cursor()
+		for k, _ := cursor.First(); k != nil && bytes.Compare(k, end) < 0; k, _ = cursor.First() {
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune expiry sweeps: %w", err)
+	}
+
+	return removed, nil
+}
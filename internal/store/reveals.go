@@ -0,0 +1,114 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var revealsBucket = []byte("reveals")
+
+// Reveal represents an encrypted secret which can be viewed once.
+// The decryption key is not stored, it is only part of the reveal link.
+type Reveal struct {
+	ID         string    `json:"id"`
+	Ciphertext []byte    `json:"ciphertext"`
+	CreatedAt  time.Time `json:"createdAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// Reveals stores reveals by ID
+type Reveals struct {
+	db *bbolt.DB
+}
+
+// NewReveals prepares the reveals bucket in db
+func NewReveals(db *bbolt.DB) (*Reveals, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(revealsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reveals bucket: %w", err)
+	}
+
+	return &Reveals{db: db}, nil
+}
+
+// Save stores a reveal, replacing the reveal with the same ID
+func (s *Reveals) Save(reveal Reveal) error {
+	value, err := json.Marshal(reveal)
+	if err != nil {
+		return fmt.Errorf("failed to encode reveal: %w", err)
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(revealsBucket).Put([]byte(reveal.ID), value)
+	})
+}
+
+// Take removes the reveal with the ID and returns it, nil when there is none.
+// Concurrent takes of the same reveal return it only once.
+func (s *Reveals) Take(id string) (*Reveal, error) {
+	var reveal *Reveal
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(revealsBucket)
+		value := bucket.Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+
+		reveal = &Reveal{}
+		if err := json.Unmarshal(value, reveal); err != nil {
+			return fmt.Errorf("failed to decode reveal: %w", err)
+		}
+		return bucket.Delete([]byte(id))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reveal, nil
+}
+
+// Prune deletes the reveals expired before the given time and returns how many were removed
+func (s *Reveals) Prune(before time.Time) (int, error) {
+	removed := 0
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(revealsBucket)
+
+		// Reveals are keyed by ID, so every reveal is checked and deleted after the iteration
+		var expired [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			var reveal Reveal
+			if err := json.Unmarshal(v, &reveal); err != nil {
+				return fmt.Errorf("failed to decode reveal: %w", err)
+			}
+			if reveal.ExpiresAt.Before(before) {
+				expired = append(expired, slices.Clone(k))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		removed = len(expired)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune reveals: %w", err)
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+)
+
+func newTestReveals(t *testing.T) *Reveals {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	reveals, err := NewReveals(db)
+	if err != nil {
+		t.Fatalf("Failed to create reveals: %v", err)
+	}
+
+	return reveals
+}
+
+func TestReveals_Take(t *testing.T) {
+	reveals := newTestReveals(t)
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := reveals.Save(Reveal{ID: "a1", Ciphertext: []byte("sealed"), CreatedAt: created, ExpiresAt: created.Add(time.Hour)}); err != nil {
+		t.Fatalf("Failed to save reveal: %v", err)
+	}
+
+	reveal, err := reveals.Take("a1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if reveal == nil {
+		t.Fatal("Expected reveal, got nil")
+	}
+	if string(reveal.Ciphertext) != "sealed" {
+		t.Errorf("Expected ciphertext %q, got %q", "sealed", reveal.Ciphertext)
+	}
+	if !reveal.ExpiresAt.Equal(created.Add(time.Hour)) {
+		t.Errorf("Expected expiration %v, got %v", created.Add(time.Hour), reveal.ExpiresAt)
+	}
+
+	// A reveal is burned after it was taken
+	reveal, err = reveals.Take("a1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if reveal != nil {
+		t.Errorf("Expected no reveal after it was taken, got %+v", reveal)
+	}
+}
+
+func TestReveals_Prune(t *testing.T) {
+	reveals := newTestReveals(t)
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, id := range []string{"c3", "a1", "b2", "d4"} {
+		err := reveals.Save(Reveal{ID: id, CreatedAt: created, ExpiresAt: created.Add(time.Duration(i) * time.Hour)})
+		if err != nil {
+			t.Fatalf("Failed to save reveal: %v", err)
+		}
+	}
+
+	removed, err := reveals.Prune(created.Add(2 * time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("Expected %d removed reveals, got %d", 2, removed)
+	}
+
+	for id, kept := range map[string]bool{"c3": false, "a1": false, "b2": true, "d4": true} {
+		reveal, err := reveals.Take(id)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if (reveal != nil) != kept {
+			t.Errorf("Expected reveal %q kept to be %v", id, kept)
+		}
+	}
+}
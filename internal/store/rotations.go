@@ -0,0 +1,90 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var rotationsBucket = []byte("rotations")
+
+// States of a rotation in the order they are reached
+const (
+	RotationOverlap   = "overlap"   // Both access keys are valid
+	RotationDisabled  = "disabled"  // The old access key is disabled
+	RotationCompleted = "completed" // The old access key is deleted
+)
+
+// Rotation represents the replacement of a service account by a sibling with new credentials
+type Rotation struct {
+	ID           string    `json:"id"`
+	Cluster      string    `json:"cluster"`
+	OldAccessKey string    `json:"oldAccessKey"`
+	NewAccessKey string    `json:"newAccessKey"`
+	ParentUser   string    `json:"parentUser"`
+	Name         string    `json:"name,omitempty"`
+	State        string    `json:"state"`
+	CreatedAt    time.Time `json:"createdAt"`
+	DisableAt    time.Time `json:"disableAt"` // End of the overlap window
+	DeleteAt     time.Time `json:"deleteAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+	Error        string    `json:"error,omitempty"` // Last failure, the step is retried on the next check
+}
+
+// Rotations stores rotations by ID
+type Rotations struct {
+	db *bbolt.DB
+}
+
+// NewRotations prepares the rotations bucket in db
+func NewRotations(db *bbolt.DB) (*Rotations, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(rotationsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rotations bucket: %w", err)
+	}
+
+	return &Rotations{db: db}, nil
+}
+
+// Save stores a rotation, replacing the rotation with the same ID
+func (s *Rotations) Save(rotation Rotation) error {
+	value, err := json.Marshal(rotation)
+	if err != nil {
+		return fmt.Errorf("failed to encode rotation: %w", err)
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(rotationsBucket).Put([]byte(rotation.ID), value)
+	})
+}
+
+// List returns every rotation with the most recently created first
+func (s *Rotations) List() ([]Rotation, error) {
+	rotations := []Rotation{}
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(rotationsBucket).ForEach(func(_, v []byte) error {
+			var rotation Rotation
+			if err := json.Unmarshal(v, &rotation); err != nil {
+				return fmt.Errorf("failed to decode rotation: %w", err)
+			}
+			rotations = append(rotations, rotation)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(rotations, func(a, b Rotation) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return rotations, nil
+}
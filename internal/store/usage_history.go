@@ -0,0 +1,117 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var usageHistoryBucket = []byte("usage_history")
+
+// UsageSnapshot represents the cluster usage at a point in time
+type UsageSnapshot struct {
+	Timestamp     time.Time              `json:"timestamp"`
+	TotalCapacity uint64                 `json:"totalCapacity"`
+	UsedCapacity  uint64                 `json:"usedCapacity"`
+	FreeCapacity  uint64                 `json:"freeCapacity"`
+	ObjectsCount  uint64                 `json:"objectsCount"`
+	BucketsCount  uint64                 `json:"bucketsCount"`
+	Buckets       map[string]BucketUsage `json:"buckets"`
+}
+
+// BucketUsage represents the usage of a single bucket in a snapshot
+type BucketUsage struct {
+	Size         uint64 `json:"size"`
+	ObjectsCount uint64 `json:"objectsCount"`
+}
+
+// UsageHistory stores usage snapshots ordered by time
+type UsageHistory struct {
+	db *bbolt.DB
+}
+
+// NewUsageHistory prepares the usage history bucket in db
+func NewUsageHistory(db *bbolt.DB) (*UsageHistory, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(usageHistoryBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create usage history bucket: %w", err)
+	}
+
+	return &UsageHistory{db: db}, nil
+}
+
+// Save stores a snapshot, replacing any snapshot taken at the same instant
+func (h *UsageHistory) Save(snapshot UsageSnapshot) error {
+	value, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode usage snapshot: %w", err)
+	}
+
+	return h.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(usageHistoryBucket).Put(timeKey(snapshot.Timestamp), value)
+	})
+}
+
+// Range returns the snapshots taken within [from, to] in chronological order
+func (h *UsageHistory) Range(from, to time.Time) ([]UsageSnapshot, error) {
+	snapshots := []UsageSnapshot{}
+	end := timeKey(to)
+
+	err := h.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(usageHistoryBucket).Cursor()
+		for k, v := cursor.Seek(timeKey(from)); k != nil && bytes.Compare(k, end) <= 0; k, v = cursor.Next() {
+			var snapshot UsageSnapshot
+			if err := json.Unmarshal(v, &snapshot); err != nil {
+				return fmt.Errorf("failed to decode usage snapshot: %w", err)
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// Prune deletes the snapshots taken before the given time and returns how many were removed
+func (h *UsageHistory) Prune(before time.Time) (int, error) {
+	removed := 0
+	end := timeKey(before)
+
+	err := h.db.Update(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(usageHistoryBucket).Cursor()
+		// The oldest snapshots come first, so keep removing the first key until it is recent enough
+		for k, _ := cursor.First(); k != nil && bytes.Compare(k, end) < 0; k, _ = cursor.First() {
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune usage history: %w", err)
+	}
+
+	return removed, nil
+}
+
+// timeKey encodes a time as a big-endian key so that byte order matches chronological order.
+// Times before the Unix epoch, including the zero time, map to the first key.
+func timeKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	if t.Before(time.Unix(0, 0)) {
+		return key
+	}
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+	return key
+}
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elct9620/minio-lite-admin/internal/infra"
+)
+
+func newTestUsageHistory(t *testing.T) *UsageHistory {
+	t.Helper()
+
+	db, err := infra.NewBoltDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	history, err := NewUsageHistory(db)
+	if err != nil {
+		t.Fatalf("Failed to create usage history: %v", err)
+	}
+
+	return history
+}
+
+func TestUsageHistory_Range(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		from          time.Time
+		to            time.Time
+		expectedTimes []time.Time
+	}{
+		{
+			name:          "all snapshots",
+			from:          start,
+			to:            start.Add(72 * time.Hour),
+			expectedTimes: []time.Time{start, start.Add(24 * time.Hour), start.Add(48 * time.Hour)},
+		},
+		{
+			name:          "bounds are inclusive",
+			from:          start.Add(24 * time.Hour),
+			to:            start.Add(48 * time.Hour),
+			expectedTimes: []time.Time{start.Add(24 * time.Hour), start.Add(48 * time.Hour)},
+		},
+		{
+			name:          "range without snapshots",
+			from:          start.Add(time.Hour),
+			to:            start.Add(2 * time.Hour),
+			expectedTimes: []time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := newTestUsageHistory(t)
+
+			// Save out of order to ensure results are sorted by time
+			for _, offset := range []time.Duration{48 * time.Hour, 0, 24 * time.Hour} {
+				err := history.Save(UsageSnapshot{
+					Timestamp:    start.Add(offset),
+					UsedCapacity: uint64(offset.Hours()),
+					Buckets:      map[string]BucketUsage{"media": {Size: 1024}},
+				})
+				if err != nil {
+					t.Fatalf("Failed to save snapshot: %v", err)
+				}
+			}
+
+			snapshots, err := history.Range(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if len(snapshots) != len(tt.expectedTimes) {
+				t.Fatalf("Expected %d snapshots, got %d", len(tt.expectedTimes), len(snapshots))
+			}
+			for i, expected := range tt.expectedTimes {
+				if !snapshots[i].Timestamp.Equal(expected) {
+					t.Errorf("Expected snapshot %d at %v, got %v", i, expected, snapshots[i].Timestamp)
+				}
+				if snapshots[i].Buckets["media"].Size != 1024 {
+					t.Errorf("Expected media size %d, got %d", 1024, snapshots[i].Buckets["media"].Size)
+				}
+			}
+		})
+	}
+}
+
+func TestUsageHistory_Prune(t *testing.T) {
+	history := newTestUsageHistory(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range 5 {
+		if err := history.Save(UsageSnapshot{Timestamp: start.Add(time.Duration(i) * time.Hour)}); err != nil {
+			t.Fatalf("Failed to save snapshot: %v", err)
+		}
+	}
+
+	removed, err := history.Prune(start.Add(3 * time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if removed != 3 {
+		t.Errorf("Expected %d removed snapshots, got %d", 3, removed)
+	}
+
+	snapshots, err := history.Range(start, start.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("Expected %d remaining snapshots, got %d", 2, len(snapshots))
+	}
+	if !snapshots[0].Timestamp.Equal(start.Add(3 * time.Hour)) {
+		t.Errorf("Expected oldest snapshot at %v, got %v", start.Add(3*time.Hour), snapshots[0].Timestamp)
+	}
+}
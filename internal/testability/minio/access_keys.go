@@ -3,6 +3,7 @@ package minio
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/minio/madmin-go/v4"
 )
@@ -155,6 +156,14 @@ func (m *MockMinIOServer) handleListAccessKeysBulk(w http.ResponseWriter, r *htt
 		}
 	}
 
+	// MinIO rejects requested users which are not built-in users, e.g. an LDAP DN
+	for _, user := range r.URL.Query()["users"] {
+		if _, exists := responseData[user]; !exists {
+			writeAdminError(w, http.StatusNotFound, "XMinioAdminNoSuchUser", "The specified user does not exist")
+			return
+		}
+	}
+
 	// Encode to JSON
 	jsonData, err := json.Marshal(responseData)
 	if err != nil {
@@ -175,3 +184,84 @@ func (m *MockMinIOServer) handleListAccessKeysBulk(w http.ResponseWriter, r *htt
 		return
 	}
 }
+
+// SetLDAPAccessKeysResponse sets the access keys of LDAP users, keyed by user DN
+func (m *MockMinIOServer) SetLDAPAccessKeysResponse(response map[string]madmin.ListAccessKeysLDAPResp) {
+	m.responses["ldap-access-keys-bulk"] = response
+}
+
+// SetOpenIDAccessKeysResponse sets the access keys of OpenID users grouped by OpenID config
+func (m *MockMinIOServer) SetOpenIDAccessKeysResponse(response []madmin.ListAccessKeysOpenIDResp) {
+	m.responses["openid-access-keys-bulk"] = response
+}
+
+// SetExternalAccessKeysError sets an error response for listing the access keys of an identity provider, ldap or openid
+func (m *MockMinIOServer) SetExternalAccessKeysError(provider string, statusCode int, message string) {
+	m.responses[provider+"-access-keys-bulk-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// handleListAccessKeysLDAPBulk handles the MinIO admin LDAP bulk access keys endpoint, LDAP is not configured by default
+func (m *MockMinIOServer) handleListAccessKeysLDAPBulk(w http.ResponseWriter, r *http.Request) {
+	m.handleListExternalAccessKeys("ldap", w, r)
+}
+
+// handleListAccessKeysOpenIDBulk handles the MinIO admin OpenID bulk access keys endpoint, OpenID is not configured by default
+func (m *MockMinIOServer) handleListAccessKeysOpenIDBulk(w http.ResponseWriter, r *http.Request) {
+	m.handleListExternalAccessKeys("openid", w, r)
+}
+
+func (m *MockMinIOServer) handleListExternalAccessKeys(provider string, w http.ResponseWriter, r *http.Request) {
+	operation := provider + "-access-keys-bulk"
+	m.recordRequest(operation, r)
+
+	if errorResponse, exists := m.responses[operation+"-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	response, exists := m.responses[operation]
+	if !exists {
+		http.Error(w, "Identity provider is not configured", http.StatusBadRequest)
+		return
+	}
+	if ldap, ok := response.(map[string]madmin.ListAccessKeysLDAPResp); ok {
+		response = lookupLDAPUsers(ldap, r.URL.Query()["userDNs"])
+	}
+
+	jsonData, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	writeEncrypted(w, jsonData)
+}
+
+// lookupLDAPUsers keeps the DNs of the requested users like the lookup bind of MinIO, a user is found by its DN
+// or by the uid of its DN. Users which are not found are left out, no users lists every DN.
+func lookupLDAPUsers(response map[string]madmin.ListAccessKeysLDAPResp, users []string) map[string]madmin.ListAccessKeysLDAPResp {
+	if len(users) == 0 {
+		return response
+	}
+
+	found := make(map[string]madmin.ListAccessKeysLDAPResp, len(users))
+	for dn, keys := range response {
+		for _, user := range users {
+			if strings.EqualFold(dn, user) || strings.HasPrefix(strings.ToLower(dn), "uid="+strings.ToLower(user)+",") {
+				found[dn] = keys
+			}
+		}
+	}
+	return found
+}
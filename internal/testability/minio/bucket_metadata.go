@@ -0,0 +1,88 @@
+package minio
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetBucketMetadataExportResponse sets the zip archive returned by bucket metadata export requests
+func (m *MockMinIOServer) SetBucketMetadataExportResponse(archive []byte) {
+	m.responses["export-bucket-metadata"] = archive
+}
+
+// SetBucketMetadataImportResponse sets the status report returned by bucket metadata import requests
+func (m *MockMinIOServer) SetBucketMetadataImportResponse(result madmin.BucketMetaImportErrs) {
+	m.responses["import-bucket-metadata"] = result
+}
+
+// SetBucketMetadataError sets an error response for bucket metadata export and import requests
+func (m *MockMinIOServer) SetBucketMetadataError(statusCode int, message string) {
+	m.responses["bucket-metadata-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// writeBucketMetadataError writes the configured bucket metadata error and reports whether one was set
+func (m *MockMinIOServer) writeBucketMetadataError(w http.ResponseWriter) bool {
+	if errorResponse, exists := m.responses["bucket-metadata-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return true
+		}
+	}
+	return false
+}
+
+// handleExportBucketMetadata handles the MinIO admin bucket metadata export endpoint
+func (m *MockMinIOServer) handleExportBucketMetadata(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("export-bucket-metadata", r)
+
+	if m.writeBucketMetadataError(w) {
+		return
+	}
+
+	archive, _ := m.responses["export-bucket-metadata"].([]byte)
+
+	w.Header().Set("Content-Type", "application/zip")
+	_, _ = w.Write(archive)
+}
+
+// handleImportBucketMetadata handles the MinIO admin bucket metadata import endpoint,
+// the size of the archive is recorded as "size" along with the query parameters
+func (m *MockMinIOServer) handleImportBucketMetadata(w http.ResponseWriter, r *http.Request) {
+	archive, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request", http.StatusBadRequest)
+		return
+	}
+
+	values := url.Values{}
+	for key, value := range r.URL.Query() {
+		values[key] = value
+	}
+	values.Set("size", strconv.Itoa(len(archive)))
+	m.recordValues("import-bucket-metadata", values)
+
+	if m.writeBucketMetadataError(w) {
+		return
+	}
+
+	result, _ := m.responses["import-bucket-metadata"].(madmin.BucketMetaImportErrs)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,228 @@
+package minio
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// mockSecretKey is the secret used by madmin to encrypt config payloads, it matches CreateMinIOClient
+const mockSecretKey = "minioadmin"
+
+// SetConfigHelpResponse sets the help returned for a subsystem, an empty subsystem describes every subsystem
+func (m *MockMinIOServer) SetConfigHelpResponse(subSystem string, help madmin.Help) {
+	m.responses["config-help:"+subSystem] = help
+}
+
+// SetConfigKVResponse sets the raw config lines returned for a subsystem
+func (m *MockMinIOServer) SetConfigKVResponse(subSystem string, config string) {
+	m.responses["config-kv:"+subSystem] = config
+}
+
+// SetConfigRestartRequired makes config changes report that a restart is required to apply them
+func (m *MockMinIOServer) SetConfigRestartRequired(required bool) {
+	m.responses["config-restart"] = required
+}
+
+// SetServerConfigResponse sets the whole server config as config lines, an import replaces it
+func (m *MockMinIOServer) SetServerConfigResponse(config string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.responses["config"] = config
+}
+
+// SetConfigHistoryResponse sets the config history entries, restoring an unknown entry fails
+func (m *MockMinIOServer) SetConfigHistoryResponse(entries []madmin.ConfigHistoryEntry) {
+	m.responses["config-history"] = entries
+}
+
+// SetConfigError sets an error response for every config request
+func (m *MockMinIOServer) SetConfigError(statusCode int, message string) {
+	m.responses["config-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// writeConfigError writes the configured config error and reports whether one was set
+func (m *MockMinIOServer) writeConfigError(w http.ResponseWriter) bool {
+	if errorResponse, exists := m.responses["config-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return true
+		}
+	}
+	return false
+}
+
+// writeEncrypted writes data encrypted the way madmin expects config payloads
+func writeEncrypted(w http.ResponseWriter, data []byte) {
+	encrypted, err := madmin.EncryptData(mockSecretKey, data)
+	if err != nil {
+		http.Error(w, "Failed to encrypt response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(encrypted)
+}
+
+// writeConfigApplied reports the change as applied unless a restart is required
+func (m *MockMinIOServer) writeConfigApplied(w http.ResponseWriter) {
+	if restart, _ := m.responses["config-restart"].(bool); !restart {
+		w.Header().Set(madmin.ConfigAppliedHeader, madmin.ConfigAppliedTrue)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleHelpConfigKV handles the MinIO admin config help endpoint
+func (m *MockMinIOServer) handleHelpConfigKV(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("help-config-kv", r)
+
+	if m.writeConfigError(w) {
+		return
+	}
+
+	subSystem := r.URL.Query().Get("subSys")
+	help, exists := m.responses["config-help:"+subSystem].(madmin.Help)
+	if !exists {
+		http.Error(w, "Invalid config sub-system "+subSystem, http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(help); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// handleGetConfigKV handles the MinIO admin get config endpoint
+func (m *MockMinIOServer) handleGetConfigKV(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("get-config-kv", r)
+
+	if m.writeConfigError(w) {
+		return
+	}
+
+	subSystem, _, _ := strings.Cut(r.URL.Query().Get("key"), ":")
+	config, _ := m.responses["config-kv:"+subSystem].(string)
+
+	writeEncrypted(w, []byte(config))
+}
+
+// handleSetConfigKV handles the MinIO admin set config endpoint, the decrypted config line is recorded as "kv"
+func (m *MockMinIOServer) handleSetConfigKV(w http.ResponseWriter, r *http.Request) {
+	m.handleConfigChange("set-config-kv", w, r)
+}
+
+// handleDelConfigKV handles the MinIO admin delete config endpoint, the decrypted config line is recorded as "kv"
+func (m *MockMinIOServer) handleDelConfigKV(w http.ResponseWriter, r *http.Request) {
+	m.handleConfigChange("del-config-kv", w, r)
+}
+
+func (m *MockMinIOServer) handleConfigChange(operation string, w http.ResponseWriter, r *http.Request) {
+	kv, err := madmin.DecryptData(mockSecretKey, r.Body)
+	if err != nil {
+		http.Error(w, "Failed to decrypt request", http.StatusBadRequest)
+		return
+	}
+
+	values := r.URL.Query()
+	values.Set("kv", string(kv))
+	m.recordValues(operation, values)
+
+	if m.writeConfigError(w) {
+		return
+	}
+
+	m.writeConfigApplied(w)
+}
+
+// handleListConfigHistoryKV handles the MinIO admin config history endpoint
+func (m *MockMinIOServer) handleListConfigHistoryKV(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("list-config-history-kv", r)
+
+	if m.writeConfigError(w) {
+		return
+	}
+
+	entries, _ := m.responses["config-history"].([]madmin.ConfigHistoryEntry)
+	if entries == nil {
+		entries = []madmin.ConfigHistoryEntry{}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(entries); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	writeEncrypted(w, buf.Bytes())
+}
+
+// handleRestoreConfigHistoryKV handles the MinIO admin config history restore endpoint
+func (m *MockMinIOServer) handleRestoreConfigHistoryKV(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("restore-config-history-kv", r)
+
+	if m.writeConfigError(w) {
+		return
+	}
+
+	restoreID := r.URL.Query().Get("restoreId")
+	entries, _ := m.responses["config-history"].([]madmin.ConfigHistoryEntry)
+	for _, entry := range entries {
+		if entry.RestoreID == restoreID {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	http.Error(w, "Config history entry "+url.QueryEscape(restoreID)+" not found", http.StatusNotFound)
+}
+
+// handleGetConfig handles the MinIO admin endpoint exporting the whole server config
+func (m *MockMinIOServer) handleGetConfig(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("get-config", r)
+
+	if m.writeConfigError(w) {
+		return
+	}
+
+	m.mu.Lock()
+	config, _ := m.responses["config"].(string)
+	m.mu.Unlock()
+
+	writeEncrypted(w, []byte(config))
+}
+
+// handleSetConfig handles the MinIO admin endpoint importing the whole server config,
+// the decrypted config is recorded as "config" and returned by later exports
+func (m *MockMinIOServer) handleSetConfig(w http.ResponseWriter, r *http.Request) {
+	config, err := madmin.DecryptData(mockSecretKey, r.Body)
+	if err != nil {
+		http.Error(w, "Failed to decrypt request", http.StatusBadRequest)
+		return
+	}
+
+	values := r.URL.Query()
+	values.Set("config", string(config))
+	m.recordValues("set-config", values)
+
+	if m.writeConfigError(w) {
+		return
+	}
+
+	m.SetServerConfigResponse(string(config))
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,52 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetDataUsageResponse sets the response for data usage info requests
+func (m *MockMinIOServer) SetDataUsageResponse(response madmin.DataUsageInfo) {
+	m.responses["data-usage"] = response
+}
+
+// SetDataUsageError sets an error response for data usage info requests
+func (m *MockMinIOServer) SetDataUsageError(statusCode int, message string) {
+	m.responses["data-usage-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// handleDataUsage handles the MinIO admin data usage info endpoint
+func (m *MockMinIOServer) handleDataUsage(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("data-usage", r)
+
+	// Check if we should return an error
+	if errorResponse, exists := m.responses["data-usage-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	var dataUsage madmin.DataUsageInfo
+	if response, exists := m.responses["data-usage"]; exists {
+		if dataUsageResp, ok := response.(madmin.DataUsageInfo); ok {
+			dataUsage = dataUsageResp
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(dataUsage); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,124 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetHealStartResponse sets the response for heal start and stop requests
+func (m *MockMinIOServer) SetHealStartResponse(response madmin.HealStartSuccess) {
+	m.responses["heal-start"] = response
+}
+
+// SetHealStatusResponses sets the responses returned by successive heal status polls,
+// the last response is repeated once all of them were returned
+func (m *MockMinIOServer) SetHealStatusResponses(responses ...madmin.HealTaskStatus) {
+	m.responses["heal-status"] = responses
+}
+
+// SetHealError sets an error response for every heal request
+func (m *MockMinIOServer) SetHealError(statusCode int, message string) {
+	m.responses["heal-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// SetBackgroundHealStatusResponse sets the response for background heal status requests
+func (m *MockMinIOServer) SetBackgroundHealStatusResponse(response madmin.BgHealState) {
+	m.responses["background-heal"] = response
+}
+
+// SetBackgroundHealStatusError sets an error response for background heal status requests
+func (m *MockMinIOServer) SetBackgroundHealStatusError(statusCode int, message string) {
+	m.responses["background-heal-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// handleHeal handles the MinIO admin heal endpoint, which starts, polls or stops a heal sequence
+// depending on the clientToken and forceStop query parameters
+func (m *MockMinIOServer) handleHeal(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	operation := "heal-start"
+	switch {
+	case query.Get("clientToken") != "":
+		operation = "heal-status"
+	case query.Get("forceStop") == "true":
+		operation = "heal-stop"
+	}
+	m.recordRequest(operation, r)
+
+	// Check if we should return an error
+	if errorResponse, exists := m.responses["heal-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	var response any = madmin.HealStartSuccess{}
+	if operation == "heal-status" {
+		response = m.nextHealStatus()
+	} else if started, exists := m.responses["heal-start"]; exists {
+		response = started
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// nextHealStatus returns the heal status of the current poll
+func (m *MockMinIOServer) nextHealStatus() madmin.HealTaskStatus {
+	statuses, _ := m.responses["heal-status"].([]madmin.HealTaskStatus)
+	if len(statuses) == 0 {
+		return madmin.HealTaskStatus{Summary: "finished"}
+	}
+
+	// Requests were recorded before, so the first poll is the first status
+	polls := len(m.Requests("heal-status"))
+	return statuses[min(polls, len(statuses))-1]
+}
+
+// handleBackgroundHealStatus handles the MinIO admin background heal status endpoint
+func (m *MockMinIOServer) handleBackgroundHealStatus(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("background-heal", r)
+
+	// Check if we should return an error
+	if errorResponse, exists := m.responses["background-heal-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	var state madmin.BgHealState
+	if response, exists := m.responses["background-heal"]; exists {
+		if stateResp, ok := response.(madmin.BgHealState); ok {
+			state = stateResp
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,83 @@
+package minio
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetIAMExportResponse sets the zip bundle returned by IAM export requests
+func (m *MockMinIOServer) SetIAMExportResponse(bundle []byte) {
+	m.responses["export-iam"] = bundle
+}
+
+// SetIAMImportResponse sets the result returned by IAM import requests. Like MinIO, errors of
+// failed entities are encoded as empty objects.
+func (m *MockMinIOServer) SetIAMImportResponse(result madmin.ImportIAMResult) {
+	m.responses["import-iam"] = result
+}
+
+// SetIAMError sets an error response for IAM export and import requests
+func (m *MockMinIOServer) SetIAMError(statusCode int, message string) {
+	m.responses["iam-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// writeIAMError writes the configured IAM error and reports whether one was set
+func (m *MockMinIOServer) writeIAMError(w http.ResponseWriter) bool {
+	if errorResponse, exists := m.responses["iam-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return true
+		}
+	}
+	return false
+}
+
+// handleExportIAM handles the MinIO admin IAM export endpoint
+func (m *MockMinIOServer) handleExportIAM(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("export-iam", r)
+
+	if m.writeIAMError(w) {
+		return
+	}
+
+	bundle, _ := m.responses["export-iam"].([]byte)
+
+	w.Header().Set("Content-Type", "application/zip")
+	_, _ = w.Write(bundle)
+}
+
+// handleImportIAM handles the MinIO admin IAM import endpoint, the size of the bundle is recorded as "size"
+func (m *MockMinIOServer) handleImportIAM(w http.ResponseWriter, r *http.Request) {
+	bundle, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request", http.StatusBadRequest)
+		return
+	}
+
+	m.recordValues("import-iam", url.Values{"size": {strconv.Itoa(len(bundle))}})
+
+	if m.writeIAMError(w) {
+		return
+	}
+
+	result, _ := m.responses["import-iam"].(madmin.ImportIAMResult)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,225 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetIDPConfigResponse stores an identity provider config, it is listed as enabled unless enable is set to off
+func (m *MockMinIOServer) SetIDPConfigResponse(config madmin.IDPConfig) {
+	m.responses["idp-config:"+config.Type+":"+config.Name] = config
+}
+
+// SetIDPCheckResponse sets the result of checking the LDAP config
+func (m *MockMinIOServer) SetIDPCheckResponse(result madmin.CheckIDPConfigResp) {
+	m.responses["idp-check"] = result
+}
+
+// SetLDAPPolicyEntitiesResponse sets the response for LDAP policy entities requests
+func (m *MockMinIOServer) SetLDAPPolicyEntitiesResponse(result madmin.PolicyEntitiesResult) {
+	m.responses["ldap-policy-entities"] = result
+}
+
+// SetLDAPPolicyAssociationResponse sets the response for LDAP policy attach and detach requests
+func (m *MockMinIOServer) SetLDAPPolicyAssociationResponse(result madmin.PolicyAssociationResp) {
+	m.responses["ldap-policy-association"] = result
+}
+
+// SetIDPError sets an error response for every identity provider request
+func (m *MockMinIOServer) SetIDPError(statusCode int, message string) {
+	m.responses["idp-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// writeIDPError writes the configured identity provider error and reports whether one was set
+func (m *MockMinIOServer) writeIDPError(w http.ResponseWriter) bool {
+	if errorResponse, exists := m.responses["idp-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return true
+		}
+	}
+	return false
+}
+
+// writeEncryptedJSON writes v as an encrypted JSON payload
+func writeEncryptedJSON(w http.ResponseWriter, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	writeEncrypted(w, data)
+}
+
+// handleListIDPConfig handles the MinIO admin IDP config list endpoint
+func (m *MockMinIOServer) handleListIDPConfig(w http.ResponseWriter, r *http.Request) {
+	idpType := chi.URLParam(r, "type")
+	m.recordValues("list-idp-config", url.Values{"type": {idpType}})
+
+	if m.writeIDPError(w) {
+		return
+	}
+
+	items := []madmin.IDPListItem{}
+	prefix := "idp-config:" + idpType + ":"
+	for key, response := range m.responses {
+		config, ok := response.(madmin.IDPConfig)
+		if !ok || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		item := madmin.IDPListItem{Type: config.Type, Name: config.Name, Enabled: true}
+		for _, info := range config.Info {
+			switch {
+			case info.Key == "enable" && info.Value == "off":
+				item.Enabled = false
+			case info.Key == "roleARN":
+				item.RoleARN = info.Value
+			}
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+
+	writeEncryptedJSON(w, items)
+}
+
+// handleGetIDPConfig handles the MinIO admin IDP config endpoint
+func (m *MockMinIOServer) handleGetIDPConfig(w http.ResponseWriter, r *http.Request) {
+	idpType, name := chi.URLParam(r, "type"), chi.URLParam(r, "name")
+	m.recordValues("get-idp-config", url.Values{"type": {idpType}, "name": {name}})
+
+	if m.writeIDPError(w) {
+		return
+	}
+
+	config, exists := m.responses["idp-config:"+idpType+":"+name].(madmin.IDPConfig)
+	if !exists {
+		http.Error(w, "The specified IDP config does not exist", http.StatusNotFound)
+		return
+	}
+
+	writeEncryptedJSON(w, config)
+}
+
+// handleAddIDPConfig handles adding an IDP config, the decrypted config is recorded as "config"
+func (m *MockMinIOServer) handleAddIDPConfig(w http.ResponseWriter, r *http.Request) {
+	m.handleIDPConfigChange("add-idp-config", w, r)
+}
+
+// handleUpdateIDPConfig handles editing an IDP config, the decrypted config is recorded as "config"
+func (m *MockMinIOServer) handleUpdateIDPConfig(w http.ResponseWriter, r *http.Request) {
+	m.handleIDPConfigChange("update-idp-config", w, r)
+}
+
+// handleDeleteIDPConfig handles removing an IDP config
+func (m *MockMinIOServer) handleDeleteIDPConfig(w http.ResponseWriter, r *http.Request) {
+	idpType, name := chi.URLParam(r, "type"), chi.URLParam(r, "name")
+	m.recordValues("delete-idp-config", url.Values{"type": {idpType}, "name": {name}})
+
+	if m.writeIDPError(w) {
+		return
+	}
+
+	key := "idp-config:" + idpType + ":" + name
+	if _, exists := m.responses[key]; !exists {
+		http.Error(w, "The specified IDP config does not exist", http.StatusNotFound)
+		return
+	}
+	delete(m.responses, key)
+
+	m.writeConfigApplied(w)
+}
+
+func (m *MockMinIOServer) handleIDPConfigChange(operation string, w http.ResponseWriter, r *http.Request) {
+	config, err := madmin.DecryptData(mockSecretKey, r.Body)
+	if err != nil {
+		http.Error(w, "Failed to decrypt request", http.StatusBadRequest)
+		return
+	}
+
+	m.recordValues(operation, url.Values{
+		"type":   {chi.URLParam(r, "type")},
+		"name":   {chi.URLParam(r, "name")},
+		"config": {string(config)},
+	})
+
+	if m.writeIDPError(w) {
+		return
+	}
+
+	m.writeConfigApplied(w)
+}
+
+// handleCheckIDPConfig handles the MinIO admin LDAP config check endpoint
+func (m *MockMinIOServer) handleCheckIDPConfig(w http.ResponseWriter, r *http.Request) {
+	m.recordValues("check-idp-config", url.Values{"type": {chi.URLParam(r, "type")}, "name": {chi.URLParam(r, "name")}})
+
+	if m.writeIDPError(w) {
+		return
+	}
+
+	result, exists := m.responses["idp-check"].(madmin.CheckIDPConfigResp)
+	if !exists {
+		result = madmin.CheckIDPConfigResp{ErrType: madmin.IDPErrNone}
+	}
+
+	writeEncryptedJSON(w, result)
+}
+
+// handleLDAPPolicyEntities handles the MinIO admin LDAP policy entities endpoint
+func (m *MockMinIOServer) handleLDAPPolicyEntities(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("ldap-policy-entities", r)
+
+	if m.writeIDPError(w) {
+		return
+	}
+
+	result, _ := m.responses["ldap-policy-entities"].(madmin.PolicyEntitiesResult)
+	writeEncryptedJSON(w, result)
+}
+
+// handleLDAPPolicyAssociation handles attaching and detaching LDAP policies, the decrypted
+// request is recorded as "user", "group" and "policy" values
+func (m *MockMinIOServer) handleLDAPPolicyAssociation(w http.ResponseWriter, r *http.Request) {
+	content, err := madmin.DecryptData(mockSecretKey, r.Body)
+	if err != nil {
+		http.Error(w, "Failed to decrypt request", http.StatusBadRequest)
+		return
+	}
+
+	var req madmin.PolicyAssociationReq
+	if err := json.Unmarshal(content, &req); err != nil {
+		http.Error(w, "Invalid policy association request", http.StatusBadRequest)
+		return
+	}
+
+	m.recordValues("ldap-policy-"+chi.URLParam(r, "action"), url.Values{
+		"user":   {req.User},
+		"group":  {req.Group},
+		"policy": req.Policies,
+	})
+
+	if m.writeIDPError(w) {
+		return
+	}
+
+	result, _ := m.responses["ldap-policy-association"].(madmin.PolicyAssociationResp)
+	writeEncryptedJSON(w, result)
+}
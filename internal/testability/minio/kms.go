@@ -0,0 +1,180 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetKMSStatusResponse sets the response for KMS status requests
+func (m *MockMinIOServer) SetKMSStatusResponse(status madmin.KMSStatus) {
+	m.responses["kms-status"] = status
+}
+
+// SetKMSMetricsResponse sets the response for KMS metrics requests
+func (m *MockMinIOServer) SetKMSMetricsResponse(metrics madmin.KMSMetrics) {
+	m.responses["kms-metrics"] = metrics
+}
+
+// SetKMSMetricsError sets an error response for KMS metrics requests only, e.g. a KMS without metrics
+func (m *MockMinIOServer) SetKMSMetricsError(statusCode int, message string) {
+	m.responses["kms-metrics-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// SetKMSKeysResponse sets the keys stored in the KMS, created keys are added to them
+func (m *MockMinIOServer) SetKMSKeysResponse(keys []madmin.KMSKeyInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.responses["kms-keys"] = keys
+}
+
+// SetKMSKeyStatusResponse sets the status of a key, existing keys without status are usable
+func (m *MockMinIOServer) SetKMSKeyStatusResponse(status madmin.KMSKeyStatus) {
+	m.responses["kms-key-status:"+status.KeyID] = status
+}
+
+// SetKMSError sets an error response for every KMS request
+func (m *MockMinIOServer) SetKMSError(statusCode int, message string) {
+	m.responses["kms-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// writeKMSError writes the configured error of a KMS response and reports whether one was set
+func (m *MockMinIOServer) writeKMSError(w http.ResponseWriter, key string) bool {
+	if errorResponse, exists := m.responses[key]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return true
+		}
+	}
+	return false
+}
+
+// kmsKeys returns the keys stored in the KMS
+func (m *MockMinIOServer) kmsKeys() []madmin.KMSKeyInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys, _ := m.responses["kms-keys"].([]madmin.KMSKeyInfo)
+	return append([]madmin.KMSKeyInfo(nil), keys...)
+}
+
+// handleKMSStatus handles the MinIO KMS status endpoint
+func (m *MockMinIOServer) handleKMSStatus(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("kms-status", r)
+
+	if m.writeKMSError(w, "kms-error") {
+		return
+	}
+
+	writeJSON(w, m.responses["kms-status"])
+}
+
+// handleKMSMetrics handles the MinIO KMS metrics endpoint
+func (m *MockMinIOServer) handleKMSMetrics(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("kms-metrics", r)
+
+	if m.writeKMSError(w, "kms-error") || m.writeKMSError(w, "kms-metrics-error") {
+		return
+	}
+
+	writeJSON(w, m.responses["kms-metrics"])
+}
+
+// handleKMSListKeys handles the MinIO KMS key list endpoint, the pattern is matched as a glob
+func (m *MockMinIOServer) handleKMSListKeys(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("kms-key-list", r)
+
+	if m.writeKMSError(w, "kms-error") {
+		return
+	}
+
+	pattern := r.URL.Query().Get("pattern")
+	keys := []madmin.KMSKeyInfo{}
+	for _, key := range m.kmsKeys() {
+		if matched, _ := path.Match(pattern, key.Name); matched {
+			keys = append(keys, key)
+		}
+	}
+
+	writeJSON(w, keys)
+}
+
+// handleKMSCreateKey handles the MinIO KMS key create endpoint
+func (m *MockMinIOServer) handleKMSCreateKey(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("kms-key-create", r)
+
+	if m.writeKMSError(w, "kms-error") {
+		return
+	}
+
+	keyID := r.URL.Query().Get("key-id")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys, _ := m.responses["kms-keys"].([]madmin.KMSKeyInfo)
+	for _, key := range keys {
+		if key.Name == keyID {
+			http.Error(w, "key already exists", http.StatusConflict)
+			return
+		}
+	}
+
+	m.responses["kms-keys"] = append(keys, madmin.KMSKeyInfo{
+		Name:      keyID,
+		CreatedAt: time.Now().UTC(),
+		CreatedBy: "minioadmin",
+	})
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleKMSKeyStatus handles the MinIO KMS key status endpoint
+func (m *MockMinIOServer) handleKMSKeyStatus(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("kms-key-status", r)
+
+	if m.writeKMSError(w, "kms-error") {
+		return
+	}
+
+	keyID := r.URL.Query().Get("key-id")
+	if status, exists := m.responses["kms-key-status:"+keyID].(madmin.KMSKeyStatus); exists {
+		writeJSON(w, status)
+		return
+	}
+
+	for _, key := range m.kmsKeys() {
+		if key.Name == keyID {
+			writeJSON(w, madmin.KMSKeyStatus{KeyID: keyID})
+			return
+		}
+	}
+
+	http.Error(w, "key does not exist", http.StatusNotFound)
+}
+
+// writeJSON writes a JSON encoded response
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
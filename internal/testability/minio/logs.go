@@ -0,0 +1,70 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LogEntryResponse represents a console log entry as streamed by the MinIO admin log API
+type LogEntryResponse struct {
+	Node    string `json:"node"`
+	Level   string `json:"level"`
+	ErrKind string `json:"errKind"`
+	Time    string `json:"time"`
+	Message string `json:"message,omitempty"`
+}
+
+// SetLogsResponse sets the entries streamed for log requests
+func (m *MockMinIOServer) SetLogsResponse(entries []LogEntryResponse) {
+	m.responses["logs"] = entries
+}
+
+// SetLogsError sets an error response for log requests
+func (m *MockMinIOServer) SetLogsError(statusCode int, message string) {
+	m.responses["logs-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// handleLogs handles the MinIO admin log endpoint
+//
+// The real endpoint keeps the connection open and streams entries as they are written, so
+// after sending the configured entries the handler waits until the client goes away.
+func (m *MockMinIOServer) handleLogs(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("logs", r)
+
+	// Check if we should return an error
+	if errorResponse, exists := m.responses["logs-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if response, exists := m.responses["logs"]; exists {
+		if entries, ok := response.([]LogEntryResponse); ok {
+			encoder := json.NewEncoder(w)
+			for _, entry := range entries {
+				if err := encoder.Encode(entry); err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	<-r.Context().Done()
+}
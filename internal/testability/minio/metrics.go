@@ -0,0 +1,62 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetMetricsResponse sets the samples streamed for realtime metrics requests
+func (m *MockMinIOServer) SetMetricsResponse(samples []madmin.RealtimeMetrics) {
+	m.responses["metrics"] = samples
+}
+
+// SetMetricsError sets an error response for realtime metrics requests
+func (m *MockMinIOServer) SetMetricsError(statusCode int, message string) {
+	m.responses["metrics-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// handleMetrics handles the MinIO admin realtime metrics endpoint
+func (m *MockMinIOServer) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("metrics", r)
+
+	// Check if we should return an error
+	if errorResponse, exists := m.responses["metrics-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	var samples []madmin.RealtimeMetrics
+	if response, exists := m.responses["metrics"]; exists {
+		if metricsResp, ok := response.([]madmin.RealtimeMetrics); ok {
+			samples = metricsResp
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	// The client stops reading at the final sample
+	for i, sample := range samples {
+		sample.Final = i == len(samples)-1
+		if err := encoder.Encode(sample); err != nil {
+			return
+		}
+	}
+
+	if len(samples) == 0 {
+		_ = encoder.Encode(madmin.RealtimeMetrics{Final: true})
+	}
+}
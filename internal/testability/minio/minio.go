@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"sync"
 	"time"
 
 	"github.com/elct9620/minio-lite-admin/internal/infra"
@@ -16,6 +18,10 @@ type MockMinIOServer struct {
 	server          *httptest.Server
 	responses       map[string]any
 	serviceAccounts map[string]*ServiceAccountInfo // In-memory store for service accounts
+
+	mu       sync.Mutex
+	requests map[string][]url.Values // Query parameters of received requests, keyed by operation
+	latency  time.Duration           // Synthetic delay before every response, e.g. for benchmarks
 }
 
 // ServiceAccountInfo represents stored service account information
@@ -36,6 +42,7 @@ func NewMockMinIOServer() *MockMinIOServer {
 	mock := &MockMinIOServer{
 		responses:       make(map[string]any),
 		serviceAccounts: make(map[string]*ServiceAccountInfo),
+		requests:        make(map[string][]url.Values),
 	}
 
 	// Set default server info response
@@ -47,6 +54,7 @@ func NewMockMinIOServer() *MockMinIOServer {
 
 	// Create HTTP test server with chi router
 	r := chi.NewRouter()
+	r.Use(mock.simulateLatency)
 
 	// MinIO Admin API endpoints
 	r.Route("/minio/admin", func(r chi.Router) {
@@ -55,9 +63,14 @@ func NewMockMinIOServer() *MockMinIOServer {
 		r.Get("/v3/info", mock.handleServerInfo)
 		r.Get("/info", mock.handleServerInfo)
 
+		// Data usage endpoints
+		r.Get("/v4/datausageinfo", mock.handleDataUsage)
+
 		// Access keys endpoints
 		r.Get("/v4/list-users", mock.handleListUsers)
 		r.Get("/v4/list-access-keys-bulk", mock.handleListAccessKeysBulk)
+		r.Get("/v4/idp/ldap/list-access-keys-bulk", mock.handleListAccessKeysLDAPBulk)
+		r.Get("/v4/idp/openid/list-access-keys-bulk", mock.handleListAccessKeysOpenIDBulk)
 
 		// Service account endpoints
 		r.Get("/v4/info-service-account", mock.handleInfoServiceAccount)
@@ -66,6 +79,62 @@ func NewMockMinIOServer() *MockMinIOServer {
 		r.Put("/v4/update-service-account", mock.handleUpdateServiceAccount)
 		r.Post("/v4/update-service-account", mock.handleUpdateServiceAccount)
 		r.Delete("/v4/delete-service-account", mock.handleDeleteServiceAccount)
+
+		// User, group and policy endpoints
+		r.Get("/v4/user-info", mock.handleUserInfo)
+		r.Get("/v4/group", mock.handleGroupDescription)
+		r.Get("/v4/info-canned-policy", mock.handleInfoCannedPolicy)
+
+		// Heal endpoints
+		r.Post("/v4/heal/*", mock.handleHeal)
+		r.Post("/v4/background-heal/status", mock.handleBackgroundHealStatus)
+
+		// Config endpoints
+		r.Get("/v4/config", mock.handleGetConfig)
+		r.Put("/v4/config", mock.handleSetConfig)
+		r.Get("/v4/help-config-kv", mock.handleHelpConfigKV)
+		r.Get("/v4/get-config-kv", mock.handleGetConfigKV)
+		r.Put("/v4/set-config-kv", mock.handleSetConfigKV)
+		r.Delete("/v4/del-config-kv", mock.handleDelConfigKV)
+		r.Get("/v4/list-config-history-kv", mock.handleListConfigHistoryKV)
+		r.Put("/v4/restore-config-history-kv", mock.handleRestoreConfigHistoryKV)
+
+		// IAM endpoints
+		r.Get("/v4/export-iam", mock.handleExportIAM)
+		r.Put("/v4/import-iam-v2", mock.handleImportIAM)
+
+		// Bucket metadata endpoints
+		r.Get("/v4/export-bucket-metadata", mock.handleExportBucketMetadata)
+		r.Put("/v4/import-bucket-metadata", mock.handleImportBucketMetadata)
+
+		// Identity provider endpoints
+		r.Get("/v4/idp-config/{type}", mock.handleListIDPConfig)
+		r.Get("/v4/idp-config/{type}/{name}", mock.handleGetIDPConfig)
+		r.Put("/v4/idp-config/{type}/{name}", mock.handleAddIDPConfig)
+		r.Post("/v4/idp-config/{type}/{name}", mock.handleUpdateIDPConfig)
+		r.Delete("/v4/idp-config/{type}/{name}", mock.handleDeleteIDPConfig)
+		r.Get("/v4/idp-config/{type}/{name}/check", mock.handleCheckIDPConfig)
+		r.Get("/v4/idp/ldap/policy-entities", mock.handleLDAPPolicyEntities)
+		r.Post("/v4/idp/ldap/policy/{action}", mock.handleLDAPPolicyAssociation)
+
+		// Service control endpoints
+		r.Post("/v4/service", mock.handleServiceAction)
+		r.Post("/v4/update", mock.handleServerUpdate)
+
+		// Log endpoints
+		r.Get("/v4/log", mock.handleLogs)
+
+		// Metrics endpoints
+		r.Get("/v4/metrics", mock.handleMetrics)
+	})
+
+	// MinIO KMS API endpoints
+	r.Route("/minio/kms/v1", func(r chi.Router) {
+		r.Get("/status", mock.handleKMSStatus)
+		r.Get("/metrics", mock.handleKMSMetrics)
+		r.Get("/key/list", mock.handleKMSListKeys)
+		r.Post("/key/create", mock.handleKMSCreateKey)
+		r.Get("/key/status", mock.handleKMSKeyStatus)
 	})
 
 	// Add a catch-all handler for unhandled requests
@@ -89,6 +158,49 @@ func (m *MockMinIOServer) URL() string {
 	return m.server.URL
 }
 
+// SetLatency delays every response by latency to simulate a remote cluster
+func (m *MockMinIOServer) SetLatency(latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.latency = latency
+}
+
+// simulateLatency delays the request by the configured latency
+func (m *MockMinIOServer) simulateLatency(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		latency := m.latency
+		m.mu.Unlock()
+
+		if latency > 0 {
+			time.Sleep(latency)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// recordRequest remembers the query parameters of a request for later assertions
+func (m *MockMinIOServer) recordRequest(operation string, r *http.Request) {
+	m.recordValues(operation, r.URL.Query())
+}
+
+// recordValues remembers values of a request for later assertions, e.g. query parameters with a decoded body
+func (m *MockMinIOServer) recordValues(operation string, values url.Values) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[operation] = append(m.requests[operation], values)
+}
+
+// Requests returns the query parameters of every request received for an operation
+func (m *MockMinIOServer) Requests(operation string) []url.Values {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]url.Values(nil), m.requests[operation]...)
+}
+
 // AddServiceAccountToStore adds a service account to the in-memory store
 func (m *MockMinIOServer) AddServiceAccountToStore(accessKey, secretKey, name, description, status, parentUser string, policy json.RawMessage, expiration *time.Time) {
 	m.serviceAccounts[accessKey] = &ServiceAccountInfo{
@@ -0,0 +1,73 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetUserInfoResponse stores a built-in user, unknown users are rejected like MinIO does for LDAP and OpenID identities
+func (m *MockMinIOServer) SetUserInfoResponse(accessKey string, info madmin.UserInfo) {
+	m.responses["user-info:"+accessKey] = info
+}
+
+// SetGroupDescriptionResponse stores a group by its name
+func (m *MockMinIOServer) SetGroupDescriptionResponse(desc madmin.GroupDesc) {
+	m.responses["group:"+desc.Name] = desc
+}
+
+// SetCannedPolicyResponse stores a canned policy document
+func (m *MockMinIOServer) SetCannedPolicyResponse(name string, document json.RawMessage) {
+	m.responses["canned-policy:"+name] = document
+}
+
+// writeAdminError writes a MinIO admin API error with code
+func writeAdminError(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(madmin.ErrorResponse{Code: code, Message: message})
+}
+
+// handleUserInfo handles the MinIO admin user info endpoint
+func (m *MockMinIOServer) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("user-info", r)
+
+	info, ok := m.responses["user-info:"+r.URL.Query().Get("accessKey")].(madmin.UserInfo)
+	if !ok {
+		writeAdminError(w, http.StatusNotFound, "XMinioAdminNoSuchUser", "The specified user does not exist")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(info)
+}
+
+// handleGroupDescription handles the MinIO admin group description endpoint
+func (m *MockMinIOServer) handleGroupDescription(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("group", r)
+
+	desc, ok := m.responses["group:"+r.URL.Query().Get("group")].(madmin.GroupDesc)
+	if !ok {
+		writeAdminError(w, http.StatusNotFound, "XMinioAdminNoSuchGroup", "The specified group does not exist")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(desc)
+}
+
+// handleInfoCannedPolicy handles the MinIO admin canned policy info endpoint
+func (m *MockMinIOServer) handleInfoCannedPolicy(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("info-canned-policy", r)
+
+	name := r.URL.Query().Get("name")
+	document, ok := m.responses["canned-policy:"+name].(json.RawMessage)
+	if !ok {
+		writeAdminError(w, http.StatusNotFound, "XMinioAdminNoSuchPolicy", "The canned policy does not exist")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(madmin.PolicyInfo{PolicyName: name, Policy: document})
+}
@@ -3,6 +3,8 @@ package minio
 import (
 	"encoding/json"
 	"net/http"
+
+	"github.com/minio/madmin-go/v4"
 )
 
 // ServerInfoResponse represents the MinIO admin API server info response format
@@ -10,6 +12,10 @@ type ServerInfoResponse struct {
 	Mode         string `json:"mode"`
 	Region       string `json:"region"`
 	DeploymentID string `json:"deploymentId"`
+
+	// Servers and Backend describe the cluster topology, a single server without drives is reported when empty
+	Servers []madmin.ServerProperties `json:"servers,omitempty"`
+	Backend *madmin.ErasureBackend    `json:"backend,omitempty"`
 }
 
 // SetServerInfoResponse sets the response for server info requests
@@ -79,6 +85,12 @@ func (m *MockMinIOServer) handleServerInfo(w http.ResponseWriter, r *http.Reques
 					},
 				},
 			}
+			if len(serverInfo.Servers) > 0 {
+				minioResponse["servers"] = serverInfo.Servers
+			}
+			if serverInfo.Backend != nil {
+				minioResponse["backend"] = serverInfo.Backend
+			}
 
 			w.Header().Set("Content-Type", "application/json")
 			if err := json.NewEncoder(w).Encode(minioResponse); err != nil {
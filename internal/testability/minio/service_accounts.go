@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/minio/madmin-go/v4"
@@ -107,6 +108,11 @@ func (m *MockMinIOServer) handleAddServiceAccount(w http.ResponseWriter, r *http
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
+	m.recordValues("add-service-account", url.Values{
+		"targetUser": {req.TargetUser},
+		"name":       {req.Name},
+		"policy":     {string(req.Policy)},
+	})
 
 	var responseData AddServiceAccountResponse
 
@@ -164,6 +170,40 @@ func (m *MockMinIOServer) handleAddServiceAccount(w http.ResponseWriter, r *http
 	}
 }
 
+// SetServiceAccountError makes update and delete requests of a single service account fail
+func (m *MockMinIOServer) SetServiceAccountError(accessKey string, statusCode int, message string) {
+	m.responses["service-account-error:"+accessKey] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// SetServiceAccountNotFound makes update and delete requests of a single service account fail as MinIO does for unknown accounts
+func (m *MockMinIOServer) SetServiceAccountNotFound(accessKey string) {
+	m.responses["service-account-missing:"+accessKey] = true
+}
+
+// writeServiceAccountError writes the error set for the service account, reporting whether there was one
+func (m *MockMinIOServer) writeServiceAccountError(w http.ResponseWriter, accessKey string) bool {
+	if _, missing := m.responses["service-account-missing:"+accessKey]; missing {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"Code":"XMinioAdminServiceAccountNotFound","Message":"The specified service account is not found"}`))
+		return true
+	}
+	if err, ok := m.responses["service-account-error:"+accessKey].(struct {
+		StatusCode int
+		Message    string
+	}); ok {
+		http.Error(w, err.Message, err.StatusCode)
+		return true
+	}
+	return false
+}
+
 // Service Account Update Methods
 
 // SetUpdateServiceAccountError sets an error response for update service account requests
@@ -201,6 +241,10 @@ func (m *MockMinIOServer) handleUpdateServiceAccount(w http.ResponseWriter, r *h
 		http.Error(w, "Missing accessKey parameter", http.StatusBadRequest)
 		return
 	}
+	m.recordRequest("update-service-account", r)
+	if m.writeServiceAccountError(w, accessKey) {
+		return
+	}
 
 	// Read and decrypt request body
 	encryptedBody, err := io.ReadAll(r.Body)
@@ -289,6 +333,10 @@ func (m *MockMinIOServer) handleDeleteServiceAccount(w http.ResponseWriter, r *h
 		http.Error(w, "Missing accessKey parameter", http.StatusBadRequest)
 		return
 	}
+	m.recordRequest("delete-service-account", r)
+	if m.writeServiceAccountError(w, accessKey) {
+		return
+	}
 
 	// Return success response (empty body for delete operations)
 	w.WriteHeader(http.StatusNoContent)
@@ -317,6 +365,8 @@ func (m *MockMinIOServer) SetInfoServiceAccountError(statusCode int, message str
 
 // handleInfoServiceAccount handles the MinIO admin info service account endpoint
 func (m *MockMinIOServer) handleInfoServiceAccount(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("info-service-account", r)
+
 	// Check if we should return an error
 	if errorResponse, exists := m.responses["info-service-account-error"]; exists {
 		if err, ok := errorResponse.(struct {
@@ -355,7 +405,7 @@ func (m *MockMinIOServer) handleInfoServiceAccount(w http.ResponseWriter, r *htt
 						Expiration:    saInfo.Expiration,
 					}
 				} else {
-					http.Error(w, "Service account not found", http.StatusNotFound)
+					writeAdminError(w, http.StatusBadRequest, "XMinioAdminServiceAccountNotFound", "The specified service account is not found")
 					return
 				}
 			}
@@ -373,7 +423,7 @@ func (m *MockMinIOServer) handleInfoServiceAccount(w http.ResponseWriter, r *htt
 				Expiration:    saInfo.Expiration,
 			}
 		} else {
-			http.Error(w, "Service account not found", http.StatusNotFound)
+			writeAdminError(w, http.StatusBadRequest, "XMinioAdminServiceAccountNotFound", "The specified service account is not found")
 			return
 		}
 	}
@@ -0,0 +1,130 @@
+package minio
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// SetServiceActionResponse sets the peer results returned by service restart and stop requests
+func (m *MockMinIOServer) SetServiceActionResponse(results []madmin.ServiceActionPeerResult) {
+	m.responses["service"] = results
+}
+
+// SetServiceActionError sets an error response for service restart and stop requests
+func (m *MockMinIOServer) SetServiceActionError(statusCode int, message string) {
+	m.responses["service-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// SetServerUpdateResponse sets the response for server update requests
+func (m *MockMinIOServer) SetServerUpdateResponse(response madmin.ServerUpdateStatus) {
+	m.responses["update"] = response
+}
+
+// SetServerUpdateError sets an error response for server update requests
+func (m *MockMinIOServer) SetServerUpdateError(statusCode int, message string) {
+	m.responses["update-error"] = struct {
+		StatusCode int
+		Message    string
+	}{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// RestartRequested reports whether a restart, which is not a dry-run, was received
+func (m *MockMinIOServer) RestartRequested() bool {
+	return m.serviceActionRequested(string(madmin.ServiceActionRestart))
+}
+
+// StopRequested reports whether a stop, which is not a dry-run, was received
+func (m *MockMinIOServer) StopRequested() bool {
+	return m.serviceActionRequested(string(madmin.ServiceActionStop))
+}
+
+// UpdateRequested reports whether a server update, which is not a dry-run, was received
+func (m *MockMinIOServer) UpdateRequested() bool {
+	for _, query := range m.Requests("update") {
+		if query.Get("dry-run") != "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MockMinIOServer) serviceActionRequested(action string) bool {
+	for _, query := range m.Requests("service") {
+		if query.Get("action") == action && query.Get("dry-run") != "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// handleServiceAction handles the MinIO admin service endpoint used to restart or stop the servers
+func (m *MockMinIOServer) handleServiceAction(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("service", r)
+
+	// Check if we should return an error
+	if errorResponse, exists := m.responses["service-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	query := r.URL.Query()
+	response := madmin.ServiceActionResult{
+		Action: madmin.ServiceAction(query.Get("action")),
+		DryRun: query.Get("dry-run") == "true",
+	}
+	if results, exists := m.responses["service"]; exists {
+		if peers, ok := results.([]madmin.ServiceActionPeerResult); ok {
+			response.Results = peers
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// handleServerUpdate handles the MinIO admin update endpoint
+func (m *MockMinIOServer) handleServerUpdate(w http.ResponseWriter, r *http.Request) {
+	m.recordRequest("update", r)
+
+	// Check if we should return an error
+	if errorResponse, exists := m.responses["update-error"]; exists {
+		if err, ok := errorResponse.(struct {
+			StatusCode int
+			Message    string
+		}); ok {
+			http.Error(w, err.Message, err.StatusCode)
+			return
+		}
+	}
+
+	var status madmin.ServerUpdateStatus
+	if response, exists := m.responses["update"]; exists {
+		if statusResp, ok := response.(madmin.ServerUpdateStatus); ok {
+			status = statusResp
+		}
+	}
+	status.DryRun = r.URL.Query().Get("dry-run") == "true"
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
@@ -1,6 +1,16 @@
 package minio
 
-import "time"
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+	"github.com/prometheus/procfs"
+)
 
 // TestScenarios provides pre-configured test scenarios
 type TestScenarios struct{}
@@ -34,6 +44,473 @@ func (TestScenarios) EmptyRegionServerInfo() ServerInfoResponse {
 	}
 }
 
+// TopologyServerInfo returns a distributed cluster of two servers with one pool of two 6-drive sets at parity 2.
+// The first set has one offline drive, the second set has one offline and one healing drive.
+func (TestScenarios) TopologyServerInfo() ServerInfoResponse {
+	servers := []madmin.ServerProperties{
+		{
+			Endpoint: "node1:9000",
+			State:    "online",
+			Uptime:   86400,
+			Version:  "2025-01-01T00:00:00Z",
+			CommitID: "abc123",
+			Network:  map[string]string{"node1:9000": "online", "node2:9000": "online"},
+		},
+		{
+			Endpoint: "node2:9000",
+			State:    "online",
+			Uptime:   3600,
+			Version:  "2025-01-01T00:00:00Z",
+			CommitID: "abc123",
+			Network:  map[string]string{"node1:9000": "online", "node2:9000": "online"},
+		},
+	}
+
+	// Each set spreads its six drives evenly over both servers
+	for set := range 2 {
+		for disk := range 6 {
+			node := disk % 2
+			drive := madmin.Disk{
+				Endpoint:       fmt.Sprintf("http://node%d:9000/data%d", node+1, set*3+disk/2+1),
+				State:          madmin.DriveStateOk,
+				TotalSpace:     1 << 40,
+				UsedSpace:      1 << 39,
+				AvailableSpace: 1 << 39,
+				Utilization:    50,
+				PoolIndex:      0,
+				SetIndex:       set,
+				DiskIndex:      disk,
+			}
+			if disk == 5 {
+				drive.State = madmin.DriveStateOffline
+			}
+			if set == 1 && disk == 4 {
+				drive.Healing = true
+			}
+			servers[node].Disks = append(servers[node].Disks, drive)
+		}
+	}
+
+	return ServerInfoResponse{
+		Mode:         "distributed",
+		Region:       "us-east-1",
+		DeploymentID: "topology-cluster-uuid",
+		Servers:      servers,
+		Backend: &madmin.ErasureBackend{
+			Type:             "Erasure",
+			StandardSCParity: 2,
+			RRSCParity:       1,
+			TotalSets:        []int{2},
+			DrivesPerSet:     []int{6},
+		},
+	}
+}
+
+// Data Usage Scenarios
+
+// SuccessfulDataUsage returns the data usage of a 1 TiB cluster with three buckets of different sizes
+func (TestScenarios) SuccessfulDataUsage() madmin.DataUsageInfo {
+	return madmin.DataUsageInfo{
+		LastUpdate:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ObjectsTotalCount: 1600,
+		ObjectsTotalSize:  350 << 30,
+		BucketsCount:      3,
+		BucketsUsage: map[string]madmin.BucketUsageInfo{
+			"backups": {
+				Size:               300 << 30,
+				ObjectsCount:       100,
+				VersionsCount:      250,
+				DeleteMarkersCount: 10,
+				ObjectSizesHistogram: map[string]uint64{
+					"BETWEEN_64_MB_AND_128_MB": 40,
+					"GREATER_THAN_512_MB":      60,
+				},
+			},
+			"media": {
+				Size:               50 << 30,
+				ObjectsCount:       500,
+				VersionsCount:      500,
+				DeleteMarkersCount: 0,
+				ObjectSizesHistogram: map[string]uint64{
+					"BETWEEN_10_MB_AND_64_MB": 500,
+				},
+			},
+			"logs": {
+				Size:               1 << 20,
+				ObjectsCount:       1000,
+				VersionsCount:      1000,
+				DeleteMarkersCount: 3,
+				ObjectSizesHistogram: map[string]uint64{
+					"LESS_THAN_1024_B": 1000,
+				},
+			},
+		},
+		TotalCapacity:     1 << 40,
+		TotalUsedCapacity: 400 << 30,
+		TotalFreeCapacity: 624 << 30,
+	}
+}
+
+// Heal Scenarios
+
+// SuccessfulHealStart returns the response of a newly started heal sequence
+func (TestScenarios) SuccessfulHealStart() madmin.HealStartSuccess {
+	return madmin.HealStartSuccess{
+		ClientToken:   "3f1c1b8e-heal-token",
+		ClientAddress: "127.0.0.1",
+		StartTime:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// RunningThenFinishedHealStatus returns a heal sequence that repairs one object on a missing drive and then finishes
+func (TestScenarios) RunningThenFinishedHealStatus() []madmin.HealTaskStatus {
+	item := madmin.HealResultItem{
+		Type:       madmin.HealItemObject,
+		Bucket:     "media",
+		Object:     "videos/intro.mp4",
+		ObjectSize: 1 << 20,
+	}
+	item.Before.Drives = []madmin.HealDriveInfo{
+		{Endpoint: "/data1", State: madmin.DriveStateOk},
+		{Endpoint: "/data2", State: madmin.DriveStateMissing},
+	}
+	item.After.Drives = []madmin.HealDriveInfo{
+		{Endpoint: "/data1", State: madmin.DriveStateOk},
+		{Endpoint: "/data2", State: madmin.DriveStateOk},
+	}
+
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	settings := madmin.HealOpts{Recursive: true, ScanMode: madmin.HealDeepScan}
+
+	return []madmin.HealTaskStatus{
+		{
+			Summary:      "running",
+			StartTime:    startTime,
+			HealSettings: settings,
+			Items:        []madmin.HealResultItem{item},
+		},
+		{
+			Summary:      "finished",
+			StartTime:    startTime,
+			HealSettings: settings,
+		},
+	}
+}
+
+// SuccessfulBackgroundHealStatus returns a background heal state with one drive healing in the second set
+func (TestScenarios) SuccessfulBackgroundHealStatus() madmin.BgHealState {
+	return madmin.BgHealState{
+		ScannedItemsCount: 4200,
+		HealDisks:         []string{"http://node2:9000/data3"},
+		Sets: []madmin.SetStatus{
+			{PoolIndex: 0, SetIndex: 1, HealStatus: "healing", HealPriority: "high", TotalObjects: 1200},
+			{PoolIndex: 0, SetIndex: 0, HealStatus: "ok", TotalObjects: 3000},
+		},
+		SCParity: map[string]int{"STANDARD": 2, "REDUCED_REDUNDANCY": 1},
+	}
+}
+
+// Service Control Scenarios
+
+// SuccessfulServiceAction returns the peer results of a restart or stop on a two node cluster
+func (TestScenarios) SuccessfulServiceAction() []madmin.ServiceActionPeerResult {
+	return []madmin.ServiceActionPeerResult{
+		{Host: "node1:9000"},
+		{Host: "node2:9000"},
+	}
+}
+
+// SuccessfulServerUpdate returns the peer results of an update on a two node cluster
+func (TestScenarios) SuccessfulServerUpdate() madmin.ServerUpdateStatus {
+	return madmin.ServerUpdateStatus{
+		Results: []madmin.ServerPeerUpdateStatus{
+			{
+				Host:           "node1:9000",
+				CurrentVersion: "RELEASE.2025-01-20T14-49-07Z",
+				UpdatedVersion: "RELEASE.2025-04-22T22-12-26Z",
+			},
+			{
+				Host:           "node2:9000",
+				CurrentVersion: "RELEASE.2025-01-20T14-49-07Z",
+				UpdatedVersion: "RELEASE.2025-04-22T22-12-26Z",
+			},
+		},
+	}
+}
+
+// Config Scenarios
+
+// ConfigSubsystemsHelp returns the help describing every config subsystem
+func (TestScenarios) ConfigSubsystemsHelp() madmin.Help {
+	return madmin.Help{
+		KeysHelp: madmin.HelpKVS{
+			{Key: "api", Description: "manage global HTTP API call specific features"},
+			{Key: "scanner", Description: "manage namespace scanning for usage calculation, lifecycle, healing and more"},
+			{Key: "identity_openid", Description: "enable OpenID SSO support", MultipleTargets: true},
+			{Key: "notify_webhook", Description: "publish bucket notifications to webhook endpoints", MultipleTargets: true},
+		},
+	}
+}
+
+// NotifyWebhookConfigHelp returns the help of the notify_webhook subsystem
+func (TestScenarios) NotifyWebhookConfigHelp() madmin.Help {
+	return madmin.Help{
+		SubSys:          "notify_webhook",
+		Description:     "publish bucket notifications to webhook endpoints",
+		MultipleTargets: true,
+		KeysHelp: madmin.HelpKVS{
+			{Key: "enable", Description: "enable notify_webhook target", Type: "on|off"},
+			{Key: "endpoint", Description: "webhook server endpoint e.g. http://localhost:8080/minio/events", Type: "url"},
+			{Key: "auth_token", Description: "opaque string or JWT authorization token", Optional: true, Type: "string"},
+			{Key: "queue_limit", Description: "maximum limit for undelivered messages, defaults to '100000'", Optional: true, Type: "number"},
+			{Key: "comment", Description: "optionally add a comment to this setting", Optional: true, Type: "sentence"},
+		},
+	}
+}
+
+// APIConfigHelp returns the help of the api subsystem, which has no targets
+func (TestScenarios) APIConfigHelp() madmin.Help {
+	return madmin.Help{
+		SubSys:      "api",
+		Description: "manage global HTTP API call specific features",
+		KeysHelp: madmin.HelpKVS{
+			{Key: "requests_max", Description: "set the maximum number of concurrent requests", Optional: true, Type: "number"},
+			{Key: "requests_deadline", Description: "set the deadline for API requests waiting to be processed", Optional: true, Type: "duration"},
+		},
+	}
+}
+
+// NotifyWebhookConfig returns the raw config of the default and a named notify_webhook target,
+// the queue limit of the default target is overridden by an environment variable
+func (TestScenarios) NotifyWebhookConfig() string {
+	return `# MINIO_NOTIFY_WEBHOOK_QUEUE_LIMIT=50000
+notify_webhook enable=off endpoint= auth_token= queue_limit=100000 comment=
+notify_webhook:primary endpoint=https://hooks.example.com/minio auth_token=s3cr3t-hook-token queue_limit=10000 comment="Audit events"
+`
+}
+
+// ServerConfig returns the whole server config of a cluster with a webhook target
+func (TestScenarios) ServerConfig() string {
+	return `api requests_max=1000 requests_deadline=10s
+scanner speed=default
+notify_webhook:primary endpoint=https://hooks.example.com/minio auth_token=s3cr3t-hook-token queue_limit=10000
+region name=us-east-1
+`
+}
+
+// ConfigHistory returns config history entries, the latest one changed a webhook token
+func (TestScenarios) ConfigHistory() []madmin.ConfigHistoryEntry {
+	return []madmin.ConfigHistoryEntry{
+		{
+			RestoreID:  "a1b2c3d4-restore-1",
+			CreateTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Data:       "api requests_max=1000",
+		},
+		{
+			RestoreID:  "a1b2c3d4-restore-2",
+			CreateTime: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			Data:       `notify_webhook:primary endpoint=https://hooks.example.com/minio auth_token="s3cr3t hook token"`,
+		},
+	}
+}
+
+// IAM Scenarios
+
+// IAMExportBundle returns a zip bundle laid out like a MinIO IAM export
+func (TestScenarios) IAMExportBundle() []byte {
+	return zipArchive(map[string]string{
+		"iam-assets/allpolicies.json":      `{"readonly-reports":{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::reports/*"]}]}}`,
+		"iam-assets/allusers.json":         `{"alice":{"status":"enabled","secretKey":"alice-secret-key"}}`,
+		"iam-assets/allgroups.json":        `{"analysts":{"members":["alice"],"status":"enabled"}}`,
+		"iam-assets/allsvcaccts.json":      `{}`,
+		"iam-assets/user_mappings.json":    `{"alice":{"policy":"readonly-reports"}}`,
+		"iam-assets/group_mappings.json":   `{}`,
+		"iam-assets/stsuser_mappings.json": `{}`,
+	})
+}
+
+// PartialIAMImport returns an import result where a mapping was skipped and a user failed
+func (TestScenarios) PartialIAMImport() madmin.ImportIAMResult {
+	return madmin.ImportIAMResult{
+		Added: madmin.IAMEntities{
+			Policies:     []string{"readonly-reports"},
+			Groups:       []string{"analysts"},
+			UserPolicies: []map[string][]string{{"alice": {"readonly-reports"}}},
+		},
+		Skipped: madmin.IAMEntities{
+			GroupPolicies: []map[string][]string{{"auditors": {"audit-policy"}}},
+		},
+		Failed: madmin.IAMErrEntities{
+			Users: []madmin.IAMErrEntity{{Name: "alice", Error: errors.New("secret key is too short")}},
+		},
+	}
+}
+
+// Bucket Metadata Scenarios
+
+// BucketMetadataArchive returns a zip archive laid out like a MinIO bucket metadata export
+func (TestScenarios) BucketMetadataArchive() []byte {
+	return zipArchive(map[string]string{
+		"reports/policy.json":   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::reports/*"]}]}`,
+		"reports/lifecycle.xml": `<LifecycleConfiguration><Rule><ID>expire-tmp</ID><Status>Enabled</Status><Filter><Prefix>tmp/</Prefix></Filter><Expiration><Days>7</Days></Expiration></Rule></LifecycleConfiguration>`,
+		"reports/quota.json":    `{"quota":1073741824,"quotatype":"hard"}`,
+		"logs/versioning.xml":   `<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`,
+		"logs/tagging.xml":      `<Tagging><TagSet><Tag><Key>team</Key><Value>ops</Value></Tag></TagSet></Tagging>`,
+		"logs/object-lock.xml":  `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`,
+	})
+}
+
+// PartialBucketMetadataImport returns an import report where the quota of one bucket failed
+func (TestScenarios) PartialBucketMetadataImport() madmin.BucketMetaImportErrs {
+	return madmin.BucketMetaImportErrs{
+		Buckets: map[string]madmin.BucketStatus{
+			"reports": {
+				Policy:    madmin.MetaStatus{IsSet: true},
+				Lifecycle: madmin.MetaStatus{IsSet: true},
+				Quota:     madmin.MetaStatus{Err: "quota is not supported in gateway mode"},
+			},
+			"logs": {
+				Versioning: madmin.MetaStatus{IsSet: true},
+				Tagging:    madmin.MetaStatus{IsSet: true},
+				ObjectLock: madmin.MetaStatus{IsSet: true},
+			},
+		},
+	}
+}
+
+// KMS Scenarios
+
+// KESStatus returns the status of a KES server with one offline endpoint
+func (TestScenarios) KESStatus() madmin.KMSStatus {
+	return madmin.KMSStatus{
+		Name:         "KES",
+		DefaultKeyID: "minio-default-key",
+		Endpoints: map[string]madmin.ItemState{
+			"https://kes-1.example.com:7373": madmin.ItemOnline,
+			"https://kes-2.example.com:7373": madmin.ItemOffline,
+		},
+		State: madmin.KMSState{
+			Version:           "2024-11-25T13-44-31Z",
+			KeyStoreLatency:   3 * time.Millisecond,
+			KeyStoreReachable: true,
+			KeystoreAvailable: true,
+			UpTime:            72 * time.Hour,
+		},
+	}
+}
+
+// KESMetrics returns the counters of a KES server
+func (TestScenarios) KESMetrics() madmin.KMSMetrics {
+	return madmin.KMSMetrics{
+		RequestOK:     15230,
+		RequestErr:    12,
+		RequestFail:   3,
+		RequestActive: 2,
+		AuditEvents:   15245,
+		ErrorEvents:   15,
+	}
+}
+
+// KMSKeys returns the keys stored in a KES server, including the default key
+func (TestScenarios) KMSKeys() []madmin.KMSKeyInfo {
+	return []madmin.KMSKeyInfo{
+		{Name: "tenant-a-key", CreatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), CreatedBy: "minioadmin"},
+		{Name: "minio-default-key", CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), CreatedBy: "minioadmin"},
+	}
+}
+
+// Identity Provider Scenarios
+
+// LDAPConfigHelp returns the help of the identity_ldap subsystem
+func (TestScenarios) LDAPConfigHelp() madmin.Help {
+	return madmin.Help{
+		SubSys:      madmin.IdentityLDAPSubSys,
+		Description: "Identity LDAP via external LDAP server",
+		KeysHelp: madmin.HelpKVS{
+			{Key: "enable", Description: "enable LDAP identity provider", Optional: true, Type: "on|off"},
+			{Key: "server_addr", Description: "AD/LDAP server address e.g. \"myldap.com\" or \"myldapserver.com:1686\"", Type: "address"},
+			{Key: "lookup_bind_dn", Description: "DN for LDAP read-only service account used to perform DN and group lookups", Optional: true, Type: "string"},
+			{Key: "lookup_bind_password", Description: "Password for LDAP read-only service account", Optional: true, Type: "string"},
+			{Key: "user_dn_search_base_dn", Description: "\";\" separated list of user search base DNs", Optional: true, Type: "list"},
+			{Key: "user_dn_search_filter", Description: "Search filter to lookup user DN", Optional: true, Type: "string"},
+			{Key: "group_search_base_dn", Description: "\";\" separated list of group search base DNs", Optional: true, Type: "list"},
+			{Key: "tls_skip_verify", Description: "trust server TLS without verification", Optional: true, Type: "on|off"},
+		},
+	}
+}
+
+// OpenIDConfigHelp returns the help of the identity_openid subsystem
+func (TestScenarios) OpenIDConfigHelp() madmin.Help {
+	return madmin.Help{
+		SubSys:          madmin.IdentityOpenIDSubSys,
+		Description:     "enable OpenID SSO support",
+		MultipleTargets: true,
+		KeysHelp: madmin.HelpKVS{
+			{Key: "enable", Description: "enable OpenID SSO support", Optional: true, Type: "on|off"},
+			{Key: "config_url", Description: "openid discovery document e.g. \"https://accounts.google.com/.well-known/openid-configuration\"", Type: "url"},
+			{Key: "client_id", Description: "unique public identifier for apps e.g. \"292085223830.apps.googleusercontent.com\"", Type: "string"},
+			{Key: "client_secret", Description: "secret for the unique public identifier for apps", Optional: true, Type: "string"},
+			{Key: "role_policy", Description: "Set the IAM access policies applicable to this client application and IDP", Optional: true, Type: "string"},
+			{Key: "scopes", Description: "Comma separated list of OpenID scopes for server, defaults to advertised scopes from discovery document", Optional: true, Type: "csv"},
+		},
+	}
+}
+
+// LDAPConfig returns the default LDAP config, the lookup bind password is set by an environment variable
+func (TestScenarios) LDAPConfig() madmin.IDPConfig {
+	return madmin.IDPConfig{
+		Type: madmin.LDAPIDPCfg,
+		Name: madmin.Default,
+		Info: []madmin.IDPCfgInfo{
+			{Key: "enable", Value: "on", IsCfg: true},
+			{Key: "server_addr", Value: "ldap.example.com:636", IsCfg: true},
+			{Key: "lookup_bind_dn", Value: "cn=admin,dc=example,dc=com", IsCfg: true},
+			{Key: "lookup_bind_password", Value: "ld4p-s3cret", IsCfg: true, IsEnv: true},
+			{Key: "user_dn_search_base_dn", Value: "ou=people,dc=example,dc=com", IsCfg: true},
+			{Key: "user_dn_search_filter", Value: "(uid=%s)", IsCfg: true},
+		},
+	}
+}
+
+// OpenIDConfig returns a disabled OpenID config using a role policy
+func (TestScenarios) OpenIDConfig() madmin.IDPConfig {
+	return madmin.IDPConfig{
+		Type: madmin.OpenidIDPCfg,
+		Name: "keycloak",
+		Info: []madmin.IDPCfgInfo{
+			{Key: "enable", Value: "off", IsCfg: true},
+			{Key: "config_url", Value: "https://sso.example.com/realms/minio/.well-known/openid-configuration", IsCfg: true},
+			{Key: "client_id", Value: "minio", IsCfg: true},
+			{Key: "client_secret", Value: "0pen1d-s3cret", IsCfg: true},
+			{Key: "role_policy", Value: "readwrite", IsCfg: true},
+			{Key: "roleARN", Value: "arn:minio:iam:::role/dfOSBR8BdCPeCRy1", IsCfg: false},
+		},
+	}
+}
+
+// LDAPPolicyEntities returns the policies mapped to an LDAP user, its group and the policies themselves
+func (TestScenarios) LDAPPolicyEntities() madmin.PolicyEntitiesResult {
+	return madmin.PolicyEntitiesResult{
+		Timestamp: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UserMappings: []madmin.UserPolicyEntities{
+			{
+				User:     "uid=alice,ou=people,dc=example,dc=com",
+				Policies: []string{"readwrite"},
+				MemberOfMappings: []madmin.GroupPolicyEntities{
+					{Group: "cn=admins,ou=groups,dc=example,dc=com", Policies: []string{"consoleAdmin"}},
+				},
+			},
+		},
+		GroupMappings: []madmin.GroupPolicyEntities{
+			{Group: "cn=admins,ou=groups,dc=example,dc=com", Policies: []string{"consoleAdmin"}},
+		},
+		PolicyMappings: []madmin.PolicyEntities{
+			{Policy: "consoleAdmin", Groups: []string{"cn=admins,ou=groups,dc=example,dc=com"}},
+			{Policy: "readwrite", Users: []string{"uid=alice,ou=people,dc=example,dc=com"}},
+		},
+	}
+}
+
 // Access Keys Scenarios
 
 // SuccessfulAccessKeys returns a typical successful access keys response
@@ -97,6 +574,115 @@ func (TestScenarios) SuccessfulAccessKeys() (AccessKeysUsersResponse, AccessKeys
 	return users, accessKeys
 }
 
+// ManyServiceAccounts returns a single user owning count service accounts, e.g. to benchmark their detail lookups
+func (TestScenarios) ManyServiceAccounts(count int) (AccessKeysUsersResponse, AccessKeysBulkResponse) {
+	users := AccessKeysUsersResponse{
+		"bulkuser": {Status: "enabled"},
+	}
+
+	serviceAccounts := make([]AccessKeysServiceAccount, count)
+	for i := range serviceAccounts {
+		serviceAccounts[i] = AccessKeysServiceAccount{
+			ParentUser:    "bulkuser",
+			AccountStatus: "enabled",
+			AccessKey:     fmt.Sprintf("BULKSVC%013d", i),
+			Name:          fmt.Sprintf("bulk-account-%d", i),
+		}
+	}
+
+	accessKeys := AccessKeysBulkResponse{
+		"bulkuser": {
+			ServiceAccounts: serviceAccounts,
+			STSKeys:         []AccessKeysServiceAccount{},
+		},
+	}
+
+	return users, accessKeys
+}
+
+// BulkServiceAccounts returns service accounts of two users with the details kept in the store.
+// The CI accounts of alice share a name prefix, one of them expired at the start of 2024.
+func (TestScenarios) BulkServiceAccounts() (AccessKeysUsersResponse, AccessKeysBulkResponse, []ServiceAccountInfo) {
+	users := AccessKeysUsersResponse{
+		"alice": {Status: "enabled"},
+		"bob":   {Status: "enabled"},
+	}
+
+	expired := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	stored := []ServiceAccountInfo{
+		{AccessKey: "ALICECI0000000000001", Name: "ci-deploy", Status: "enabled", ParentUser: "alice", Expiration: &expired},
+		{AccessKey: "ALICECI0000000000002", Name: "ci-test", Status: "enabled", ParentUser: "alice", Expiration: &valid},
+		{AccessKey: "BOBBACKUP00000000001", Name: "backup", Status: "enabled", ParentUser: "bob"},
+	}
+
+	accessKeys := AccessKeysBulkResponse{}
+	for _, info := range stored {
+		list := accessKeys[info.ParentUser]
+		list.ServiceAccounts = append(list.ServiceAccounts, AccessKeysServiceAccount{
+			ParentUser:    info.ParentUser,
+			AccountStatus: info.Status,
+			AccessKey:     info.AccessKey,
+			Name:          info.Name,
+		})
+		list.STSKeys = []AccessKeysServiceAccount{}
+		accessKeys[info.ParentUser] = list
+	}
+
+	return users, accessKeys, stored
+}
+
+// LDAPAccessKeys returns the access keys of an LDAP user, the STS key is missing its parent as in older MinIO releases
+func (TestScenarios) LDAPAccessKeys() map[string]madmin.ListAccessKeysLDAPResp {
+	expiration := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	return map[string]madmin.ListAccessKeysLDAPResp{
+		"uid=alice,ou=people,dc=example,dc=com": {
+			ServiceAccounts: []madmin.ServiceAccountInfo{
+				{
+					ParentUser:    "uid=alice,ou=people,dc=example,dc=com",
+					AccountStatus: "enabled",
+					AccessKey:     "LDAPALICESVC00000001",
+					Name:          "alice-backup",
+					ImpliedPolicy: true,
+				},
+			},
+			STSKeys: []madmin.ServiceAccountInfo{
+				{
+					AccountStatus: "enabled",
+					AccessKey:     "LDAPALICESTS00000001",
+					ImpliedPolicy: true,
+					Expiration:    &expiration,
+				},
+			},
+		},
+	}
+}
+
+// OpenIDAccessKeys returns the access keys of an OpenID subject of the keycloak config
+func (TestScenarios) OpenIDAccessKeys() []madmin.ListAccessKeysOpenIDResp {
+	return []madmin.ListAccessKeysOpenIDResp{
+		{
+			ConfigName: "keycloak",
+			Users: []madmin.OpenIDUserAccessKeys{
+				{
+					MinioAccessKey: "bob@example.com",
+					ID:             "5f1c0b4e-0b9a-4d0c-9a53-1f0c6f1e7b2a",
+					ReadableName:   "bob",
+					ServiceAccounts: []madmin.ServiceAccountInfo{
+						{
+							ParentUser:    "bob@example.com",
+							AccountStatus: "disabled",
+							AccessKey:     "OIDCBOBSVC0000000001",
+							Name:          "bob-ci",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 // Service Account Creation Scenarios
 
 // SuccessfulAddServiceAccount returns a typical successful add service account response
@@ -162,3 +748,95 @@ func (TestScenarios) SuccessfulInfoServiceAccount(accessKey string) InfoServiceA
 		Expiration:    nil,
 	}
 }
+
+// Log Scenarios
+
+// SuccessfulLogs returns a typical set of console log entries from two nodes
+func (TestScenarios) SuccessfulLogs() []LogEntryResponse {
+	return []LogEntryResponse{
+		{
+			Node:    "node1:9000",
+			Level:   "INFO",
+			ErrKind: "INFO",
+			Time:    "2025-01-01T00:00:00Z",
+			Message: "Server started",
+		},
+		{
+			Node:    "node2:9000",
+			Level:   "ERROR",
+			ErrKind: "ERROR",
+			Time:    "2025-01-01T00:00:01Z",
+			Message: "Drive offline",
+		},
+	}
+}
+
+// Metrics Scenarios
+
+// SuccessfulMetrics returns three disk and network samples taken one second apart.
+// Between samples the drives complete 100 reads and 50 writes, and the network receives 1 MiB.
+func (TestScenarios) SuccessfulMetrics() []madmin.RealtimeMetrics {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	samples := make([]madmin.RealtimeMetrics, 3)
+	for i := range samples {
+		collectedAt := start.Add(time.Duration(i) * time.Second)
+		n := uint64(i)
+
+		disk := &madmin.DiskMetric{
+			CollectedAt: collectedAt,
+			NDisks:      4,
+			Offline:     1,
+			IOStats: madmin.DiskIOStats{
+				ReadIOs:      1000 + n*100,
+				WriteIOs:     500 + n*50,
+				ReadSectors:  n * 2048,
+				WriteSectors: n * 1024,
+			},
+		}
+
+		samples[i] = madmin.RealtimeMetrics{
+			Hosts: []string{"node1:9000", "node2:9000"},
+			Aggregated: madmin.Metrics{
+				Disk: disk,
+				Net: &madmin.NetMetrics{
+					CollectedAt:   collectedAt,
+					InterfaceName: "eth0",
+					NetStats: procfs.NetDevLine{
+						Name:    "eth0",
+						RxBytes: n * 1024 * 1024,
+						TxBytes: n * 512 * 1024,
+					},
+				},
+			},
+		}
+	}
+
+	return samples
+}
+
+// zipArchive creates a zip archive of the files, written in name order so the archive is reproducible
+func zipArchive(files map[string]string) []byte {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, name := range names {
+		file, err := archive.Create(name)
+		if err != nil {
+			panic(err)
+		}
+		if _, err := file.Write([]byte(files[name])); err != nil {
+			panic(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
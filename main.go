@@ -11,8 +11,13 @@ import (
 	"github.com/elct9620/minio-lite-admin/internal/config"
 	httpHandler "github.com/elct9620/minio-lite-admin/internal/handler/http"
 	"github.com/elct9620/minio-lite-admin/internal/infra"
+	"github.com/elct9620/minio-lite-admin/internal/job"
 	"github.com/elct9620/minio-lite-admin/internal/logger"
+	"github.com/elct9620/minio-lite-admin/internal/metrics"
+	"github.com/elct9620/minio-lite-admin/internal/notify"
 	"github.com/elct9620/minio-lite-admin/internal/service"
+	"github.com/elct9620/minio-lite-admin/internal/store"
+	"go.etcd.io/bbolt"
 )
 
 func main() {
@@ -26,25 +31,276 @@ func main() {
 	})
 	logger.SetGlobalLogger(log)
 
-	// Initialize MinIO client
-	minioClient, err := infra.NewMinIOClient(infra.MinIOConfig{
-		URL:      cfg.MinIO.URL,
-		RootUser: cfg.MinIO.RootUser,
-		Password: cfg.MinIO.Password,
-	})
+	// Initialize metrics if enabled
+	var appMetrics *metrics.Metrics
+	var wrapTransport func(http.RoundTripper) http.RoundTripper
+	if cfg.Metrics.Enabled {
+		appMetrics = metrics.New()
+		wrapTransport = appMetrics.InstrumentRoundTripper
+		log.Info().Str("path", cfg.Metrics.Path).Msg("Metrics endpoint enabled")
+	}
+
+	// Initialize MinIO clients, created on first use of each cluster
+	minioConfigs := make([]infra.MinIOConfig, 0, len(cfg.MinIOClusters()))
+	minioURLs := make(map[string]string, len(cfg.MinIOClusters()))
+	minioRootUsers := make(map[string]string, len(cfg.MinIOClusters()))
+	for _, cluster := range cfg.MinIOClusters() {
+		minioURLs[cluster.Name] = cluster.URL
+		minioRootUsers[cluster.Name] = cluster.RootUser
+		minioConfigs = append(minioConfigs, infra.MinIOConfig{
+			Name:          cluster.Name,
+			URL:           cluster.URL,
+			RootUser:      cluster.RootUser,
+			Password:      cluster.Password,
+			WrapTransport: wrapTransport,
+		})
+	}
+	minioClients, err := infra.NewMinIOClientRegistry(minioConfigs)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize MinIO clients")
+	}
+	log.Info().Strs("clusters", minioClients.Names()).Msg("MinIO clusters configured")
+
+	// Usage history and expiry sweeps are collected from the default cluster
+	minioClient, err := minioClients.Client(minioClients.Default())
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize MinIO client")
 	}
 
-	// Initialize services
-	getServerInfoService := service.NewGetServerInfoService(minioClient)
-	listAccessKeysService := service.NewListAccessKeysService(minioClient)
-	addServiceAccountService := service.NewAddServiceAccountService(minioClient)
-	deleteServiceAccountService := service.NewDeleteServiceAccountService(minioClient)
-	updateServiceAccountService := service.NewUpdateServiceAccountService(minioClient)
+	// Keys of new service accounts are generated by the server
+	credentialRules := service.CredentialRules{
+		AccessKeyPrefix:  cfg.Credentials.AccessKeyPrefix,
+		AccessKeyLength:  cfg.Credentials.AccessKeyLength,
+		AccessKeyCharset: cfg.Credentials.AccessKeyCharset,
+		SecretKeyLength:  cfg.Credentials.SecretKeyLength,
+		SecretKeyCharset: cfg.Credentials.SecretKeyCharset,
+		MinSecretEntropy: cfg.Credentials.MinSecretEntropy,
+	}
+	if err := credentialRules.Validate(); err != nil {
+		log.Fatal().Err(err).Msg("Invalid credential rules")
+	}
+
+	// Background jobs stop when the server shuts down
+	jobsCtx, stopJobs := context.WithCancel(log.WithContext(context.Background()))
+	defer stopJobs()
+
+	// Open the local store when a feature keeping local state is enabled
+	var db *bbolt.DB
+	if cfg.History.Enabled || cfg.Expiry.Enabled || cfg.Rotation.Enabled || cfg.Reveal.Enabled {
+		db, err = infra.NewBoltDB(cfg.Store.Path)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to open local store")
+		}
+		defer db.Close()
+	}
+
+	// Initialize usage history if enabled
+	var getUsageHistoryService *service.GetUsageHistoryService
+	if cfg.History.Enabled {
+		if cfg.History.Interval <= 0 {
+			log.Fatal().Dur("interval", cfg.History.Interval).Msg("Usage history interval must be positive")
+		}
+
+		usageHistory, err := store.NewUsageHistory(db)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize usage history")
+		}
+
+		collectUsageSnapshotService := service.NewCollectUsageSnapshotService(minioClient, usageHistory, cfg.History.Retention)
+		getUsageHistoryService = service.NewGetUsageHistoryService(usageHistory)
+
+		go job.Periodic{
+			Name:     "usage-history",
+			Interval: cfg.History.Interval,
+			Task: func(ctx context.Context) error {
+				_, err := collectUsageSnapshotService.Execute(ctx)
+				return err
+			},
+		}.Run(jobsCtx)
+		log.Info().Str("path", cfg.Store.Path).Dur("interval", cfg.History.Interval).Msg("Usage history enabled")
+	}
+
+	// Details of service accounts are cached per cluster and shared with the background jobs
+	serviceAccountCaches := service.NewServiceAccountCaches(service.DefaultServiceAccountCacheTTL)
+
+	// Initialize access key expiry sweeps of the default cluster if enabled
+	var listExpirySweepsService *service.ListExpirySweepsService
+	if cfg.Expiry.Enabled {
+		if cfg.Expiry.Interval <= 0 {
+			log.Fatal().Dur("interval", cfg.Expiry.Interval).Msg("Expiry sweep interval must be positive")
+		}
+		if cfg.Expiry.WarnDays < 0 {
+			log.Fatal().Int("warnDays", cfg.Expiry.WarnDays).Msg("Expiry warning days must not be negative")
+		}
+		if !service.ValidExpiryAction(cfg.Expiry.Action) {
+			log.Fatal().Str("action", cfg.Expiry.Action).Msg("Expiry action must be one of none, disable or delete")
+		}
+
+		expirySweeps, err := store.NewExpirySweeps(db)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize expiry sweeps")
+		}
+
+		var notifiers []service.ExpiryNotifier
+		if cfg.Expiry.WebhookURL != "" {
+			notifiers = append(notifiers, notify.NewWebhook(cfg.Expiry.WebhookURL))
+		}
+		if cfg.Expiry.SMTP.Addr != "" {
+			if cfg.Expiry.SMTP.From == "" || len(cfg.Expiry.SMTP.Recipients()) == 0 {
+				log.Fatal().Msg("Expiry SMTP notifications require a sender and at least one recipient")
+			}
+			notifiers = append(notifiers, notify.NewSMTP(notify.SMTPConfig{
+				Addr:     cfg.Expiry.SMTP.Addr,
+				Username: cfg.Expiry.SMTP.Username,
+				Password: cfg.Expiry.SMTP.Password,
+				From:     cfg.Expiry.SMTP.From,
+				To:       cfg.Expiry.SMTP.Recipients(),
+			}))
+		}
+
+		// The sweeper shares the API cache, so that disabled and deleted accounts are listed as such
+		sweepAccessKeyExpiryService := service.NewSweepAccessKeyExpiryService(
+			minioClient,
+			serviceAccountCaches.Cluster(minioClients.Default()),
+			expirySweeps,
+			service.ExpiryPolicy{
+				Cluster:   minioClients.Default(),
+				Warning:   time.Duration(cfg.Expiry.WarnDays) * 24 * time.Hour,
+				Action:    cfg.Expiry.Action,
+				Retention: cfg.Expiry.Retention,
+			},
+			notifiers...,
+		)
+		listExpirySweepsService = service.NewListExpirySweepsService(expirySweeps)
+
+		go job.Periodic{
+			Name:     "access-key-expiry",
+			Interval: cfg.Expiry.Interval,
+			Task: func(ctx context.Context) error {
+				_, err := sweepAccessKeyExpiryService.Execute(ctx)
+				return err
+			},
+		}.Run(jobsCtx)
+		log.Info().Str("action", cfg.Expiry.Action).Int("warnDays", cfg.Expiry.WarnDays).Int("notifiers", len(notifiers)).Msg("Access key expiry sweeps enabled")
+	}
+
+	// Initialize access key rotations of every cluster if enabled
+	var rotations *store.Rotations
+	if cfg.Rotation.Enabled {
+		if cfg.Rotation.Interval <= 0 {
+			log.Fatal().Dur("interval", cfg.Rotation.Interval).Msg("Rotation check interval must be positive")
+		}
+		if cfg.Rotation.Overlap <= 0 || cfg.Rotation.Grace <= 0 {
+			log.Fatal().Dur("overlap", cfg.Rotation.Overlap).Dur("grace", cfg.Rotation.Grace).Msg("Rotation overlap and grace must be positive")
+		}
+
+		rotations, err = store.NewRotations(db)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize rotations")
+		}
+
+		advanceAccessKeyRotationsService := service.NewAdvanceAccessKeyRotationsService(minioClients, serviceAccountCaches, rotations)
+
+		go job.Periodic{
+			Name:     "access-key-rotation",
+			Interval: cfg.Rotation.Interval,
+			Task: func(ctx context.Context) error {
+				_, err := advanceAccessKeyRotationsService.Execute(ctx)
+				return err
+			},
+		}.Run(jobsCtx)
+		log.Info().Dur("overlap", cfg.Rotation.Overlap).Dur("grace", cfg.Rotation.Grace).Msg("Access key rotations enabled")
+	}
+
+	// Initialize one-time reveal links if enabled, they are shared by every cluster
+	var createSecretRevealService *service.CreateSecretRevealService
+	var openSecretRevealService *service.OpenSecretRevealService
+	if cfg.Reveal.Enabled {
+		if cfg.Reveal.TTL <= 0 {
+			log.Fatal().Dur("ttl", cfg.Reveal.TTL).Msg("Reveal link TTL must be positive")
+		}
+
+		reveals, err := store.NewReveals(db)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize reveals")
+		}
+
+		createSecretRevealService = service.NewCreateSecretRevealService(reveals, cfg.Reveal.TTL, cfg.Reveal.BaseURL)
+		openSecretRevealService = service.NewOpenSecretRevealService(reveals)
+		log.Info().Dur("ttl", cfg.Reveal.TTL).Msg("Reveal links enabled")
+	}
+
+	// Initialize services of each cluster
+	newServices := func(cluster string) (httpHandler.Services, error) {
+		minioClient, err := minioClients.Client(cluster)
+		if err != nil {
+			return httpHandler.Services{}, err
+		}
+
+		confirmationTokens := service.NewConfirmationTokens(service.DefaultConfirmationTTL)
+		serviceAccounts := serviceAccountCaches.Cluster(cluster)
+		services := httpHandler.Services{
+			GetServerInfoService:           service.NewGetServerInfoService(minioClient),
+			ListAccessKeysService:          service.NewListAccessKeysService(minioClient, serviceAccounts),
+			AddServiceAccountService:       service.NewAddServiceAccountService(minioClient, credentialRules),
+			DeleteServiceAccountService:    service.NewDeleteServiceAccountService(minioClient, serviceAccounts),
+			UpdateServiceAccountService:    service.NewUpdateServiceAccountService(minioClient, serviceAccounts),
+			BulkAccessKeysService:          service.NewBulkAccessKeysService(minioClient, serviceAccounts),
+			GetLogsService:                 service.NewGetLogsService(minioClient),
+			GetClusterMetricsService:       service.NewGetClusterMetricsService(minioClient),
+			GetBucketUsageService:          service.NewGetBucketUsageService(minioClient),
+			StartHealService:               service.NewStartHealService(minioClient),
+			GetHealStatusService:           service.NewGetHealStatusService(minioClient),
+			StopHealService:                service.NewStopHealService(minioClient),
+			GetBackgroundHealStatusService: service.NewGetBackgroundHealStatusService(minioClient),
+			PrepareClusterActionService:    service.NewPrepareClusterActionService(minioClient, confirmationTokens),
+			RunClusterActionService:        service.NewRunClusterActionService(minioClient, confirmationTokens),
+			ListConfigSubsystemsService:    service.NewListConfigSubsystemsService(minioClient),
+			GetConfigSubsystemService:      service.NewGetConfigSubsystemService(minioClient),
+			SetConfigSubsystemService:      service.NewSetConfigSubsystemService(minioClient),
+			DeleteConfigSubsystemService:   service.NewDeleteConfigSubsystemService(minioClient),
+			ListConfigHistoryService:       service.NewListConfigHistoryService(minioClient),
+			RestoreConfigHistoryService:    service.NewRestoreConfigHistoryService(minioClient),
+			ExportConfigService:            service.NewExportConfigService(minioClient),
+			PrepareConfigImportService:     service.NewPrepareConfigImportService(minioClient, confirmationTokens),
+			ImportConfigService:            service.NewImportConfigService(minioClient, confirmationTokens),
+			ExportIAMService:               service.NewExportIAMService(minioClient),
+			ImportIAMService:               service.NewImportIAMService(minioClient),
+			ExportBucketMetadataService:    service.NewExportBucketMetadataService(minioClient),
+			ImportBucketMetadataService:    service.NewImportBucketMetadataService(minioClient),
+			GetKMSStatusService:            service.NewGetKMSStatusService(minioClient),
+			ListKMSKeysService:             service.NewListKMSKeysService(minioClient),
+			CreateKMSKeyService:            service.NewCreateKMSKeyService(minioClient),
+			DescribeKMSKeyService:          service.NewDescribeKMSKeyService(minioClient),
+			ListIDPConfigsService:          service.NewListIDPConfigsService(minioClient),
+			GetIDPConfigService:            service.NewGetIDPConfigService(minioClient),
+			SetIDPConfigService:            service.NewSetIDPConfigService(minioClient),
+			DeleteIDPConfigService:         service.NewDeleteIDPConfigService(minioClient),
+			CheckLDAPConfigService:         service.NewCheckLDAPConfigService(minioClient),
+			LookupLDAPUserService:          service.NewLookupLDAPUserService(minioClient),
+			ListLDAPPolicyMappingsService:  service.NewListLDAPPolicyMappingsService(minioClient),
+			UpdateLDAPPolicyMappingService: service.NewUpdateLDAPPolicyMappingService(minioClient),
+			CreateSecretRevealService:      createSecretRevealService,
+			OpenSecretRevealService:        openSecretRevealService,
+			RenderCredentialsService:       service.NewRenderCredentialsService(minioURLs[cluster]),
+			SimulateAccessKeyPolicyService: service.NewSimulateAccessKeyPolicyService(minioClient, minioRootUsers[cluster]),
+		}
+		if rotations != nil {
+			rotationPolicy := service.RotationPolicy{Overlap: cfg.Rotation.Overlap, Grace: cfg.Rotation.Grace}
+			services.StartAccessKeyRotationService = service.NewStartAccessKeyRotationService(minioClient, cluster, rotations, rotationPolicy, credentialRules)
+			services.ListAccessKeyRotationsService = service.NewListAccessKeyRotationsService(cluster, rotations)
+		}
+		if cluster == minioClients.Default() {
+			services.GetUsageHistoryService = getUsageHistoryService
+			services.ListExpirySweepsService = listExpirySweepsService
+		}
+
+		return services, nil
+	}
+	getClustersSummaryService := service.NewGetClustersSummaryService(minioClients)
 
 	// Set up HTTP service with dependencies
-	r, err := httpHandler.NewService(cfg, log, getServerInfoService, listAccessKeysService, addServiceAccountService, deleteServiceAccountService, updateServiceAccountService, distFS)
+	r, err := httpHandler.NewService(cfg, log, minioClients.Names(), newServices, getClustersSummaryService, appMetrics, distFS)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to create HTTP service")
 	}
@@ -81,6 +337,7 @@ func main() {
 	// Wait for interrupt signal
 	<-quit
 	log.Info().Msg("Shutting down server...")
+	stopJobs()
 
 	// Create a deadline for graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
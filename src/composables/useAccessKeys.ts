@@ -5,6 +5,7 @@ export interface AccessKeyInfo {
   parentUser: string
   accountStatus: string
   type: 'user' | 'serviceAccount' | 'sts'
+  provider: 'builtin' | 'ldap' | 'openid'
   name?: string
   description?: string
   expiration?: string
@@ -15,11 +16,19 @@ export interface AccessKeyInfo {
 export interface AccessKeysResponse {
   accessKeys: AccessKeyInfo[]
   total: number
+  nextCursor?: string
 }
 
 export interface AccessKeysOptions {
   type?: 'all' | 'users' | 'serviceAccounts' | 'sts'
   user?: string
+  provider?: 'all' | 'builtin' | 'ldap' | 'openid'
+  search?: string
+  sort?: 'accessKey' | 'name' | 'parent' | 'expiration' | 'status'
+  order?: 'asc' | 'desc'
+  limit?: number
+  offset?: number
+  cursor?: string
 }
 
 export function useAccessKeys() {
@@ -27,6 +36,7 @@ export function useAccessKeys() {
   const loading = ref(false)
   const error = ref<string | null>(null)
   const total = ref(0)
+  const nextCursor = ref<string | null>(null)
 
   const fetchAccessKeys = async (options: AccessKeysOptions = {}) => {
     loading.value = true
@@ -40,6 +50,26 @@ export function useAccessKeys() {
       if (options.user) {
         params.append('user', options.user)
       }
+      if (options.provider && options.provider !== 'all') {
+        params.append('provider', options.provider)
+      }
+      if (options.search) {
+        params.append('search', options.search)
+      }
+      if (options.sort) {
+        params.append('sort', options.sort)
+      }
+      if (options.order) {
+        params.append('order', options.order)
+      }
+      if (options.limit) {
+        params.append('limit', String(options.limit))
+      }
+      if (options.cursor) {
+        params.append('cursor', options.cursor)
+      } else if (options.offset) {
+        params.append('offset', String(options.offset))
+      }
 
       const url = `/api/access-keys${params.toString() ? `?${params.toString()}` : ''}`
       const response = await fetch(url)
@@ -51,10 +81,12 @@ export function useAccessKeys() {
       const data: AccessKeysResponse = await response.json()
       accessKeys.value = data.accessKeys
       total.value = data.total
+      nextCursor.value = data.nextCursor ?? null
     } catch (err) {
       error.value = err instanceof Error ? err.message : 'Unknown error occurred'
       accessKeys.value = []
       total.value = 0
+      nextCursor.value = null
     } finally {
       loading.value = false
     }
@@ -126,6 +158,7 @@ export function useAccessKeys() {
     loading,
     error,
     total,
+    nextCursor,
     fetchAccessKeys,
     userAccessKeys,
     serviceAccountKeys,
@@ -2,6 +2,7 @@ import { createRouter, createWebHistory } from 'vue-router'
 import DashboardView from '../views/DashboardView.vue'
 import AccessKeyView from '../views/AccessKeyView.vue'
 import SiteReplicationView from '../views/SiteReplicationView.vue'
+import RevealView from '../views/RevealView.vue'
 
 const routes = [
   {
@@ -31,6 +32,14 @@ const routes = [
     meta: {
       title: 'Site Replication'
     }
+  },
+  {
+    path: '/reveal/:id',
+    name: 'Reveal',
+    component: RevealView,
+    meta: {
+      title: 'Reveal Secret Key'
+    }
   }
 ]
 